@@ -0,0 +1,9 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_host_network_interfaces" "example" {
+  host_uuid = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+}
+
+output "zstack_host_network_interfaces" {
+  value = data.zstack_host_network_interfaces.example.interfaces
+}
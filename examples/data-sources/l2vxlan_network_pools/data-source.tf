@@ -0,0 +1,10 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_l2vxlan_network_pools" "example" {
+  name = "example-vxlan-pool"
+  # name_pattern = "vxlan-%"  # Pattern for fuzzy name search, similar to MySQL LIKE. Use % for multiple characters and _ for exactly one character.
+}
+
+output "zstack_l2vxlan_network_pools" {
+  value = data.zstack_l2vxlan_network_pools.example
+}
@@ -0,0 +1,9 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_lb_server_group_backends" "example" {
+  server_group_uuid = "5d09ee200ebf450b9c962ce2082a64f8"
+}
+
+output "unhealthy_backends" {
+  value = [for b in data.zstack_lb_server_group_backends.example.backends : b if b.status != "Active"]
+}
@@ -0,0 +1,13 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_l2vxlan_network_pools" "pool" {
+  name = "example-vxlan-pool"
+}
+
+data "zstack_vni_ranges" "example" {
+  pool_uuid = data.zstack_l2vxlan_network_pools.pool.l2vxlan_network_pools[0].uuid
+}
+
+output "zstack_vni_ranges" {
+  value = data.zstack_vni_ranges.example.vni_ranges
+}
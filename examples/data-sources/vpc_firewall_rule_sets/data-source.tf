@@ -0,0 +1,9 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_vpc_firewall_rule_sets" "example" {
+  name = "web-ingress"
+}
+
+output "zstack_vpc_firewall_rule_sets" {
+  value = data.zstack_vpc_firewall_rule_sets.example.rule_sets
+}
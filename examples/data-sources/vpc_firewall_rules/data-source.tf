@@ -0,0 +1,9 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_vpc_firewall_rules" "example" {
+  rule_set_uuid = "b54a1e1e5ea74cbd8a3e1f5a1ba8a5c2"
+}
+
+output "accepted_rules" {
+  value = [for r in data.zstack_vpc_firewall_rules.example.rules : r if r.action == "accept" && r.state == "enable"]
+}
@@ -0,0 +1,9 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_vrouter_routes" "example" {
+  virtual_router_uuid = "example-virtual-router-uuid"
+}
+
+output "static_routes" {
+  value = [for r in data.zstack_vrouter_routes.example.routes : r if r.type == "UserStatic"]
+}
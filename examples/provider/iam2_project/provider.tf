@@ -0,0 +1,13 @@
+# Copyright (c) ZStack.io, Inc.
+
+# Log in as an IAM2 virtual ID and scope every API call to one IAM2 project.
+# Resources created by this provider configuration land in that project.
+# Use login_type = "ldap" to authenticate an LDAP uid instead; its LDAP entry
+# must be bound to an IAM2 virtual ID to log in to a project.
+provider "zstack" {
+  host             = "ip address of zstack cloud api endpoint"
+  login_type       = "iam2_virtual_id"
+  account_name     = "tenant-virtual-id"
+  account_password = "password"
+  project_name     = "tenant-project"
+}
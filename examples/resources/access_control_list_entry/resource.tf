@@ -0,0 +1,28 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_access_control_list" "office" {
+  name       = "office-networks"
+  ip_version = 4
+}
+
+# IP addresses and CIDR blocks for white or black list listeners
+resource "zstack_access_control_list_entry" "office" {
+  acl_uuid   = zstack_access_control_list.office.uuid
+  ip_entries = ["10.10.0.0/16", "192.168.1.20"]
+}
+
+resource "zstack_access_control_list" "routing" {
+  name = "path-routing"
+}
+
+# A redirect rule routing https://api.example.com/v1 requests
+resource "zstack_access_control_list_entry" "api" {
+  acl_uuid = zstack_access_control_list.routing.uuid
+  name     = "api-v1"
+  domain   = "api.example.com"
+  url      = "/v1"
+}
+
+output "zstack_access_control_list_entry" {
+  value = zstack_access_control_list_entry.office
+}
@@ -0,0 +1,21 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_eip" "service" {
+  name     = "service-eip"
+  vip_uuid = "5d09ee200ebf450b9c962ce2082a64f8"
+}
+
+variable "active_nic_uuid" {
+  description = "NIC of the VM currently serving traffic; change it to fail over to the standby VM."
+  type        = string
+  default     = "910ea22533ba41f0b037e063eb207c2e"
+}
+
+resource "zstack_eip_association" "service" {
+  eip_uuid    = zstack_eip.service.uuid
+  vm_nic_uuid = var.active_nic_uuid
+}
+
+output "zstack_eip_association" {
+  value = zstack_eip_association.service
+}
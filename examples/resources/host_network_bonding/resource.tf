@@ -0,0 +1,36 @@
+# Copyright (c) ZStack.io, Inc.
+
+data "zstack_host_network_interfaces" "host" {
+  host_uuid = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+}
+
+resource "zstack_host_network_bonding" "example" {
+  host_uuid        = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+  bond_name        = "bond0"
+  mode             = "802.3ad"
+  xmit_hash_policy = "layer3+4"
+  slave_names = [
+    for nic in data.zstack_host_network_interfaces.host.interfaces : nic.interface_name
+    if contains(["eth1", "eth2"], nic.interface_name) && nic.carrier_active
+  ]
+}
+
+resource "zstack_l2_network" "example" {
+  name               = "example-l2-bond"
+  zone_uuid          = "zone-uuid"
+  physical_interface = zstack_host_network_bonding.example.bond_name
+}
+
+# ZStack creates the VLAN sub-interface bond0.100 and its bridge on every host
+# of the cluster when the VLAN network is attached.
+resource "zstack_l2vlan_network" "example" {
+  name                   = "example-vlan100-bond"
+  zone_uuid              = "zone-uuid"
+  vlan                   = 100
+  physical_interface     = zstack_host_network_bonding.example.bond_name
+  attached_cluster_uuids = ["cluster-uuid"]
+}
+
+output "host_network_bonding" {
+  value = zstack_host_network_bonding.example
+}
@@ -0,0 +1,18 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_l2_network" "example" {
+  name               = "example-l2-flat"
+  description        = "Example flat L2 network"
+  zone_uuid          = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+  physical_interface = "eth1"
+  vswitch_type       = "LinuxBridge"
+}
+
+resource "zstack_l2_network_cluster_attachment" "example" {
+  l2_network_uuid = zstack_l2_network.example.uuid
+  cluster_uuid    = "cluster-uuid"
+}
+
+output "l2_network" {
+  value = zstack_l2_network.example
+}
@@ -13,3 +13,20 @@ resource "zstack_l2_network_cluster_attachment" "example" {
 output "zstack_l2_network_cluster_attachment" {
   value = zstack_l2_network_cluster_attachment.example
 }
+
+# Flat network whose uplink NIC is named differently on some hosts.
+resource "zstack_l2_network" "flat" {
+  name               = "example-l2-flat"
+  zone_uuid          = "zone-uuid"
+  physical_interface = "eth1"
+}
+
+resource "zstack_l2_network_cluster_attachment" "flat" {
+  l2_network_uuid = zstack_l2_network.flat.uuid
+  cluster_uuid    = "cluster-uuid"
+
+  host_physical_interfaces = {
+    "host-uuid-1" = "ens1f0"
+    "host-uuid-2" = "bond0"
+  }
+}
@@ -0,0 +1,25 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_l2vxlan_network_pool" "example" {
+  name               = "example-vxlan-pool"
+  description        = "Example L2 VXLAN network pool"
+  zone_uuid          = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+  physical_interface = "eth1"
+
+  vni_ranges = [
+    {
+      name      = "tenant-a"
+      start_vni = 1000
+      end_vni   = 1999
+    },
+    {
+      name      = "tenant-b"
+      start_vni = 2000
+      end_vni   = 2999
+    },
+  ]
+}
+
+output "l2vxlan_network_pool" {
+  value = zstack_l2vxlan_network_pool.example
+}
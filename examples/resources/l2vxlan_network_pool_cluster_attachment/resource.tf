@@ -0,0 +1,33 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_l2vxlan_network_pool" "example" {
+  name      = "example-vxlan-pool"
+  zone_uuid = "a1b2c3d4e5f6789a0b1c2d3e4f5a6b7c"
+
+  vni_ranges = [
+    {
+      name      = "default"
+      start_vni = 1000
+      end_vni   = 1999
+    },
+  ]
+}
+
+resource "zstack_l2vxlan_network_pool_cluster_attachment" "example" {
+  pool_uuid    = zstack_l2vxlan_network_pool.example.uuid
+  cluster_uuid = "cluster-uuid"
+  vtep_cidr    = "192.168.100.0/24"
+}
+
+resource "zstack_l2vxlan_network" "example" {
+  name      = "example-l2-vxlan-network"
+  pool_uuid = zstack_l2vxlan_network_pool.example.uuid
+  vni       = 1000
+  zone_uuid = zstack_l2vxlan_network_pool.example.zone_uuid
+
+  depends_on = [zstack_l2vxlan_network_pool_cluster_attachment.example]
+}
+
+output "zstack_l2vxlan_network_pool_cluster_attachment" {
+  value = zstack_l2vxlan_network_pool_cluster_attachment.example
+}
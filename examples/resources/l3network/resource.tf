@@ -6,6 +6,22 @@ resource "zstack_l3network" "example" {
   l2_network_uuid = "example-l2-network-uuid"
   type            = "L3BasicNetwork"
   category        = "Private"
+
+  network_services = [
+    { type = "DHCP", provider = "Flat" },
+    { type = "Eip", provider = "Flat" },
+    { type = "Userdata", provider = "Flat" },
+    { type = "SecurityGroup", provider = "SecurityGroup" },
+    { type = "HostRoute", provider = "Flat" },
+  ]
+
+  dns_servers = ["223.5.5.5", "8.8.8.8"]
+
+  host_routes = [
+    { prefix = "10.10.0.0/16", nexthop = "172.16.0.254" },
+  ]
+
+  mtu = 1450
 }
 
 output "l3network" {
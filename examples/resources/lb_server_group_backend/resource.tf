@@ -0,0 +1,25 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_lb_server_group" "web" {
+  name               = "web-servers"
+  load_balancer_uuid = "5d09ee200ebf450b9c962ce2082a64f8"
+}
+
+# A VM NIC backend.
+resource "zstack_lb_server_group_backend" "vm" {
+  server_group_uuid = zstack_lb_server_group.web.uuid
+  vm_nic_uuid       = "0a4b1c8e2f3d4e5f8a9b0c1d2e3f4a5b"
+  weight            = 50
+}
+
+# A server outside ZStack, listening on its own port.
+resource "zstack_lb_server_group_backend" "external" {
+  server_group_uuid = zstack_lb_server_group.web.uuid
+  ip_address        = "192.168.10.20"
+  weight            = 100
+  port              = 8080
+}
+
+output "zstack_lb_server_group_backend" {
+  value = zstack_lb_server_group_backend.vm
+}
@@ -10,6 +10,35 @@ resource "zstack_load_balancer_listener" "example" {
   instance_port      = 8080 # Backend port on instances
 }
 
+# An HTTPS listener with health checks, cookie persistence and an IP allowlist
+resource "zstack_load_balancer_listener" "https" {
+  name               = "https-listener"
+  load_balancer_uuid = "lb-uuid-placeholder"
+  protocol           = "https"
+  load_balancer_port = 443
+  instance_port      = 8080
+  certificate_uuid   = "certificate-uuid-placeholder"
+
+  balancer_algorithm      = "leastconn"
+  connection_idle_timeout = 60
+  max_connection          = 20000
+
+  health_check_protocol  = "http"
+  health_check_method    = "GET"
+  health_check_path      = "/healthz"
+  health_check_http_code = "http_2xx"
+  health_check_interval  = 5
+  health_check_timeout   = 2
+  healthy_threshold      = 2
+  unhealthy_threshold    = 3
+
+  session_persistence  = "insert"
+  session_idle_timeout = 1800
+
+  acl_uuids = ["acl-uuid-placeholder"]
+  acl_type  = "white"
+}
+
 output "zstack_load_balancer_listener" {
   value = zstack_load_balancer_listener.example
 }
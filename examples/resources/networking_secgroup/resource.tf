@@ -19,6 +19,37 @@ resource "zstack_networking_secgroup" "linuxbridge_defautvstype_test" {
   ip_version  = 4
 }
 
+resource "zstack_networking_secgroup" "inline_rules_test" {
+  name       = "tf-secgroup-inline-rules"
+  ip_version = 4
+
+  # Authoritative: the list order is the rule priority, and unlisted ingress
+  # rules are deleted. Do not also use zstack_networking_secgroup_rule here.
+  ingress = [
+    {
+      action                  = "ACCEPT"
+      protocol                = "TCP"
+      ip_ranges               = "0.0.0.0/0"
+      destination_port_ranges = "22"
+      description             = "ssh"
+    },
+    {
+      action                  = "ACCEPT"
+      protocol                = "TCP"
+      ip_ranges               = "0.0.0.0/0"
+      destination_port_ranges = "80,443"
+    },
+  ]
+
+  egress = [
+    {
+      action    = "DROP"
+      protocol  = "ALL"
+      ip_ranges = "10.0.0.0/8"
+    },
+  ]
+}
+
 output "linuxbridge_uuid" {
   value = zstack_networking_secgroup.linuxbridge_test.uuid
 }
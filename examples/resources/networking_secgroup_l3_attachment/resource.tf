@@ -0,0 +1,16 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_networking_secgroup" "web" {
+  name       = "web"
+  ip_version = 4
+}
+
+# Every VM NIC on the L3 network gets the security group.
+resource "zstack_networking_secgroup_l3_attachment" "web" {
+  secgroup_uuid   = zstack_networking_secgroup.web.uuid
+  l3_network_uuid = "l3-network-uuid"
+}
+
+output "zstack_networking_secgroup_l3_attachment" {
+  value = zstack_networking_secgroup_l3_attachment.web
+}
@@ -0,0 +1,34 @@
+# Copyright (c) ZStack.io, Inc.
+
+# Send traffic from the 10.1.0.0/16 tenant network out through a second uplink.
+resource "zstack_policy_route_table" "backup_uplink" {
+  vrouter_uuid = "example-virtual-router-uuid"
+  number       = 100
+}
+
+resource "zstack_policy_route_table_entry" "default" {
+  table_uuid       = zstack_policy_route_table.backup_uplink.uuid
+  destination_cidr = "0.0.0.0/0"
+  next_hop_ip      = "203.0.113.1"
+}
+
+resource "zstack_policy_route_rule_set" "tenant" {
+  name         = "tenant-source-routing"
+  vrouter_uuid = "example-virtual-router-uuid"
+}
+
+resource "zstack_policy_route_rule" "tenant" {
+  rule_set_uuid = zstack_policy_route_rule_set.tenant.uuid
+  table_uuid    = zstack_policy_route_table.backup_uplink.uuid
+  rule_number   = 10
+  source_ip     = "10.1.0.0/16"
+}
+
+resource "zstack_policy_route_rule_set_attachment" "tenant" {
+  rule_set_uuid   = zstack_policy_route_rule_set.tenant.uuid
+  l3_network_uuid = "tenant-l3-network-uuid"
+}
+
+output "zstack_policy_route_rule_set_attachment" {
+  value = zstack_policy_route_rule_set_attachment.tenant
+}
@@ -0,0 +1,11 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_policy_route_table" "example" {
+  vrouter_uuid = "example-virtual-router-uuid"
+  number       = 100
+  description  = "Routes for traffic leaving through the backup uplink"
+}
+
+output "zstack_policy_route_table" {
+  value = zstack_policy_route_table.example
+}
@@ -0,0 +1,16 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_policy_route_table" "example" {
+  vrouter_uuid = "example-virtual-router-uuid"
+  number       = 100
+}
+
+resource "zstack_policy_route_table_entry" "default" {
+  table_uuid       = zstack_policy_route_table.example.uuid
+  destination_cidr = "0.0.0.0/0"
+  next_hop_ip      = "203.0.113.1"
+}
+
+output "zstack_policy_route_table_entry" {
+  value = zstack_policy_route_table_entry.default
+}
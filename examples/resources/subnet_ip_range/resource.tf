@@ -9,6 +9,36 @@ resource "zstack_subnet_ip_range" "test" {
   gateway         = "192.168.100.1"
 }
 
+resource "zstack_l3network" "dual_stack" {
+  name            = "dual-stack"
+  l2_network_uuid = "example-l2-network-uuid"
+  category        = "Private"
+  ip_version      = 46
+}
+
+resource "zstack_subnet_ip_range" "ipv4" {
+  l3_network_uuid = zstack_l3network.dual_stack.uuid
+  name            = "dual-stack-v4"
+  network_cidr    = "192.168.10.0/24"
+}
+
+resource "zstack_subnet_ip_range" "ipv6" {
+  l3_network_uuid = zstack_l3network.dual_stack.uuid
+  name            = "dual-stack-v6"
+  network_cidr    = "2001:db8:10::/64"
+  address_mode    = "SLAAC"
+}
+
+resource "zstack_subnet_ip_range" "ipv6_stateful" {
+  l3_network_uuid = zstack_l3network.dual_stack.uuid
+  name            = "dual-stack-v6-dhcp"
+  start_ip        = "2001:db8:20::10"
+  end_ip          = "2001:db8:20::ff"
+  gateway         = "2001:db8:20::1"
+  prefix_len      = 64
+  address_mode    = "Stateful-DHCP"
+}
+
 output "zstack_subnet_ip_range" {
   value = zstack_subnet_ip_range.test
 }
@@ -1,11 +1,21 @@
 # Copyright (c) ZStack.io, Inc.
 
+resource "zstack_vpc_ha_group" "example" {
+  name        = "example-ha-group"
+  monitor_ips = ["172.20.0.1"]
+}
+
 resource "zstack_virtual_router_instance" "test" {
   name                         = "example-vr"
   description                  = "Example Virtual router instance"
   virtual_router_offering_uuid = "uuid of virtual router offering"
+  ha_group_uuid                = zstack_vpc_ha_group.example.uuid
+
+  # Change this value to reconnect the virtual router, or set
+  # reconnect_action = "Reboot" to reboot it instead.
+  reconnect_trigger = "1"
 }
 
 output "zstack_virtual_router_instance" {
   value = zstack_virtual_router_instance.test
-}
\ No newline at end of file
+}
@@ -0,0 +1,24 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_networking_secgroup" "baseline" {
+  name       = "baseline"
+  ip_version = 4
+}
+
+resource "zstack_networking_secgroup" "web" {
+  name       = "web"
+  ip_version = 4
+}
+
+# The baseline group is evaluated first (priority 1), then the web group.
+resource "zstack_vm_nic_security_groups" "web_nic" {
+  nic_uuid = "vm-nic-uuid"
+  security_group_uuids = [
+    zstack_networking_secgroup.baseline.uuid,
+    zstack_networking_secgroup.web.uuid,
+  ]
+}
+
+output "zstack_vm_nic_security_groups" {
+  value = zstack_vm_nic_security_groups.web_nic
+}
@@ -0,0 +1,28 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_vpc_firewall_rule_set" "example" {
+  name           = "web-ingress"
+  default_action = "drop"
+}
+
+resource "zstack_vpc_firewall_rule" "https" {
+  rule_set_uuid    = zstack_vpc_firewall_rule_set.example.uuid
+  rule_number      = 100
+  action           = "accept"
+  protocol         = "TCP"
+  destination_ip   = "192.168.10.0/24"
+  destination_port = "443"
+  description      = "Allow HTTPS to the web tier"
+}
+
+resource "zstack_vpc_firewall_rule" "ping" {
+  rule_set_uuid = zstack_vpc_firewall_rule_set.example.uuid
+  rule_number   = 200
+  action        = "accept"
+  protocol      = "ICMP"
+  state         = "disable"
+}
+
+output "zstack_vpc_firewall_rule" {
+  value = zstack_vpc_firewall_rule.https
+}
@@ -0,0 +1,11 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_vpc_firewall_rule_set" "example" {
+  name           = "web-ingress"
+  description    = "Inbound rules for the web tier"
+  default_action = "drop"
+}
+
+output "zstack_vpc_firewall_rule_set" {
+  value = zstack_vpc_firewall_rule_set.example
+}
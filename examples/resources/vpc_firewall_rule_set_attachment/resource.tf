@@ -0,0 +1,17 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_vpc_firewall_rule_set" "example" {
+  name           = "web-ingress"
+  default_action = "drop"
+}
+
+# Filter packets entering the VPC router from the web tier network.
+resource "zstack_vpc_firewall_rule_set_attachment" "example" {
+  rule_set_uuid   = zstack_vpc_firewall_rule_set.example.uuid
+  l3_network_uuid = "web-l3-network-uuid"
+  direction       = "in"
+}
+
+output "zstack_vpc_firewall_rule_set_attachment" {
+  value = zstack_vpc_firewall_rule_set_attachment.example
+}
@@ -4,7 +4,14 @@ resource "zstack_vrouter_route_entry" "example" {
   route_table_uuid = "example-route-table-uuid"
   destination      = "10.0.0.0/24"
   target           = "192.168.1.1"
-  type             = "User"
+  type             = "UserStatic"
+}
+
+# Drop traffic to an address block that must never leave the router.
+resource "zstack_vrouter_route_entry" "blackhole" {
+  route_table_uuid = "example-route-table-uuid"
+  destination      = "10.99.0.0/16"
+  type             = "UserBlackHole"
 }
 
 output "vrouter_route_entry" {
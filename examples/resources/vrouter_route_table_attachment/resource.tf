@@ -0,0 +1,20 @@
+# Copyright (c) ZStack.io, Inc.
+
+resource "zstack_vrouter_route_table" "example" {
+  name = "vpc-routes"
+}
+
+resource "zstack_vrouter_route_entry" "on_prem" {
+  route_table_uuid = zstack_vrouter_route_table.example.uuid
+  destination      = "172.20.0.0/16"
+  target           = "192.168.1.254"
+}
+
+resource "zstack_vrouter_route_table_attachment" "example" {
+  route_table_uuid    = zstack_vrouter_route_table.example.uuid
+  virtual_router_uuid = "example-virtual-router-uuid"
+}
+
+output "zstack_vrouter_route_table_attachment" {
+  value = zstack_vrouter_route_table_attachment.example
+}
@@ -0,0 +1,251 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRedactFields are always redacted from audit log bodies. A JSON field
+// is redacted when its name, lowercased and without "_" and "-", contains one
+// of them, so "accessKeySecret", "oldPassword" and "private_key" all match.
+var defaultRedactFields = []string{"password", "secret", "token", "privatekey", "session"}
+
+const (
+	redactedValue = "******"
+
+	// auditMaxBodyBytes bounds the bodies copied into the audit log; larger
+	// bodies are recorded by size only.
+	auditMaxBodyBytes = 1 << 20
+)
+
+var jobUuidPattern = regexp.MustCompile(`/api-jobs/([0-9a-fA-F]{32})`)
+
+// queryConditionPattern splits a ZStack query condition such as
+// "name=vm1" or "password!=x" into its field, operator and value.
+var queryConditionPattern = regexp.MustCompile(`^([A-Za-z0-9_.\-]+?)(!?~=|!?\?=|!=|>=|<=|=|>|<)(.*)$`)
+
+// apiAuditRecord is one line of the audit log.
+type apiAuditRecord struct {
+	Time             string          `json:"time"`
+	Method           string          `json:"method"`
+	Path             string          `json:"path"`
+	Query            string          `json:"query,omitempty"`
+	Status           int             `json:"status,omitempty"`
+	DurationMs       int64           `json:"duration_ms"`
+	JobUuid          string          `json:"job_uuid,omitempty"`
+	RequestBody      json.RawMessage `json:"request_body,omitempty"`
+	RequestBodySize  int             `json:"request_body_size,omitempty"`
+	ResponseBody     json.RawMessage `json:"response_body,omitempty"`
+	ResponseBodySize int             `json:"response_body_size,omitempty"`
+	Error            string          `json:"error,omitempty"`
+}
+
+// apiAuditLog appends a JSON line per API call to the api_log_file. Request
+// headers are never logged, since they carry the session or AccessKey
+// signature.
+type apiAuditLog struct {
+	mu     sync.Mutex
+	file   *os.File
+	redact []string
+}
+
+// openAPIAuditLog opens path for appending, creating it readable by the
+// owner only. extraRedactFields are redacted in addition to
+// defaultRedactFields.
+func openAPIAuditLog(path string, extraRedactFields []string) (*apiAuditLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	redact := append([]string{}, defaultRedactFields...)
+	for _, field := range extraRedactFields {
+		if field = normalizeFieldName(field); field != "" {
+			redact = append(redact, field)
+		}
+	}
+	return &apiAuditLog{file: file, redact: redact}, nil
+}
+
+// Close closes the log file. Records written after Close are dropped.
+func (l *apiAuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+func (l *apiAuditLog) write(record apiAuditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.file.Write(append(line, '\n'))
+}
+
+// body returns the redacted body for the log, or only its size when it is
+// not JSON or too large to copy. With redactUuids set every "uuid" field is
+// redacted too, which login responses need: their inventory UUID is the
+// session ID.
+func (l *apiAuditLog) body(data []byte, redactUuids bool) (json.RawMessage, int) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, 0
+	}
+	if len(data) > auditMaxBodyBytes {
+		return nil, len(data)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, len(data)
+	}
+	redacted, err := json.Marshal(l.redactValue(value, redactUuids))
+	if err != nil {
+		return nil, len(data)
+	}
+	return redacted, 0
+}
+
+// query returns the raw query string with secrets redacted like body does:
+// the value of a secret parameter, and the value of a query condition
+// ("q=password=x") on a secret field. Other parameters are kept as sent.
+func (l *apiAuditLog) query(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	params := strings.Split(raw, "&")
+	for i, param := range params {
+		rawKey, rawValue, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			key = rawKey
+		}
+		if l.isSecret(key) {
+			params[i] = rawKey + "=" + redactedValue
+			continue
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			continue
+		}
+		m := queryConditionPattern.FindStringSubmatch(value)
+		if m == nil {
+			continue
+		}
+		field := m[1][strings.LastIndex(m[1], ".")+1:]
+		if l.isSecret(field) {
+			params[i] = rawKey + "=" + url.QueryEscape(m[1]+m[2]) + redactedValue
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func (l *apiAuditLog) redactValue(value any, redactUuids bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if l.isSecret(key) || (redactUuids && key == "uuid") {
+				v[key] = redactedValue
+			} else {
+				v[key] = l.redactValue(field, redactUuids)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = l.redactValue(item, redactUuids)
+		}
+	}
+	return value
+}
+
+func (l *apiAuditLog) isSecret(key string) bool {
+	key = normalizeFieldName(key)
+	for _, field := range l.redact {
+		if strings.Contains(key, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeFieldName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer("_", "", "-", "").Replace(name)
+}
+
+// auditTransport records every request of the API proxy in an apiAuditLog.
+type auditTransport struct {
+	next http.RoundTripper
+	log  *apiAuditLog
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := apiAuditRecord{
+		Time:   time.Now().UTC().Format(time.RFC3339Nano),
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  t.log.query(req.URL.RawQuery),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		record.RequestBody, record.RequestBodySize = t.log.body(data, false)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		record.DurationMs = time.Since(start).Milliseconds()
+		record.JobUuid = jobUuid(req.URL.Path, "")
+		record.Error = err.Error()
+		t.log.write(record)
+		return nil, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	record.DurationMs = time.Since(start).Milliseconds()
+	record.Status = resp.StatusCode
+	if err != nil {
+		record.Error = err.Error()
+		t.log.write(record)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	record.JobUuid = jobUuid(req.URL.Path, resp.Header.Get("Location")+string(data))
+	record.ResponseBody, record.ResponseBodySize = t.log.body(data, strings.HasSuffix(req.URL.Path, "/login"))
+	t.log.write(record)
+	return resp, nil
+}
+
+// jobUuid returns the UUID of the async job a call started or polled: job
+// polls carry it in the path, async API responses in their job location.
+func jobUuid(path, response string) string {
+	if m := jobUuidPattern.FindStringSubmatch(path); m != nil {
+		return m[1]
+	}
+	if m := jobUuidPattern.FindStringSubmatch(response); m != nil {
+		return m[1]
+	}
+	return ""
+}
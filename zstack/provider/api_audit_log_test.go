@@ -0,0 +1,150 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readAuditLog(t *testing.T, path string) []apiAuditRecord {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var records []apiAuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record apiAuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("audit log line is not JSON: %s", scanner.Text())
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestAPIAuditLog_Redaction(t *testing.T) {
+	log, err := openAPIAuditLog(filepath.Join(t.TempDir(), "api.log"), []string{"user_data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := log.body([]byte(`{"logInByAccount":{"accountName":"admin","password":"b109f3bb"},`+
+		`"params":{"accessKeySecret":"s3cr3t","private_key":"k","userData":"#cloud-config","name":"vm1"},`+
+		`"systemTags":[{"sessionUuid":"abc"}]}`), false)
+	got := string(body)
+
+	for _, secret := range []string{"b109f3bb", "s3cr3t", `"k"`, "#cloud-config", "abc"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("%s was not redacted: %s", secret, got)
+		}
+	}
+	for _, kept := range []string{`"accountName":"admin"`, `"name":"vm1"`} {
+		if !strings.Contains(got, kept) {
+			t.Errorf("%s should be kept: %s", kept, got)
+		}
+	}
+}
+
+func TestAPIAuditLog_QueryRedaction(t *testing.T) {
+	log, err := openAPIAuditLog(filepath.Join(t.TempDir(), "api.log"), []string{"user_data"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"q=name=vm1&limit=10":                     "q=name=vm1&limit=10",
+		"q=password=hunter2&q=name=vm1":           "q=password%3D" + redactedValue + "&q=name=vm1",
+		"q=vmInstance.rootPassword!%3Dhunter2":    "q=vmInstance.rootPassword%21%3D" + redactedValue,
+		"accessKeySecret=s3cr3t&replyWithCount=1": "accessKeySecret=" + redactedValue + "&replyWithCount=1",
+		"q=userData~=%25cloud%25":                 "q=userData~%3D" + redactedValue,
+		"replyWithCount":                          "replyWithCount",
+	}
+	for in, want := range cases {
+		if got := log.query(in); got != want {
+			t.Errorf("query(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
+
+func TestAPIAuditLog_LoginSessionRedacted(t *testing.T) {
+	log, err := openAPIAuditLog(filepath.Join(t.TempDir(), "api.log"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := log.body([]byte(`{"inventory":{"uuid":"5b6d1a0f8a1e4f0b9c3d2e1f0a9b8c7d","accountUuid":"36c27e8ff05c4780bf6d2fa65700f22e"}}`), true)
+	if strings.Contains(string(body), "5b6d1a0f8a1e4f0b9c3d2e1f0a9b8c7d") {
+		t.Errorf("the session UUID of a login response must be redacted: %s", body)
+	}
+}
+
+func TestAPIAuditLog_NonJSONBody(t *testing.T) {
+	log, err := openAPIAuditLog(filepath.Join(t.TempDir(), "api.log"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, size := log.body([]byte("<html>502 Bad Gateway</html>"), false)
+	if body != nil || size != 28 {
+		t.Errorf("non-JSON bodies should be recorded by size only, got %q, %d", body, size)
+	}
+}
+
+func TestAPIProxy_WritesAuditLog(t *testing.T) {
+	var backend *httptest.Server
+	backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		location := backend.URL + "/zstack/v1/api-jobs/0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c"
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"location": %q}`, location)
+	}))
+	defer backend.Close()
+
+	logPath := filepath.Join(t.TempDir(), "api.log")
+	proxy := startTestAPIProxy(t, backend, apiProxyConfig{APILogFile: logPath})
+
+	req, _ := http.NewRequest(http.MethodPost, proxy.baseURL()+"/zstack/v1/vm-instances?sessionId=5b6d1a0f8a1e4f0b9c3d2e1f0a9b8c7d",
+		strings.NewReader(`{"params":{"name":"vm1","rootPassword":"hunter2"}}`))
+	req.Header.Set("Authorization", "OAuth 5b6d1a0f8a1e4f0b9c3d2e1f0a9b8c7d")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request through proxy failed: %v", err)
+	}
+	resp.Body.Close()
+
+	records := readAuditLog(t, logPath)
+	if len(records) != 1 {
+		t.Fatalf("expected 1 audit record, got %d", len(records))
+	}
+	record := records[0]
+	if record.Method != http.MethodPost || record.Path != "/zstack/v1/vm-instances" || record.Status != http.StatusAccepted ||
+		record.Query != "sessionId="+redactedValue {
+		t.Errorf("unexpected record %+v", record)
+	}
+	if record.JobUuid != "0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c" {
+		t.Errorf("expected the job UUID to be recorded, got %q", record.JobUuid)
+	}
+	if strings.Contains(string(record.RequestBody), "hunter2") || !strings.Contains(string(record.RequestBody), "vm1") {
+		t.Errorf("unexpected request body %s", record.RequestBody)
+	}
+
+	raw, _ := os.ReadFile(logPath)
+	if strings.Contains(string(raw), "5b6d1a0f8a1e4f0b9c3d2e1f0a9b8c7d") {
+		t.Error("request headers and secret query parameters must not be logged")
+	}
+	if info, err := os.Stat(logPath); err == nil && info.Mode().Perm() != 0o600 {
+		t.Errorf("expected the log to be created with mode 0600, got %v", info.Mode().Perm())
+	}
+}
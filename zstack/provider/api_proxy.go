@@ -0,0 +1,211 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiProxyConfig holds the provider-wide API policies applied to every call
+// the SDK client makes.
+type apiProxyConfig struct {
+	// MaxConcurrentRequests caps the number of API requests in flight. Zero
+	// means unlimited.
+	MaxConcurrentRequests int
+	// RequestsPerSecond caps the rate at which API requests are started. Zero
+	// means unlimited.
+	RequestsPerSecond float64
+	// APILogFile is the file every API call is appended to as a JSON line.
+	// Empty disables the audit log.
+	APILogFile string
+	// APILogRedactFields are JSON field names redacted from the audit log in
+	// addition to defaultRedactFields.
+	APILogRedactFields []string
+	// ReadCacheTTL is how long query results are reused. Zero disables the
+	// read cache.
+	ReadCacheTTL time.Duration
+	// Session is the IAM2 virtual ID or LDAP login session every request is
+	// authenticated with, renewed when it expires. Nil for account and
+	// AccessKey authentication, which the SDK client handles itself.
+	Session *loginSession
+	// Scheme is how the management node is reached, "http" or "https".
+	// Empty means "http". The SDK client only speaks plain HTTP, so HTTPS
+	// always goes through the proxy.
+	Scheme string
+}
+
+func (c apiProxyConfig) enabled() bool {
+	return c.MaxConcurrentRequests > 0 || c.RequestsPerSecond > 0 || c.APILogFile != "" || c.ReadCacheTTL > 0 || c.Session != nil ||
+		c.Scheme == "https"
+}
+
+// apiProxyBaseTransport sends the proxied requests to the management node.
+// Tests replace it to trust their TLS server.
+var apiProxyBaseTransport http.RoundTripper = http.DefaultTransport
+
+// apiProxy is a loopback reverse proxy between the SDK client and the ZStack
+// management node. The SDK client does not expose its HTTP transport, so
+// Configure points it at this proxy whenever an API policy is enabled and the
+// policies are applied here, once, for every resource and data source.
+type apiProxy struct {
+	target   *url.URL
+	listener net.Listener
+	server   *http.Server
+	auditLog *apiAuditLog
+}
+
+// newAPIProxy starts a proxy on 127.0.0.1 that forwards to the management
+// node at host:port, over config.Scheme, through the transports built from
+// config. The proxy runs until Close is called.
+func newAPIProxy(ctx context.Context, host string, port int, config apiProxyConfig) (*apiProxy, error) {
+	scheme := config.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	target, err := url.Parse(scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("could not start the local API proxy: %w", err)
+	}
+
+	p := &apiProxy{target: target, listener: listener}
+
+	transport := apiProxyBaseTransport
+	if config.APILogFile != "" {
+		auditLog, err := openAPIAuditLog(config.APILogFile, config.APILogRedactFields)
+		if err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("could not open api_log_file: %w", err)
+		}
+		p.auditLog = auditLog
+		// The audit log sits below the rate limit so durations exclude the
+		// time spent queued.
+		transport = &auditTransport{next: transport, log: auditLog}
+	}
+	if config.MaxConcurrentRequests > 0 || config.RequestsPerSecond > 0 {
+		transport = &limitedTransport{
+			next:    transport,
+			limiter: newRequestLimiter(config.MaxConcurrentRequests, config.RequestsPerSecond),
+			logCtx:  ctx,
+		}
+	}
+	if config.Session != nil {
+		// A retry after renewing the session goes through the rate limit
+		// and the audit log like any other request.
+		transport = &sessionTransport{next: transport, session: config.Session}
+	}
+	if config.ReadCacheTTL > 0 {
+		// Cache hits neither count against the rate limit nor appear in the
+		// audit log, since they make no API call.
+		transport = &cachingTransport{
+			next:   transport,
+			cache:  newReadCache(config.ReadCacheTTL),
+			logCtx: ctx,
+		}
+	}
+
+	p.server = &http.Server{
+		Handler: &httputil.ReverseProxy{
+			Rewrite: func(r *httputil.ProxyRequest) {
+				r.SetURL(target)
+				r.Out.Host = target.Host
+			},
+			Transport:      transport,
+			ModifyResponse: p.rewriteLocation,
+		},
+	}
+	go func() {
+		_ = p.server.Serve(listener)
+	}()
+
+	return p, nil
+}
+
+// Close stops the proxy, dropping any request still in flight, and closes
+// the audit log.
+func (p *apiProxy) Close() error {
+	err := p.server.Close()
+	if p.auditLog != nil {
+		if closeErr := p.auditLog.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Host returns the host the SDK client should connect to.
+func (p *apiProxy) Host() string {
+	return "127.0.0.1"
+}
+
+// Port returns the port the SDK client should connect to.
+func (p *apiProxy) Port() int {
+	return p.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (p *apiProxy) baseURL() string {
+	return "http://" + p.listener.Addr().String()
+}
+
+// rewriteLocation points the job polling URL of asynchronous APIs back at the
+// proxy. ZStack returns it as an absolute URL of the management node, both in
+// the Location header and in the "location" field of the 202 response body,
+// and the SDK follows it as-is.
+func (p *apiProxy) rewriteLocation(resp *http.Response) error {
+	if location := resp.Header.Get("Location"); location != "" {
+		resp.Header.Set("Location", p.proxiedURL(location))
+	}
+
+	if resp.StatusCode != http.StatusAccepted || resp.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	body = rewriteJobLocation(body, p.proxiedURL)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return nil
+}
+
+// proxiedURL replaces the scheme and host of a management node URL with the
+// proxy's. URLs outside the ZStack API are returned unchanged.
+func (p *apiProxy) proxiedURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || !u.IsAbs() || !strings.HasPrefix(u.Path, "/zstack/") {
+		return raw
+	}
+	return p.baseURL() + u.RequestURI()
+}
+
+var jobLocationField = regexp.MustCompile(`("location"\s*:\s*")([^"]*)(")`)
+
+// rewriteJobLocation rewrites the "location" field of an async API response.
+func rewriteJobLocation(body []byte, rewrite func(string) string) []byte {
+	return jobLocationField.ReplaceAllFunc(body, func(match []byte) []byte {
+		parts := jobLocationField.FindSubmatch(match)
+		out := append([]byte{}, parts[1]...)
+		out = append(out, rewrite(string(parts[2]))...)
+		return append(out, parts[3]...)
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func startTestAPIProxy(t *testing.T, backend *httptest.Server, config apiProxyConfig) *apiProxy {
+	t.Helper()
+	u, err := url.Parse(backend.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, portStr, _ := net.SplitHostPort(u.Host)
+	port, _ := strconv.Atoi(portStr)
+
+	proxy, err := newAPIProxy(context.Background(), host, port, config)
+	if err != nil {
+		t.Fatalf("could not start proxy: %v", err)
+	}
+	t.Cleanup(func() { proxy.Close() })
+	return proxy
+}
+
+func TestAPIProxy_ForwardsRequests(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"path":%q,"query":%q,"auth":%q}`, r.URL.Path, r.URL.RawQuery, r.Header.Get("Authorization"))
+	}))
+	defer backend.Close()
+	proxy := startTestAPIProxy(t, backend, apiProxyConfig{MaxConcurrentRequests: 1})
+
+	req, _ := http.NewRequest(http.MethodGet, proxy.baseURL()+"/zstack/v1/vm-instances?q=name=vm1", nil)
+	req.Header.Set("Authorization", "OAuth session-1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request through proxy failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var got map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got["path"] != "/zstack/v1/vm-instances" || got["query"] != "q=name=vm1" || got["auth"] != "OAuth session-1" {
+		t.Errorf("request was not forwarded as-is: %v", got)
+	}
+}
+
+func TestAPIProxy_RewritesJobLocation(t *testing.T) {
+	var backend *httptest.Server
+	backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		location := backend.URL + "/zstack/v1/api-jobs/job-1"
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"location": %q}`, location)
+	}))
+	defer backend.Close()
+	proxy := startTestAPIProxy(t, backend, apiProxyConfig{RequestsPerSecond: 100})
+
+	resp, err := http.Post(proxy.baseURL()+"/zstack/v1/vm-instances", "application/json", nil)
+	if err != nil {
+		t.Fatalf("request through proxy failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	want := proxy.baseURL() + "/zstack/v1/api-jobs/job-1"
+	if got := resp.Header.Get("Location"); got != want {
+		t.Errorf("Location header: expected %s, got %s", want, got)
+	}
+	var body map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body["location"] != want {
+		t.Errorf("job location: expected %s, got %s", want, body["location"])
+	}
+}
+
+func TestAPIProxy_AppliesConcurrencyLimit(t *testing.T) {
+	var active, maxActive int32
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		io.WriteString(w, "{}")
+	}))
+	defer backend.Close()
+	proxy := startTestAPIProxy(t, backend, apiProxyConfig{MaxConcurrentRequests: 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := http.Get(proxy.baseURL() + "/zstack/v1/zones")
+			if err != nil {
+				t.Errorf("request through proxy failed: %v", err)
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxActive > 2 {
+		t.Fatalf("expected at most 2 requests at the management node, got %d", maxActive)
+	}
+}
+
+func TestAPIProxy_ProxiedURL(t *testing.T) {
+	p := &apiProxy{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	p.listener = listener
+
+	cases := map[string]string{
+		"http://10.0.0.1:8080/zstack/v1/api-jobs/abc": p.baseURL() + "/zstack/v1/api-jobs/abc",
+		"http://example.com/other":                    "http://example.com/other",
+		"/zstack/v1/api-jobs/abc":                     "/zstack/v1/api-jobs/abc",
+	}
+	for in, want := range cases {
+		if got := p.proxiedURL(in); got != want {
+			t.Errorf("proxiedURL(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
+
+func TestAPIProxy_ForwardsOverHTTPS(t *testing.T) {
+	backend := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"path":%q}`, r.URL.Path)
+	}))
+	defer backend.Close()
+	previous := apiProxyBaseTransport
+	apiProxyBaseTransport = backend.Client().Transport
+	t.Cleanup(func() { apiProxyBaseTransport = previous })
+
+	config := apiProxyConfig{Scheme: "https"}
+	if !config.enabled() {
+		t.Fatal("https endpoints must be reached through the proxy")
+	}
+	proxy := startTestAPIProxy(t, backend, config)
+	if proxy.target.Scheme != "https" {
+		t.Fatalf("expected an https target, got %s", proxy.target)
+	}
+
+	resp, err := http.Get(proxy.baseURL() + "/zstack/v1/zones")
+	if err != nil {
+		t.Fatalf("request through proxy failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != `{"path":"/zstack/v1/zones"}` {
+		t.Errorf("request was not forwarded over https: %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestAPIProxy_CloseStopsListener(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer backend.Close()
+	logFile := filepath.Join(t.TempDir(), "api.log")
+	proxy := startTestAPIProxy(t, backend, apiProxyConfig{APILogFile: logFile})
+
+	if err := proxy.Close(); err != nil {
+		t.Fatalf("unexpected error closing the proxy: %v", err)
+	}
+	if _, err := http.Get(proxy.baseURL() + "/zstack/v1/zones"); err == nil {
+		t.Error("the proxy should not accept requests after Close")
+	}
+	if err := proxy.auditLog.file.Close(); err == nil {
+		t.Error("the audit log should be closed with the proxy")
+	}
+}
+
+func TestProvider_ReconfigureClosesPreviousAPIProxy(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer backend.Close()
+	first := startTestAPIProxy(t, backend, apiProxyConfig{MaxConcurrentRequests: 1})
+	second := startTestAPIProxy(t, backend, apiProxyConfig{MaxConcurrentRequests: 1})
+	p := &ZStackProvider{}
+
+	p.replaceAPIProxy(context.Background(), first)
+	p.replaceAPIProxy(context.Background(), second)
+	if _, err := http.Get(first.baseURL() + "/zstack/v1/zones"); err == nil {
+		t.Error("the proxy of the previous Configure should be closed")
+	}
+	resp, err := http.Get(second.baseURL() + "/zstack/v1/zones")
+	if err != nil {
+		t.Fatalf("the current proxy should keep serving: %v", err)
+	}
+	resp.Body.Close()
+
+	p.replaceAPIProxy(context.Background(), nil)
+	if _, err := http.Get(second.baseURL() + "/zstack/v1/zones"); err == nil {
+		t.Error("the proxy should be closed when Configure no longer needs one")
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultReadCacheTTL is how long a query result is reused when
+// read_cache_ttl is not set. It is kept short because waits for state changes
+// that no request of this provider instance caused poll through the cache.
+const defaultReadCacheTTL = 10 * time.Second
+
+const zstackAPIPrefix = "/zstack/v1/"
+
+var uuidSegmentPattern = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+
+// relatedCacheTypes lists resource types whose inventories change as a side
+// effect of mutating another type.
+var relatedCacheTypes = map[string][]string{
+	// Creating or destroying a VM instance creates or deletes its root volume
+	// and takes or frees IPs of its L3 networks.
+	"vm-instances": {"volumes", "l3-networks"},
+	"volumes":      {"vm-instances"},
+	// VIPs take IPs of their L3 network; EIPs create a VIP and bind a VM NIC.
+	"vips": {"l3-networks"},
+	"eips": {"vips", "vm-instances"},
+}
+
+// readCache holds the results of ZStack query APIs for one provider
+// instance, so the many data sources and findResourceByQuery lookups of a
+// large configuration share one API call per distinct query.
+//
+// Every GET is cached except async job polls, which always reach the
+// management node. Entries are keyed by path and query string and grouped by
+// resource type, the first path segment after /zstack/v1/. Any other request
+// invalidates the types named in its path and their related types, once when
+// it is sent and again when its async job finishes, so a resource reading or
+// adopting its own object after a write never sees the cached result.
+type readCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+	// generations counts invalidations per resource type, so a query that
+	// was in flight during an invalidation is not stored.
+	generations map[string]uint64
+	// jobs maps the UUID of a running async job to the types it mutates.
+	jobs map[string][]string
+}
+
+type readCacheEntry struct {
+	resourceType string
+	// ready is closed once the first request for the key has completed.
+	ready   chan struct{}
+	resp    *cachedResponse
+	expires time.Time
+}
+
+type cachedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{
+		ttl:         ttl,
+		entries:     make(map[string]*readCacheEntry),
+		generations: make(map[string]uint64),
+		jobs:        make(map[string][]string),
+	}
+}
+
+// invalidate drops the cached queries of the given resource types.
+func (c *readCache) invalidate(resourceTypes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, t := range resourceTypes {
+		c.generations[t]++
+	}
+	for key, entry := range c.entries {
+		for _, t := range resourceTypes {
+			if entry.resourceType == t {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+func (c *readCache) trackJob(jobUuid string, resourceTypes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.jobs[jobUuid] = resourceTypes
+}
+
+// finishJob invalidates the types mutated by a finished async job.
+func (c *readCache) finishJob(jobUuid string) {
+	c.mu.Lock()
+	resourceTypes, ok := c.jobs[jobUuid]
+	delete(c.jobs, jobUuid)
+	c.mu.Unlock()
+
+	if ok {
+		c.invalidate(resourceTypes)
+	}
+}
+
+// cachedResourceType returns the resource type of a cacheable GET path, or
+// "" when the path must not be cached.
+func cachedResourceType(path string) string {
+	if !strings.HasPrefix(path, zstackAPIPrefix) {
+		return ""
+	}
+	resourceType, _, _ := strings.Cut(strings.Trim(strings.TrimPrefix(path, zstackAPIPrefix), "/"), "/")
+	if resourceType == "" || resourceType == "api-jobs" {
+		return ""
+	}
+	return resourceType
+}
+
+// mutatedResourceTypes returns the resource types a non-GET request may
+// change: every resource segment of its path, e.g. "volumes" and
+// "vm-instances" for attaching a volume to a VM instance, and their related
+// types.
+func mutatedResourceTypes(path string) []string {
+	if !strings.HasPrefix(path, zstackAPIPrefix) {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var resourceTypes []string
+	add := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			resourceTypes = append(resourceTypes, t)
+		}
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(path, zstackAPIPrefix), "/") {
+		if segment == "" || segment == "actions" || uuidSegmentPattern.MatchString(segment) {
+			continue
+		}
+		add(segment)
+		for _, related := range relatedCacheTypes[segment] {
+			add(related)
+		}
+	}
+	return resourceTypes
+}
+
+// cachingTransport serves repeated queries of the API proxy from a
+// readCache.
+type cachingTransport struct {
+	next  http.RoundTripper
+	cache *readCache
+	// logCtx carries the provider logger; proxy requests have no Terraform
+	// context of their own.
+	logCtx context.Context
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.mutate(req)
+	}
+
+	if m := jobUuidPattern.FindStringSubmatch(req.URL.Path); m != nil {
+		resp, err := t.next.RoundTrip(req)
+		if err == nil && resp.StatusCode != http.StatusAccepted {
+			t.cache.finishJob(m[1])
+		}
+		return resp, err
+	}
+
+	resourceType := cachedResourceType(req.URL.Path)
+	if resourceType == "" {
+		return t.next.RoundTrip(req)
+	}
+	return t.query(req, resourceType)
+}
+
+func (t *cachingTransport) mutate(req *http.Request) (*http.Response, error) {
+	resourceTypes := mutatedResourceTypes(req.URL.Path)
+	t.cache.invalidate(resourceTypes)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || len(resourceTypes) == 0 {
+		return resp, err
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		// Synchronous APIs have finished; drop anything cached meanwhile.
+		t.cache.invalidate(resourceTypes)
+		return resp, nil
+	}
+
+	// Async APIs finish when their job does.
+	location := resp.Header.Get("Location")
+	if location == "" {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		location = string(data)
+	}
+	if m := jobUuidPattern.FindStringSubmatch(location); m != nil {
+		t.cache.trackJob(m[1], resourceTypes)
+	}
+	return resp, nil
+}
+
+func (t *cachingTransport) query(req *http.Request, resourceType string) (*http.Response, error) {
+	key := req.URL.Path + "?" + req.URL.RawQuery
+
+	for {
+		t.cache.mu.Lock()
+		entry, ok := t.cache.entries[key]
+		if !ok {
+			break
+		}
+		t.cache.mu.Unlock()
+
+		select {
+		case <-entry.ready:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		t.cache.mu.Lock()
+		if entry.resp != nil && time.Now().Before(entry.expires) {
+			cached := entry.resp
+			t.cache.mu.Unlock()
+			tflog.Debug(t.logCtx, "ZStack API query served from the read cache", map[string]any{"path": req.URL.Path})
+			return cached.response(req), nil
+		}
+		// Expired, or the first request failed: fetch again.
+		if t.cache.entries[key] == entry {
+			delete(t.cache.entries, key)
+		}
+		t.cache.mu.Unlock()
+	}
+
+	entry := &readCacheEntry{resourceType: resourceType, ready: make(chan struct{})}
+	t.cache.entries[key] = entry
+	generation := t.cache.generations[resourceType]
+	t.cache.mu.Unlock()
+
+	resp, err := t.next.RoundTrip(req)
+
+	var cached *cachedResponse
+	if err == nil && resp.StatusCode == http.StatusOK {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			resp, err = nil, readErr
+		} else {
+			cached = &cachedResponse{status: resp.StatusCode, header: resp.Header.Clone(), body: data}
+			resp = cached.response(req)
+		}
+	}
+
+	t.cache.mu.Lock()
+	if cached != nil && t.cache.generations[resourceType] == generation {
+		entry.resp = cached
+		entry.expires = time.Now().Add(t.cache.ttl)
+	} else if t.cache.entries[key] == entry {
+		delete(t.cache.entries, key)
+	}
+	close(entry.ready)
+	t.cache.mu.Unlock()
+
+	return resp, err
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.status, http.StatusText(c.status)),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingBackend struct {
+	*httptest.Server
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newCountingBackend(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *countingBackend {
+	t.Helper()
+	b := &countingBackend{calls: make(map[string]int)}
+	b.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b.mu.Lock()
+		b.calls[r.Method+" "+r.URL.RequestURI()]++
+		b.mu.Unlock()
+		if handler != nil {
+			handler(w, r)
+			return
+		}
+		io.WriteString(w, `{"inventories":[]}`)
+	}))
+	t.Cleanup(b.Close)
+	return b
+}
+
+func (b *countingBackend) count(key string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.calls[key]
+}
+
+func proxyGet(t *testing.T, proxy *apiProxy, path string) {
+	t.Helper()
+	resp, err := http.Get(proxy.baseURL() + path)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", path, err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s: unexpected status %d", path, resp.StatusCode)
+	}
+}
+
+func proxyDo(t *testing.T, proxy *apiProxy, method, path string) {
+	t.Helper()
+	req, _ := http.NewRequest(method, proxy.baseURL()+path, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+const testL3Query = "/zstack/v1/l3-networks?q=zoneUuid=6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f"
+
+func TestReadCache_ServesRepeatedQueries(t *testing.T) {
+	backend := newCountingBackend(t, nil)
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	for i := 0; i < 3; i++ {
+		proxyGet(t, proxy, testL3Query)
+	}
+	proxyGet(t, proxy, "/zstack/v1/l3-networks?q=name=public")
+
+	if n := backend.count("GET " + testL3Query); n != 1 {
+		t.Errorf("expected 1 call for the repeated query, got %d", n)
+	}
+	if n := backend.count("GET /zstack/v1/l3-networks?q=name=public"); n != 1 {
+		t.Errorf("a different query must not share the entry, got %d calls", n)
+	}
+}
+
+func TestReadCache_UuidLookupsShareCacheUntilMutated(t *testing.T) {
+	backend := newCountingBackend(t, nil)
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	// findResourceByQuery and GETs by UUID are served from the cache.
+	const query = "/zstack/v1/l3-networks?q=uuid=6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f"
+	const get = "/zstack/v1/vm-instances/6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f"
+	proxyGet(t, proxy, query)
+	proxyGet(t, proxy, query)
+	proxyGet(t, proxy, get)
+	proxyGet(t, proxy, get)
+	if n := backend.count("GET " + query); n != 1 {
+		t.Errorf("expected 1 call for the repeated uuid query, got %d", n)
+	}
+	if n := backend.count("GET " + get); n != 1 {
+		t.Errorf("expected 1 call for the repeated GET by UUID, got %d", n)
+	}
+
+	// A write to the resource is seen by its next read.
+	proxyDo(t, proxy, http.MethodPut, "/zstack/v1/vm-instances/6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f/actions")
+	proxyGet(t, proxy, get)
+	if n := backend.count("GET " + get); n != 2 {
+		t.Errorf("a write must invalidate reads of the same resource, got %d calls", n)
+	}
+}
+
+func TestReadCache_AsyncJobPollsAreNotCached(t *testing.T) {
+	backend := newCountingBackend(t, nil)
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	const path = "/zstack/v1/api-jobs/6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f"
+	proxyGet(t, proxy, path)
+	proxyGet(t, proxy, path)
+
+	if n := backend.count("GET " + path); n != 2 {
+		t.Errorf("async job polls must always reach the API, got %d calls", n)
+	}
+}
+
+func TestReadCache_Expires(t *testing.T) {
+	backend := newCountingBackend(t, nil)
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: 10 * time.Millisecond})
+
+	proxyGet(t, proxy, testL3Query)
+	time.Sleep(20 * time.Millisecond)
+	proxyGet(t, proxy, testL3Query)
+
+	if n := backend.count("GET " + testL3Query); n != 2 {
+		t.Errorf("expired entries must be fetched again, got %d calls", n)
+	}
+}
+
+func TestReadCache_InvalidatedBySyncMutation(t *testing.T) {
+	backend := newCountingBackend(t, nil)
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	proxyGet(t, proxy, testL3Query)
+	proxyGet(t, proxy, "/zstack/v1/images")
+	proxyDo(t, proxy, http.MethodDelete, "/zstack/v1/l3-networks/0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c")
+	proxyGet(t, proxy, testL3Query)
+	proxyGet(t, proxy, "/zstack/v1/images")
+
+	if n := backend.count("GET " + testL3Query); n != 2 {
+		t.Errorf("mutating an L3 network must invalidate L3 queries, got %d calls", n)
+	}
+	if n := backend.count("GET /zstack/v1/images"); n != 1 {
+		t.Errorf("other types must stay cached, got %d calls", n)
+	}
+}
+
+func TestReadCache_InvalidatedWhenAsyncJobFinishes(t *testing.T) {
+	const job = "0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c"
+	var jobDone atomic.Bool
+	var backend *countingBackend
+	backend = newCountingBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			w.Header().Set("Location", backend.URL+"/zstack/v1/api-jobs/"+job)
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, `{"location": "%s/zstack/v1/api-jobs/%s"}`, backend.URL, job)
+		case r.URL.Path == "/zstack/v1/api-jobs/"+job && !jobDone.Load():
+			w.WriteHeader(http.StatusAccepted)
+			io.WriteString(w, `{}`)
+		default:
+			io.WriteString(w, `{"inventories":[]}`)
+		}
+	})
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	proxyDo(t, proxy, http.MethodPost, "/zstack/v1/vm-instances")
+	// Queried while the job runs: cached, but must not outlive the job.
+	proxyGet(t, proxy, "/zstack/v1/volumes")
+	proxyDo(t, proxy, http.MethodGet, "/zstack/v1/api-jobs/"+job)
+	proxyGet(t, proxy, "/zstack/v1/volumes")
+	if n := backend.count("GET /zstack/v1/volumes"); n != 1 {
+		t.Fatalf("expected the query to be cached while the job runs, got %d calls", n)
+	}
+
+	jobDone.Store(true)
+	proxyDo(t, proxy, http.MethodGet, "/zstack/v1/api-jobs/"+job)
+	proxyGet(t, proxy, "/zstack/v1/volumes")
+	if n := backend.count("GET /zstack/v1/volumes"); n != 2 {
+		t.Errorf("a finished VM create must invalidate volume queries, got %d calls", n)
+	}
+}
+
+func TestReadCache_CoalescesConcurrentQueries(t *testing.T) {
+	release := make(chan struct{})
+	backend := newCountingBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		io.WriteString(w, `{"inventories":[]}`)
+	})
+	proxy := startTestAPIProxy(t, backend.Server, apiProxyConfig{ReadCacheTTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			proxyGet(t, proxy, testL3Query)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := backend.count("GET " + testL3Query); n != 1 {
+		t.Errorf("concurrent identical queries should share one call, got %d", n)
+	}
+}
+
+func TestReadCache_InFlightQueryNotStoredAfterInvalidation(t *testing.T) {
+	cache := newReadCache(time.Minute)
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	calls := 0
+	transport := &cachingTransport{
+		cache: cache,
+		next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				close(started)
+				<-finish
+			}
+			return (&cachedResponse{status: http.StatusOK, header: http.Header{}, body: []byte(`{}`)}).response(req), nil
+		}),
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1"+testL3Query, nil)
+		transport.RoundTrip(req)
+	}()
+	<-started
+	cache.invalidate([]string{"l3-networks"})
+	close(finish)
+	<-done
+
+	req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1"+testL3Query, nil)
+	transport.RoundTrip(req)
+	if calls != 2 {
+		t.Errorf("a result fetched across an invalidation must not be cached, got %d calls", calls)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMutatedResourceTypes(t *testing.T) {
+	cases := map[string][]string{
+		"/zstack/v1/volumes/0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c/vm-instances/6dc2c1e0e0a04d1b8b9e3b1f1c2d3e4f": {"volumes", "vm-instances", "l3-networks"},
+		"/zstack/v1/l3-networks/0b1e6a5c3f0d4a8e9c2b7d6e5f4a3b2c/actions":                                   {"l3-networks"},
+		"/zstack/v1/accounts/login": {"accounts", "login"},
+		"/other":                    nil,
+	}
+	for path, want := range cases {
+		if got := mutatedResourceTypes(path); !reflect.DeepEqual(got, want) {
+			t.Errorf("mutatedResourceTypes(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	loginTypeAccount       = "account"
+	loginTypeIAM2VirtualID = "iam2_virtual_id"
+	loginTypeLdap          = "ldap"
+)
+
+// sessionAuthenticator performs the session logins that the SDK client does
+// not expose (IAM2 virtual ID, LDAP and IAM2 project scoping). It talks to the
+// ZStack REST API directly, never through the local API proxy, so credentials
+// travel with the scheme of the management node endpoint. It only returns
+// session UUIDs; the provider then hands the session to the SDK client.
+type sessionAuthenticator struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newSessionAuthenticator(scheme, host string, port int) *sessionAuthenticator {
+	return &sessionAuthenticator{
+		baseURL:    scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port)) + "/zstack",
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// sessionFor logs in with the given login type and, when a project is
+// requested, exchanges the session for one scoped to that project.
+//
+// Only IAM2 virtual ID sessions can be exchanged. An LDAP login signs in as
+// the identity the LDAP entry is bound to, so for a project login the entry
+// must be bound to an IAM2 virtual ID; an entry bound to a platform account is
+// rejected before the project login is attempted.
+func (a *sessionAuthenticator) sessionFor(ctx context.Context, loginType, name, password, projectUuid, projectName string) (string, error) {
+	var session sessionInventory
+	var err error
+
+	switch loginType {
+	case loginTypeIAM2VirtualID:
+		session, err = a.loginIAM2VirtualID(ctx, name, password)
+	case loginTypeLdap:
+		session, err = a.loginLdap(ctx, name, password)
+		if err == nil && (projectUuid != "" || projectName != "") {
+			err = a.checkLdapVirtualID(ctx, session, name)
+		}
+	default:
+		return "", fmt.Errorf("login type %q does not use session login", loginType)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if projectName == "" && projectUuid != "" {
+		projectName, err = a.projectName(ctx, session.UUID, projectUuid)
+		if err != nil {
+			return "", err
+		}
+	}
+	if projectName == "" {
+		return session.UUID, nil
+	}
+
+	return a.loginProject(ctx, session.UUID, projectName)
+}
+
+// loginIAM2VirtualID logs in with an IAM2 virtual ID and returns the session.
+// Like the ZStack UI, the password is sent as its SHA-512 hex digest.
+func (a *sessionAuthenticator) loginIAM2VirtualID(ctx context.Context, name, password string) (sessionInventory, error) {
+	body := map[string]any{
+		"loginIAM2VirtualID": map[string]string{
+			"name":     name,
+			"password": sha512Hex(password),
+		},
+	}
+	return a.loginSession(ctx, "", "v1/iam2/virtual-ids/login", body)
+}
+
+// loginLdap logs in with an LDAP uid and returns the session. LDAP passwords
+// are verified by the directory server, so they are sent as-is.
+func (a *sessionAuthenticator) loginLdap(ctx context.Context, uid, password string) (sessionInventory, error) {
+	body := map[string]any{
+		"logInByLdap": map[string]string{
+			"uid":      uid,
+			"password": password,
+		},
+	}
+	return a.loginSession(ctx, "", "v1/ldap/login", body)
+}
+
+// checkLdapVirtualID makes sure an LDAP session belongs to an IAM2 virtual
+// ID. The session user is the identity the LDAP entry is bound to: a virtual
+// ID when the entry is bound to one, which the project login then accepts
+// like a virtual ID login, and otherwise a platform account.
+func (a *sessionAuthenticator) checkLdapVirtualID(ctx context.Context, session sessionInventory, uid string) error {
+	var out struct {
+		Inventories []struct {
+			UUID string `json:"uuid"`
+		} `json:"inventories"`
+	}
+	if session.UserUUID != "" {
+		if err := a.do(ctx, http.MethodGet, session.UUID, "v1/iam2/virtual-ids/"+url.PathEscape(session.UserUUID), nil, &out); err != nil {
+			return err
+		}
+	}
+	if len(out.Inventories) == 0 {
+		return fmt.Errorf("LDAP uid %s is not bound to an IAM2 virtual ID, so it cannot log in to an IAM2 project; bind the LDAP entry to a virtual ID that is a member of the project", uid)
+	}
+	return nil
+}
+
+// loginProject exchanges a virtual ID session for a session scoped to the
+// named IAM2 project. Resources created with the returned session belong to
+// that project.
+func (a *sessionAuthenticator) loginProject(ctx context.Context, session, projectName string) (string, error) {
+	body := map[string]any{
+		"loginIAM2Project": map[string]string{
+			"projectName": projectName,
+		},
+	}
+	return a.login(ctx, session, "v1/iam2/projects/login", body)
+}
+
+// projectName resolves an IAM2 project UUID to its name, which is what the
+// project login API expects.
+func (a *sessionAuthenticator) projectName(ctx context.Context, session, projectUuid string) (string, error) {
+	var out struct {
+		Inventories []struct {
+			UUID string `json:"uuid"`
+			Name string `json:"name"`
+		} `json:"inventories"`
+	}
+	if err := a.do(ctx, http.MethodGet, session, "v1/iam2/projects/"+url.PathEscape(projectUuid), nil, &out); err != nil {
+		return "", err
+	}
+	if len(out.Inventories) == 0 || out.Inventories[0].Name == "" {
+		return "", fmt.Errorf("IAM2 project %s not found or not visible to this virtual ID", projectUuid)
+	}
+	return out.Inventories[0].Name, nil
+}
+
+// sessionInventory is the part of a ZStack session inventory the logins use.
+// UserUUID is the virtual ID, account or user the session belongs to.
+type sessionInventory struct {
+	UUID     string `json:"uuid"`
+	UserUUID string `json:"userUuid"`
+}
+
+func (a *sessionAuthenticator) login(ctx context.Context, session, path string, body any) (string, error) {
+	inventory, err := a.loginSession(ctx, session, path, body)
+	return inventory.UUID, err
+}
+
+func (a *sessionAuthenticator) loginSession(ctx context.Context, session, path string, body any) (sessionInventory, error) {
+	var out struct {
+		Inventory sessionInventory `json:"inventory"`
+	}
+	if err := a.do(ctx, http.MethodPut, session, path, body, &out); err != nil {
+		return sessionInventory{}, err
+	}
+	if out.Inventory.UUID == "" {
+		return sessionInventory{}, fmt.Errorf("%s returned no session", path)
+	}
+	return out.Inventory, nil
+}
+
+func (a *sessionAuthenticator) do(ctx context.Context, method, session, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+"/"+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if session != "" {
+		req.Header.Set("Authorization", "OAuth "+session)
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var failure struct {
+			Error struct {
+				Code        string `json:"code"`
+				Description string `json:"description"`
+				Details     string `json:"details"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(data, &failure) == nil && failure.Error.Code != "" {
+			return fmt.Errorf("%s %s failed with status code %d: [%s] %s %s",
+				method, path, resp.StatusCode, failure.Error.Code, failure.Error.Description, failure.Error.Details)
+		}
+		return fmt.Errorf("%s %s failed with status code %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// loginSession holds the session of an IAM2 virtual ID or LDAP login for the
+// lifetime of the provider and logs in again when ZStack reports that it
+// expired.
+type loginSession struct {
+	mu      sync.Mutex
+	session string
+	login   func(ctx context.Context) (string, error)
+}
+
+// newLoginSession logs in once with login and returns the session holder.
+func newLoginSession(ctx context.Context, login func(ctx context.Context) (string, error)) (*loginSession, error) {
+	session, err := login(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &loginSession{session: session, login: login}, nil
+}
+
+func (s *loginSession) current() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.session
+}
+
+// renew logs in again unless another request already replaced the expired
+// session, and returns the session to retry with.
+func (s *loginSession) renew(ctx context.Context, expired string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.session != expired {
+		return s.session, nil
+	}
+	session, err := s.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.session = session
+	return session, nil
+}
+
+// sessionTransport authenticates every proxied request with the current login
+// session, whatever session the SDK client was configured with. When ZStack
+// rejects a request because the session expired, it logs in again and retries
+// the request once; ZStack checks the session before running an API, so the
+// rejected request had no effect.
+type sessionTransport struct {
+	next    http.RoundTripper
+	session *loginSession
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	session := t.session.current()
+	resp, err := t.next.RoundTrip(withSession(req, session, body))
+	if err != nil || !isSessionExpiredResponse(resp) {
+		return resp, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	session, err = t.session.renew(req.Context(), session)
+	if err != nil {
+		return nil, fmt.Errorf("the ZStack session expired and logging in again failed: %w", err)
+	}
+	return t.next.RoundTrip(withSession(req, session, body))
+}
+
+// withSession returns a copy of req with body and the given session.
+func withSession(req *http.Request, session string, body []byte) *http.Request {
+	out := req.Clone(req.Context())
+	out.Header.Set("Authorization", "OAuth "+session)
+	if body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.ContentLength = int64(len(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	return out
+}
+
+// isSessionExpiredResponse reports whether ZStack rejected a request because
+// its session is invalid or expired (HTTP 401, or error code ID.1001). The
+// response body is restored so it can still be read when it is not.
+func isSessionExpiredResponse(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.StatusCode < 400 || resp.Body == nil {
+		return false
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+
+	var failure struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	return json.Unmarshal(data, &failure) == nil && failure.Error.Code == "ID.1001"
+}
+
+func sha512Hex(s string) string {
+	sum := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,334 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeZStackAuth is a local stand-in for the ZStack management node login APIs.
+type fakeZStackAuth struct {
+	mu       sync.Mutex
+	requests []fakeAuthRequest
+}
+
+type fakeAuthRequest struct {
+	method        string
+	path          string
+	authorization string
+	body          map[string]map[string]string
+}
+
+func (f *fakeZStackAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	f.mu.Lock()
+	f.requests = append(f.requests, fakeAuthRequest{
+		method:        r.Method,
+		path:          r.URL.Path,
+		authorization: r.Header.Get("Authorization"),
+		body:          body,
+	})
+	f.mu.Unlock()
+
+	writeJSON := func(status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	session := func(uuid string) map[string]any {
+		return map[string]any{"inventory": map[string]string{"uuid": uuid}}
+	}
+	// ldap-user is bound to the IAM2 virtual ID vid-1 and ldap-admin to a
+	// platform account.
+	ldapUsers := map[string]string{"ldap-user": "vid-1", "ldap-admin": "account-1"}
+
+	switch {
+	case r.Method == http.MethodPut && r.URL.Path == "/zstack/v1/iam2/virtual-ids/login":
+		if body["loginIAM2VirtualID"]["name"] != "tenant" || body["loginIAM2VirtualID"]["password"] != sha512Hex("secret") {
+			writeJSON(http.StatusBadRequest, map[string]any{"error": map[string]string{
+				"code": "ID.1001", "description": "wrong virtual ID name or password",
+			}})
+			return
+		}
+		writeJSON(http.StatusOK, session("vid-session"))
+	case r.Method == http.MethodPut && r.URL.Path == "/zstack/v1/ldap/login":
+		userUuid, ok := ldapUsers[body["logInByLdap"]["uid"]]
+		if !ok || body["logInByLdap"]["password"] != "ldap-secret" {
+			writeJSON(http.StatusBadRequest, map[string]any{"error": map[string]string{
+				"code": "LDAP.1000", "description": "ldap authentication failed",
+			}})
+			return
+		}
+		writeJSON(http.StatusOK, map[string]any{"inventory": map[string]string{"uuid": "ldap-session", "userUuid": userUuid}})
+	case r.Method == http.MethodGet && r.URL.Path == "/zstack/v1/iam2/virtual-ids/vid-1":
+		writeJSON(http.StatusOK, map[string]any{"inventories": []map[string]string{{"uuid": "vid-1", "name": "tenant"}}})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/zstack/v1/iam2/virtual-ids/"):
+		writeJSON(http.StatusOK, map[string]any{"inventories": []map[string]string{}})
+	case r.Method == http.MethodGet && r.URL.Path == "/zstack/v1/iam2/projects/project-uuid-1":
+		writeJSON(http.StatusOK, map[string]any{"inventories": []map[string]string{{"uuid": "project-uuid-1", "name": "tenant-project"}}})
+	case r.Method == http.MethodPut && r.URL.Path == "/zstack/v1/iam2/projects/login":
+		if body["loginIAM2Project"]["projectName"] != "tenant-project" {
+			writeJSON(http.StatusBadRequest, map[string]any{"error": map[string]string{
+				"code": "IAM2.1002", "description": "not a member of the project",
+			}})
+			return
+		}
+		writeJSON(http.StatusOK, session("project-session:"+strings.TrimPrefix(r.Header.Get("Authorization"), "OAuth ")))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeAuthenticator(t *testing.T) (*sessionAuthenticator, *fakeZStackAuth) {
+	t.Helper()
+	fake := &fakeZStackAuth{}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	host, portStr, err := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	return newSessionAuthenticator("http", host, port), fake
+}
+
+func TestSessionAuthenticator_IAM2VirtualID(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	session, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "secret", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "vid-session" {
+		t.Errorf("expected vid-session, got %q", session)
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("expected a single login request, got %d", len(fake.requests))
+	}
+	if fake.requests[0].body["loginIAM2VirtualID"]["password"] == "secret" {
+		t.Error("virtual ID password must not be sent in clear text")
+	}
+}
+
+func TestSessionAuthenticator_IAM2VirtualIDWrongPassword(t *testing.T) {
+	auth, _ := newFakeAuthenticator(t)
+
+	_, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "wrong", "", "")
+	if err == nil {
+		t.Fatal("expected login failure")
+	}
+	if !strings.Contains(err.Error(), "ID.1001") || !strings.Contains(err.Error(), "wrong virtual ID name or password") {
+		t.Errorf("error should carry the ZStack error code and description, got: %v", err)
+	}
+}
+
+func TestSessionAuthenticator_ProjectByName(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	session, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "secret", "", "tenant-project")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "project-session:vid-session" {
+		t.Errorf("project login should exchange the virtual ID session, got %q", session)
+	}
+	last := fake.requests[len(fake.requests)-1]
+	if last.authorization != "OAuth vid-session" {
+		t.Errorf("project login should authenticate with the virtual ID session, got %q", last.authorization)
+	}
+}
+
+func TestSessionAuthenticator_ProjectByUuid(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	session, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "secret", "project-uuid-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "project-session:vid-session" {
+		t.Errorf("unexpected session %q", session)
+	}
+	if len(fake.requests) != 3 {
+		t.Fatalf("expected login, project lookup and project login, got %d requests", len(fake.requests))
+	}
+	if fake.requests[1].method != http.MethodGet || fake.requests[1].authorization != "OAuth vid-session" {
+		t.Errorf("project lookup should be an authenticated GET, got %+v", fake.requests[1])
+	}
+}
+
+func TestSessionAuthenticator_UnknownProjectUuid(t *testing.T) {
+	auth, _ := newFakeAuthenticator(t)
+
+	if _, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "secret", "missing", ""); err == nil {
+		t.Fatal("expected an error for an unknown project uuid")
+	}
+}
+
+func TestSessionAuthenticator_Ldap(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	session, err := auth.sessionFor(context.Background(), loginTypeLdap, "ldap-user", "ldap-secret", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "ldap-session" {
+		t.Errorf("unexpected session %q", session)
+	}
+	if len(fake.requests) != 1 || fake.requests[0].path != "/zstack/v1/ldap/login" {
+		t.Errorf("expected a single LDAP login, got %+v", fake.requests)
+	}
+}
+
+func TestSessionAuthenticator_LdapProject(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	session, err := auth.sessionFor(context.Background(), loginTypeLdap, "ldap-user", "ldap-secret", "project-uuid-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "project-session:ldap-session" {
+		t.Errorf("project login should exchange the LDAP session, got %q", session)
+	}
+	var paths []string
+	for _, req := range fake.requests {
+		paths = append(paths, req.method+" "+req.path)
+	}
+	want := []string{
+		"PUT /zstack/v1/ldap/login",
+		"GET /zstack/v1/iam2/virtual-ids/vid-1",
+		"GET /zstack/v1/iam2/projects/project-uuid-1",
+		"PUT /zstack/v1/iam2/projects/login",
+	}
+	if strings.Join(paths, "|") != strings.Join(want, "|") {
+		t.Errorf("expected LDAP login, virtual ID lookup, project lookup and project login, got %q", paths)
+	}
+}
+
+func TestSessionAuthenticator_LdapProjectWithoutVirtualID(t *testing.T) {
+	auth, fake := newFakeAuthenticator(t)
+
+	_, err := auth.sessionFor(context.Background(), loginTypeLdap, "ldap-admin", "ldap-secret", "", "tenant-project")
+	if err == nil || !strings.Contains(err.Error(), "not bound to an IAM2 virtual ID") {
+		t.Fatalf("expected an error for an LDAP entry bound to a platform account, got %v", err)
+	}
+	for _, req := range fake.requests {
+		if req.path == "/zstack/v1/iam2/projects/login" {
+			t.Error("project login should not be attempted")
+		}
+	}
+}
+
+func TestSessionAuthenticator_HTTPS(t *testing.T) {
+	fake := &fakeZStackAuth{}
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+
+	host, portStr, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "https://"))
+	port, _ := strconv.Atoi(portStr)
+	auth := newSessionAuthenticator("https", host, port)
+	auth.httpClient = server.Client()
+
+	session, err := auth.sessionFor(context.Background(), loginTypeIAM2VirtualID, "tenant", "secret", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session != "vid-session" {
+		t.Errorf("expected vid-session, got %q", session)
+	}
+}
+
+func TestSessionAuthenticator_AccountLoginTypeRejected(t *testing.T) {
+	auth, _ := newFakeAuthenticator(t)
+
+	if _, err := auth.sessionFor(context.Background(), loginTypeAccount, "admin", "password", "", ""); err == nil {
+		t.Fatal("account logins are handled by the SDK and should be rejected here")
+	}
+}
+
+func TestSessionTransport_RenewsExpiredSession(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Authorization")+" "+string(body))
+		mu.Unlock()
+		if r.Header.Get("Authorization") != "OAuth session-2" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, `{"error":{"code":"ID.1001","description":"Session expired"}}`)
+			return
+		}
+		io.WriteString(w, `{"inventory":{}}`)
+	}))
+	defer backend.Close()
+
+	logins := 0
+	session, err := newLoginSession(context.Background(), func(context.Context) (string, error) {
+		logins++
+		return "session-" + strconv.Itoa(logins), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &sessionTransport{next: http.DefaultTransport, session: session}}
+
+	req, _ := http.NewRequest(http.MethodPost, backend.URL+"/zstack/v1/zones", strings.NewReader(`{"params":{}}`))
+	req.Header.Set("Authorization", "OAuth stale-sdk-session")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the retried request to succeed, got status %d", resp.StatusCode)
+	}
+	if logins != 2 {
+		t.Errorf("expected one renewal after the initial login, got %d logins", logins)
+	}
+	want := []string{`OAuth session-1 {"params":{}}`, `OAuth session-2 {"params":{}}`}
+	if strings.Join(seen, "|") != strings.Join(want, "|") {
+		t.Errorf("expected the request to be retried with the renewed session and same body, got %q", seen)
+	}
+}
+
+func TestSessionTransport_PassesOtherErrorsThrough(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		io.WriteString(w, `{"error":{"code":"SYS.1000","description":"internal error"}}`)
+	}))
+	defer backend.Close()
+
+	logins := 0
+	session, _ := newLoginSession(context.Background(), func(context.Context) (string, error) {
+		logins++
+		return "session", nil
+	})
+	client := &http.Client{Transport: &sessionTransport{next: http.DefaultTransport, session: session}}
+
+	resp, err := client.Get(backend.URL + "/zstack/v1/zones")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if logins != 1 {
+		t.Errorf("other errors should not renew the session, got %d logins", logins)
+	}
+	if !strings.Contains(string(body), "SYS.1000") {
+		t.Errorf("error body should reach the caller unchanged, got %s", body)
+	}
+}
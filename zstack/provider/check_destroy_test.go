@@ -708,3 +708,16 @@ var testAccCheckBackupStorageDestroy = testAccCheckResourceDestroyByGet("zstack_
 	_, err := cli.GetBackupStorage(id)
 	return err
 })
+
+var testAccCheckL2VxlanNetworkPoolDestroy = testAccCheckResourceDestroyByQuery("zstack_l2vxlan_network_pool", func(cli *client.ZSClient, q *param.QueryParam) ([]view.L2VxlanNetworkPoolInventoryView, error) {
+	return cli.QueryL2VxlanNetworkPool(q)
+})
+
+var testAccCheckL2NetworkDestroy = testAccCheckResourceDestroyByGet("zstack_l2_network", func(cli *client.ZSClient, id string) error {
+	_, err := cli.GetL2Network(id)
+	return err
+})
+
+var testAccCheckHostNetworkBondingDestroy = testAccCheckResourceDestroyByQuery("zstack_host_network_bonding", func(cli *client.ZSClient, q *param.QueryParam) ([]view.HostNetworkBondingInventoryView, error) {
+	return cli.QueryHostNetworkBonding(q)
+})
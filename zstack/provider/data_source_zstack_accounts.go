@@ -80,10 +80,12 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	accounts, err := d.client.QueryAccount(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack Accounts",
-			err.Error(),
-		)
+			"Could not query ZStack Accounts",
+			"QueryAccount",
+			err,
+		))
 		return
 	}
 
@@ -83,10 +83,12 @@ func (d *affinityGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	affinityGroups, err := d.client.QueryAffinityGroup(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack Affinity Groups",
-			err.Error(),
-		)
+			"Could not query ZStack Affinity Groups",
+			"QueryAffinityGroup",
+			err,
+		))
 		return
 	}
 
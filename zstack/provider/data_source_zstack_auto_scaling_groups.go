@@ -177,10 +177,12 @@ func (d *autoScalingGroupDataSource) Read(ctx context.Context, req datasource.Re
 
 	groups, err := d.client.QueryAutoScalingGroup(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Auto Scaling Groups",
-			err.Error(),
-		)
+			"Could not read ZStack Auto Scaling Groups",
+			"QueryAutoScalingGroup",
+			err,
+		))
 		return
 	}
 
@@ -88,10 +88,12 @@ func (d *backupStorageDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	backupstorages, err := d.client.QueryBackupStorage(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Backup Storages",
-			err.Error(),
-		)
+			"Could not read ZStack Backup Storages",
+			"QueryBackupStorage",
+			err,
+		))
 		return
 	}
 
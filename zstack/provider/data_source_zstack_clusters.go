@@ -164,10 +164,12 @@ func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	clusters, err := d.client.QueryCluster(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Clusters",
-			err.Error(),
-		)
+			"Could not read ZStack Clusters",
+			"QueryCluster",
+			err,
+		))
 		return
 	}
 	filters := make(map[string][]string)
@@ -87,10 +87,12 @@ func (d *diskOfferingDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	diskOffers, err := d.client.QueryDiskOffering(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read disk offers",
-			err.Error(),
-		)
+			"Could not read disk offers",
+			"QueryDiskOffering",
+			err,
+		))
 		return
 	}
 
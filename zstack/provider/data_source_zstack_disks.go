@@ -92,10 +92,12 @@ func (d *disksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	disks, err := d.client.QueryVolume(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read disks",
-			err.Error(),
-		)
+			"Could not read disks",
+			"QueryVolume",
+			err,
+		))
 		return
 	}
 
@@ -89,10 +89,12 @@ func (d *eipDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	eips, err := d.client.QueryEip(&params)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack EIPs",
-			err.Error(),
-		)
+			"Could not read ZStack EIPs",
+			"QueryEip",
+			err,
+		))
 		return
 	}
 
@@ -86,10 +86,12 @@ func (d *globalConfigsDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	configs, err := d.client.QueryGlobalConfig(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack Global Configs",
-			err.Error(),
-		)
+			"Could not query ZStack Global Configs",
+			"QueryGlobalConfig",
+			err,
+		))
 		return
 	}
 
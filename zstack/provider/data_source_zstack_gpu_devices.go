@@ -217,10 +217,12 @@ func (d *gpuDeviceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	gpus, err := d.client.QueryGpuDevice(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack GPU Devices",
-			err.Error(),
-		)
+			"Could not read ZStack GPU Devices",
+			"QueryGpuDevice",
+			err,
+		))
 		return
 	}
 
@@ -83,10 +83,12 @@ func (d *hookScriptsDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	hook_scripts, err := d.client.QueryVmUserDefinedXmlHookScript(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Hosts ",
-			err.Error(),
-		)
+			"Could not read ZStack Hosts",
+			"QueryVmUserDefinedXmlHookScript",
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,203 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ datasource.DataSource              = &hostNetworkInterfacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &hostNetworkInterfacesDataSource{}
+)
+
+type hostNetworkInterfacesDataSource struct {
+	client *client.ZSClient
+}
+
+type hostNetworkInterfacesDataSourceModel struct {
+	HostUuid      types.String                      `tfsdk:"host_uuid"`
+	InterfaceName types.String                      `tfsdk:"interface_name"`
+	Interfaces    []hostNetworkInterfacesEntryModel `tfsdk:"interfaces"`
+}
+
+type hostNetworkInterfacesEntryModel struct {
+	Uuid             types.String   `tfsdk:"uuid"`
+	HostUuid         types.String   `tfsdk:"host_uuid"`
+	InterfaceName    types.String   `tfsdk:"interface_name"`
+	InterfaceType    types.String   `tfsdk:"interface_type"`
+	Mac              types.String   `tfsdk:"mac"`
+	Speed            types.Int64    `tfsdk:"speed"`
+	PciDeviceAddress types.String   `tfsdk:"pci_device_address"`
+	CarrierActive    types.Bool     `tfsdk:"carrier_active"`
+	SlaveActive      types.Bool     `tfsdk:"slave_active"`
+	BondingUuid      types.String   `tfsdk:"bonding_uuid"`
+	IpAddresses      []types.String `tfsdk:"ip_addresses"`
+}
+
+func ZStackHostNetworkInterfacesDataSource() datasource.DataSource {
+	return &hostNetworkInterfacesDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *hostNetworkInterfacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *hostNetworkInterfacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_network_interfaces"
+}
+
+// Schema implements datasource.DataSource.
+func (d *hostNetworkInterfacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the physical network interfaces (NICs) of ZStack hosts with their link speed, MAC, PCI address and carrier state.",
+		MarkdownDescription: "Lists the physical network interfaces (NICs) of ZStack hosts with their link speed, MAC, PCI address and carrier state.",
+		Attributes: map[string]schema.Attribute{
+			"host_uuid": schema.StringAttribute{
+				Description: "Only list the NICs of this host.",
+				Optional:    true,
+			},
+			"interface_name": schema.StringAttribute{
+				Description: "Only list NICs with this interface name, e.g. `eth0`.",
+				Optional:    true,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Description: "The matching NICs, ordered by host and interface name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "UUID of the NIC.",
+							Computed:    true,
+						},
+						"host_uuid": schema.StringAttribute{
+							Description: "UUID of the host the NIC belongs to.",
+							Computed:    true,
+						},
+						"interface_name": schema.StringAttribute{
+							Description: "Interface name of the NIC on the host.",
+							Computed:    true,
+						},
+						"interface_type": schema.StringAttribute{
+							Description: "How the NIC is used, e.g. `noMaster`, `bridgeSlave` or `bondSlave`.",
+							Computed:    true,
+						},
+						"mac": schema.StringAttribute{
+							Description: "MAC address of the NIC.",
+							Computed:    true,
+						},
+						"speed": schema.Int64Attribute{
+							Description: "Link speed of the NIC in Mbps.",
+							Computed:    true,
+						},
+						"pci_device_address": schema.StringAttribute{
+							Description: "PCI address of the NIC, e.g. `0000:3b:00.0`.",
+							Computed:    true,
+						},
+						"carrier_active": schema.BoolAttribute{
+							Description: "Whether the NIC has link (carrier).",
+							Computed:    true,
+						},
+						"slave_active": schema.BoolAttribute{
+							Description: "Whether the NIC is an active member of its bond.",
+							Computed:    true,
+						},
+						"bonding_uuid": schema.StringAttribute{
+							Description: "UUID of the bond the NIC is a member of, if any.",
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Description: "IP addresses configured on the NIC.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *hostNetworkInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hostNetworkInterfacesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := param.NewQueryParam()
+	if !state.HostUuid.IsNull() {
+		q.AddQ("hostUuid=" + state.HostUuid.ValueString())
+	}
+	if !state.InterfaceName.IsNull() {
+		q.AddQ("interfaceName=" + state.InterfaceName.ValueString())
+	}
+
+	nics, err := d.client.QueryHostNetworkInterface(&q)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack Host Network Interfaces",
+			"Could not query host network interfaces",
+			"QueryHostNetworkInterface",
+			err,
+		))
+		return
+	}
+
+	state.Interfaces = make([]hostNetworkInterfacesEntryModel, 0, len(nics))
+	for _, nic := range nics {
+		ipAddresses := make([]types.String, 0, len(nic.IpAddresses))
+		for _, ip := range nic.IpAddresses {
+			ipAddresses = append(ipAddresses, types.StringValue(ip))
+		}
+
+		state.Interfaces = append(state.Interfaces, hostNetworkInterfacesEntryModel{
+			Uuid:             types.StringValue(nic.UUID),
+			HostUuid:         types.StringValue(nic.HostUuid),
+			InterfaceName:    types.StringValue(nic.InterfaceName),
+			InterfaceType:    stringValueOrNull(nic.InterfaceType),
+			Mac:              stringValueOrNull(nic.Mac),
+			Speed:            types.Int64Value(int64(nic.Speed)),
+			PciDeviceAddress: stringValueOrNull(nic.PciDeviceAddress),
+			CarrierActive:    types.BoolValue(nic.CarrierActive),
+			SlaveActive:      types.BoolValue(nic.SlaveActive),
+			BondingUuid:      stringValueOrNull(nic.BondingUuid),
+			IpAddresses:      ipAddresses,
+		})
+	}
+	sort.SliceStable(state.Interfaces, func(i, j int) bool {
+		a, b := state.Interfaces[i], state.Interfaces[j]
+		if a.HostUuid.ValueString() != b.HostUuid.ValueString() {
+			return a.HostUuid.ValueString() < b.HostUuid.ValueString()
+		}
+		return a.InterfaceName.ValueString() < b.InterfaceName.ValueString()
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestHostNetworkInterfacesDataSource_Schema(t *testing.T) {
+	var d hostNetworkInterfacesDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	optional := []string{"host_uuid", "interface_name"}
+	for _, attr := range optional {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+	}
+
+	if _, ok := resp.Schema.Attributes["interfaces"]; !ok {
+		t.Fatal("schema missing computed attribute interfaces")
+	}
+}
+
+func TestHostNetworkInterfacesDataSource_Metadata(t *testing.T) {
+	var d hostNetworkInterfacesDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_host_network_interfaces" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccHostNetworkInterfacesDataSource(t *testing.T) {
+	env := loadEnvData(t)
+	if len(env.Hosts) == 0 {
+		t.Skip("no hosts in env data")
+	}
+	hostUuid := envStr(env.Hosts[0], "uuid")
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+data "zstack_host_network_interfaces" "test" {
+  host_uuid = %q
+}
+`, hostUuid),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.zstack_host_network_interfaces.test", tfjsonpath.New("interfaces").AtSliceIndex(0).AtMapKey("host_uuid"), knownvalue.StringExact(hostUuid)),
+					statecheck.ExpectKnownValue("data.zstack_host_network_interfaces.test", tfjsonpath.New("interfaces").AtSliceIndex(0).AtMapKey("mac"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
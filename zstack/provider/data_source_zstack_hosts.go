@@ -87,10 +87,12 @@ func (d *hostsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	hosts, err := d.client.QueryHost(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Hosts ",
-			err.Error(),
-		)
+			"Could not read ZStack Hosts",
+			"QueryHost",
+			err,
+		))
 		return
 	}
 
@@ -80,10 +80,12 @@ func (d *iam2ProjectDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	projects, err := d.client.QueryIAM2Project(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack IAM2 Projects",
-			err.Error(),
-		)
+			"Could not query ZStack IAM2 Projects",
+			"QueryIAM2Project",
+			err,
+		))
 		return
 	}
 
@@ -90,10 +90,12 @@ func (d *imageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	images, err := d.client.QueryImage(&params)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Images",
-			err.Error(),
-		)
+			"Could not read ZStack Images",
+			"QueryImage",
+			err,
+		))
 		return
 	}
 
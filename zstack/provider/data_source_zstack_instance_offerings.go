@@ -93,10 +93,12 @@ func (d *instanceOfferingDataSource) Read(ctx context.Context, req datasource.Re
 
 	instanceOffers, err := d.client.QueryInstanceOffering(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read instance offers",
-			err.Error(),
-		)
+			"Could not read instance offers",
+			"QueryInstanceOffering",
+			err,
+		))
 		return
 	}
 
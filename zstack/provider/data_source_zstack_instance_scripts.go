@@ -166,10 +166,12 @@ func (d *instanceScriptDataSource) Read(ctx context.Context, req datasource.Read
 	scripts, err := d.client.QueryGuestVmScript(&params)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Scripts",
-			err.Error(),
-		)
+			"Could not read ZStack Scripts",
+			"QueryGuestVmScript",
+			err,
+		))
 		return
 	}
 
@@ -112,10 +112,12 @@ func (d *vmsDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	vminstances, err := d.client.QueryVmInstance(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read vm instances",
-			err.Error(),
-		)
+			"Could not read vm instances",
+			"QueryVmInstance",
+			err,
+		))
 		return
 	}
 
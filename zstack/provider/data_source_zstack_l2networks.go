@@ -89,10 +89,12 @@ func (d *l2NetworkDataSource) Read(ctx context.Context, req datasource.ReadReque
 	//Query L2 networks with name filtering
 	l2networks, err := d.client.QueryL2Network(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack L2Networks ",
-			err.Error(),
-		)
+			"Could not read ZStack L2Networks",
+			"QueryL2Network",
+			err,
+		))
 		return
 	}
 
@@ -178,10 +178,12 @@ func (d *l2VlanNetworkDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	l2VlanNetworks, err := d.client.QueryL2VlanNetwork(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack L2 VLAN Networks",
-			err.Error(),
-		)
+			"Could not read ZStack L2 VLAN Networks",
+			"QueryL2VlanNetwork",
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,186 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ datasource.DataSource              = &l2VxlanNetworkPoolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &l2VxlanNetworkPoolsDataSource{}
+)
+
+type l2VxlanNetworkPoolsDataSource struct {
+	client *client.ZSClient
+}
+
+type l2VxlanNetworkPoolsDataSourceModel struct {
+	Uuid        types.String                    `tfsdk:"uuid"`
+	Name        types.String                    `tfsdk:"name"`
+	NamePattern types.String                    `tfsdk:"name_pattern"`
+	Pools       []l2VxlanNetworkPoolsEntryModel `tfsdk:"l2vxlan_network_pools"`
+}
+
+type l2VxlanNetworkPoolsEntryModel struct {
+	Uuid                 types.String            `tfsdk:"uuid"`
+	Name                 types.String            `tfsdk:"name"`
+	Description          types.String            `tfsdk:"description"`
+	ZoneUuid             types.String            `tfsdk:"zone_uuid"`
+	PhysicalInterface    types.String            `tfsdk:"physical_interface"`
+	AttachedClusterUuids []types.String          `tfsdk:"attached_cluster_uuids"`
+	VtepCidrs            map[string]types.String `tfsdk:"vtep_cidrs"`
+}
+
+func ZStackL2VxlanNetworkPoolsDataSource() datasource.DataSource {
+	return &l2VxlanNetworkPoolsDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *l2VxlanNetworkPoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *l2VxlanNetworkPoolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2vxlan_network_pools"
+}
+
+// Schema implements datasource.DataSource.
+func (d *l2VxlanNetworkPoolsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches a list of L2 VXLAN network pools and the clusters they are attached to.",
+		MarkdownDescription: "Fetches a list of L2 VXLAN network pools and the clusters they are attached to.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Description: "Exact UUID lookup. Mutually exclusive with `name` / `name_pattern`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("name"),
+						path.MatchRoot("name_pattern"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact name for searching VXLAN network pools.",
+				Optional:    true,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description: "Pattern for fuzzy name search, similar to MySQL LIKE. Use % for multiple characters and _ for exactly one character.",
+				Optional:    true,
+			},
+			"l2vxlan_network_pools": schema.ListNestedAttribute{
+				Description: "List of VXLAN network pools matching the specified filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "UUID of the VXLAN network pool.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the VXLAN network pool.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the VXLAN network pool.",
+							Computed:    true,
+						},
+						"zone_uuid": schema.StringAttribute{
+							Description: "UUID of the zone of the VXLAN network pool.",
+							Computed:    true,
+						},
+						"physical_interface": schema.StringAttribute{
+							Description: "Physical interface that carries the VXLAN traffic.",
+							Computed:    true,
+						},
+						"attached_cluster_uuids": schema.ListAttribute{
+							Description: "UUIDs of clusters the pool is attached to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"vtep_cidrs": schema.MapAttribute{
+							Description: "VTEP CIDR of each attached cluster, keyed by cluster UUID.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *l2VxlanNetworkPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state l2VxlanNetworkPoolsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := param.NewQueryParam()
+	applyUuidOrNameFilter(&params, state.Uuid, state.Name, state.NamePattern)
+
+	pools, err := d.client.QueryL2VxlanNetworkPool(&params)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack L2 VXLAN Network Pools",
+			"Could not read ZStack L2 VXLAN network pools",
+			"QueryL2VxlanNetworkPool",
+			err,
+		))
+		return
+	}
+
+	state.Pools = make([]l2VxlanNetworkPoolsEntryModel, 0, len(pools))
+	for _, pool := range pools {
+		attachedClusters := make([]types.String, 0, len(pool.AttachedClusterUuids))
+		for _, clusterUuid := range pool.AttachedClusterUuids {
+			attachedClusters = append(attachedClusters, types.StringValue(clusterUuid))
+		}
+
+		vtepCidrs := make(map[string]types.String, len(pool.AttachedCidrs))
+		for clusterUuid, cidr := range pool.AttachedCidrs {
+			vtepCidrs[clusterUuid] = types.StringValue(cidr)
+		}
+
+		state.Pools = append(state.Pools, l2VxlanNetworkPoolsEntryModel{
+			Uuid:                 types.StringValue(pool.UUID),
+			Name:                 types.StringValue(pool.Name),
+			Description:          stringValueOrNull(pool.Description),
+			ZoneUuid:             types.StringValue(pool.ZoneUuid),
+			PhysicalInterface:    stringValueOrNull(pool.PhysicalInterface),
+			AttachedClusterUuids: attachedClusters,
+			VtepCidrs:            vtepCidrs,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestL2VxlanNetworkPoolsDataSource_Schema(t *testing.T) {
+	var d l2VxlanNetworkPoolsDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	optional := []string{"uuid", "name", "name_pattern"}
+	for _, attr := range optional {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+	}
+
+	if _, ok := resp.Schema.Attributes["l2vxlan_network_pools"]; !ok {
+		t.Fatal("schema missing computed attribute l2vxlan_network_pools")
+	}
+}
+
+func TestL2VxlanNetworkPoolsDataSource_Metadata(t *testing.T) {
+	var d l2VxlanNetworkPoolsDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_l2vxlan_network_pools" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestVniRangesDataSource_Schema(t *testing.T) {
+	var d vniRangesDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	optional := []string{"pool_uuid", "name"}
+	for _, attr := range optional {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+	}
+
+	if _, ok := resp.Schema.Attributes["vni_ranges"]; !ok {
+		t.Fatal("schema missing computed attribute vni_ranges")
+	}
+}
+
+func TestVniRangesDataSource_Metadata(t *testing.T) {
+	var d vniRangesDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vni_ranges" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
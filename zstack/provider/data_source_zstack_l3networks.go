@@ -104,10 +104,12 @@ func (d *l3NetworkDataSource) Read(ctx context.Context, req datasource.ReadReque
 	//Query L3 networks with name filtering
 	l3networks, err := d.client.QueryL3Network(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack L3Networks ",
-			err.Error(),
-		)
+			"Could not read ZStack L3Networks",
+			"QueryL3Network",
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,155 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+)
+
+var (
+	_ datasource.DataSource              = &lbServerGroupBackendsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lbServerGroupBackendsDataSource{}
+)
+
+type lbServerGroupBackendsDataSource struct {
+	client *client.ZSClient
+}
+
+type lbServerGroupBackendsDataSourceModel struct {
+	ServerGroupUuid types.String                 `tfsdk:"server_group_uuid"`
+	Backends        []lbServerGroupBackendsModel `tfsdk:"backends"`
+}
+
+type lbServerGroupBackendsModel struct {
+	Type      types.String `tfsdk:"type"`
+	VmNicUuid types.String `tfsdk:"vm_nic_uuid"`
+	IpAddress types.String `tfsdk:"ip_address"`
+	Weight    types.Int64  `tfsdk:"weight"`
+	Port      types.Int64  `tfsdk:"port"`
+	IpVersion types.Int64  `tfsdk:"ip_version"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func ZStackLBServerGroupBackendsDataSource() datasource.DataSource {
+	return &lbServerGroupBackendsDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *lbServerGroupBackendsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *lbServerGroupBackendsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lb_server_group_backends"
+}
+
+// Schema implements datasource.DataSource.
+func (d *lbServerGroupBackendsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Fetches the VM NIC and server IP backends of a load balancer server group, with their weight, port and health status.",
+		MarkdownDescription: "Fetches the VM NIC and server IP backends of a load balancer server group, with their weight, port and health status.",
+		Attributes: map[string]schema.Attribute{
+			"server_group_uuid": schema.StringAttribute{
+				Description: "The UUID of the load balancer server group.",
+				Required:    true,
+			},
+			"backends": schema.ListNestedAttribute{
+				Description: "Backends of the server group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the backend, `vm_nic` or `server_ip`.",
+							Computed:    true,
+						},
+						"vm_nic_uuid": schema.StringAttribute{
+							Description: "UUID of the VM NIC, for `vm_nic` backends.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address of the server, for `server_ip` backends.",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "Weight of the backend.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port of the backend, or null when it uses the instance port of the listener.",
+							Computed:    true,
+						},
+						"ip_version": schema.Int64Attribute{
+							Description: "IP version of the backend (4 or 6).",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Health status of the backend reported by the load balancer, e.g. `Active` when it passes the health check and `Inactive` when it does not.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *lbServerGroupBackendsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lbServerGroupBackendsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backends, err := queryLBServerGroupBackends(d.client, state.ServerGroupUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack Load Balancer Server Group Backends",
+			"Could not read backends of load balancer server group "+state.ServerGroupUuid.ValueString(),
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
+		return
+	}
+
+	state.Backends = []lbServerGroupBackendsModel{}
+	for _, b := range backends {
+		backendType := "vm_nic"
+		if b.VmNicUuid == "" {
+			backendType = "server_ip"
+		}
+		state.Backends = append(state.Backends, lbServerGroupBackendsModel{
+			Type:      types.StringValue(backendType),
+			VmNicUuid: stringValueOrNull(b.VmNicUuid),
+			IpAddress: stringValueOrNull(b.IpAddress),
+			Weight:    types.Int64Value(b.Weight),
+			Port:      int64ValueOrNull(b.Port),
+			IpVersion: types.Int64Value(b.IpVersion),
+			Status:    stringValueOrNull(b.Status),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
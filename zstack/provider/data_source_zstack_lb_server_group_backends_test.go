@@ -0,0 +1,35 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestLbServerGroupBackendsDataSource_Schema(t *testing.T) {
+	var d lbServerGroupBackendsDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	expectedAttrs := []string{"server_group_uuid", "backends"}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing attribute %q", attr)
+		}
+	}
+}
+
+func TestLbServerGroupBackendsDataSource_Metadata(t *testing.T) {
+	var d lbServerGroupBackendsDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_lb_server_group_backends" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
@@ -82,10 +82,12 @@ func (d *licenseAuthorizedCapacityDataSource) Read(ctx context.Context, req data
 
 	capacity, err := d.client.GetLicenseAuthorizedCapacity()
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack License Authorized Capacity",
-			err.Error(),
-		)
+			"Could not read ZStack License Authorized Capacity",
+			"GetLicenseAuthorizedCapacity",
+			err,
+		))
 		return
 	}
 
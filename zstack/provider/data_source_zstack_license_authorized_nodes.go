@@ -80,10 +80,12 @@ func (d *licenseAuthorizedNodeDataSource) Read(ctx context.Context, req datasour
 
 	nodes, err := d.client.QueryLicenseAuthorizedNode(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack License Authorized Nodes",
-			err.Error(),
-		)
+			"Could not query ZStack License Authorized Nodes",
+			"QueryLicenseAuthorizedNode",
+			err,
+		))
 		return
 	}
 
@@ -177,10 +177,12 @@ func (d *loadBalancerListenerDataSource) Read(ctx context.Context, req datasourc
 
 	listeners, err := d.client.QueryLoadBalancerListener(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Load Balancer Listeners",
-			err.Error(),
-		)
+			"Could not read ZStack Load Balancer Listeners",
+			"QueryLoadBalancerListener",
+			err,
+		))
 		return
 	}
 
@@ -167,10 +167,12 @@ func (d *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	lbs, err := d.client.QueryLoadBalancer(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Load Balancers",
-			err.Error(),
-		)
+			"Could not read ZStack Load Balancers",
+			"QueryLoadBalancer",
+			err,
+		))
 		return
 	}
 
@@ -87,10 +87,12 @@ func (d *mnNodeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	queryParam := param.NewQueryParam()
 	mn_nodes, err := d.client.QueryManagementNode(&queryParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Management Nodes",
-			err.Error(),
-		)
+			"Could not read ZStack Management Nodes",
+			"QueryManagementNode",
+			err,
+		))
 		return
 	}
 
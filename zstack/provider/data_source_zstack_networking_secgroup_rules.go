@@ -89,10 +89,12 @@ func (d *networkingSecGroupRuleDataSource) Read(ctx context.Context, req datasou
 
 	securityGroupRules, err := d.client.QuerySecurityGroupRule(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack Security Groups Rules",
-			err.Error(),
-		)
+			"Could not query ZStack Security Groups Rules",
+			"QuerySecurityGroupRule",
+			err,
+		))
 		return
 	}
 
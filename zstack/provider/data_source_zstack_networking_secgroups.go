@@ -100,10 +100,12 @@ func (d *networkingSecGroupDataSource) Read(ctx context.Context, req datasource.
 
 	securityGroups, err := d.client.QuerySecurityGroup(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack Security Groups",
-			err.Error(),
-		)
+			"Could not query ZStack Security Groups",
+			"QuerySecurityGroup",
+			err,
+		))
 		return
 	}
 
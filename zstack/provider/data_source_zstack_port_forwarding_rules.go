@@ -202,10 +202,12 @@ func (d *portForwardingRuleDataSource) Read(ctx context.Context, req datasource.
 
 	rules, err := d.client.QueryPortForwardingRule(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Port Forwarding Rules",
-			err.Error(),
-		)
+			"Could not read ZStack Port Forwarding Rules",
+			"QueryPortForwardingRule",
+			err,
+		))
 		return
 	}
 
@@ -88,10 +88,12 @@ func (d *primaryStorageDataSource) Read(ctx context.Context, req datasource.Read
 
 	primaryStorages, err := d.client.QueryPrimaryStorage(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack primary Storages",
-			err.Error(),
-		)
+			"Could not read ZStack primary Storages",
+			"QueryPrimaryStorage",
+			err,
+		))
 		return
 	}
 
@@ -104,10 +104,12 @@ func (d *reservedIpDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 	_, err := d.client.Zql(ctx, queryStr, &zqlResponse)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Reserved IPs",
-			err.Error(),
-		)
+			"Could not read ZStack Reserved IPs",
+			"Zql",
+			err,
+		))
 		return
 	}
 
@@ -85,10 +85,12 @@ func (d *sdnControllerDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	sdnControllers, err := d.client.QuerySdnController(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack SDN Controllers ",
-			err.Error(),
-		)
+			"Could not read ZStack SDN Controllers",
+			"QuerySdnController",
+			err,
+		))
 		return
 	}
 
@@ -80,10 +80,12 @@ func (d *sshKeyPairDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	sshKeyPairs, err := d.client.QuerySshKeyPair(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Query ZStack SSH Key Pairs",
-			err.Error(),
-		)
+			"Could not query ZStack SSH Key Pairs",
+			"QuerySshKeyPair",
+			err,
+		))
 		return
 	}
 
@@ -94,10 +94,12 @@ func (d *subnetIpRangeDataSource) Read(ctx context.Context, req datasource.ReadR
 	ipRanges, err := d.client.QueryIpRange(&params)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack Subnet IP Ranges",
-			err.Error(),
-		)
+			"Could not read ZStack Subnet IP Ranges",
+			"QueryIpRange",
+			err,
+		))
 		return
 	}
 
@@ -127,10 +127,12 @@ func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	case "user":
 		userTags, err := d.client.QueryUserTag(&params)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Failed to Fetch User Tags from ZStack",
-				fmt.Sprintf("Error while querying user tags: %s", err.Error()),
-			)
+				"Error while querying user tags",
+				"QueryUserTag",
+				err,
+			))
 			return
 		}
 		filteredTags, filterDiags := utils.FilterResource(ctx, userTags, filters, "user_tags")
@@ -150,7 +152,7 @@ func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	case "system":
 		systemTags, err := d.client.QuerySystemTag(&params)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to query system tags", err.Error())
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Unable to query system tags", "Could not query system tags", "QuerySystemTag", err))
 			return
 		}
 		filteredTags, filterDiags := utils.FilterResource(ctx, systemTags, filters, "system_tags")
@@ -172,7 +174,7 @@ func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	case "tag":
 		tags, err := d.client.QueryTag(&params)
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to query tags", err.Error())
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Unable to query tags", "Could not query tags", "QueryTag", err))
 			return
 		}
 		filteredTags, filterDiags := utils.FilterResource(ctx, tags, filters, "tag")
@@ -88,10 +88,12 @@ func (d *userTagDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	userTags, err := d.client.QueryUserTag(&params)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack User Tags",
-			err.Error(),
-		)
+			"Could not read ZStack User Tags",
+			"QueryUserTag",
+			err,
+		))
 		return
 	}
 
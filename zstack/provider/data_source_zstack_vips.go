@@ -92,10 +92,12 @@ func (d *vipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	vips, err := d.client.QueryVip(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack VIPS ",
-			err.Error(),
-		)
+			"Could not read ZStack VIPS",
+			"QueryVip",
+			err,
+		))
 		return
 	}
 
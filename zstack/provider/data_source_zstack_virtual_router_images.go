@@ -113,7 +113,7 @@ func (d *virtualRouterImageDataSource) Read(ctx context.Context, req datasource.
 
 	_, err := d.client.Zql(ctx, query, &zqlResponse)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to execute ZQL query, got error: %s", err))
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Client Error", "Unable to execute ZQL query", "Zql", err))
 		return
 	}
 
@@ -97,10 +97,12 @@ func (d *vrouterOfferingDataSource) Read(ctx context.Context, req datasource.Rea
 
 	vrouterOffers, err := d.client.QueryVirtualRouterOffering(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read virtual router offers",
-			err.Error(),
-		)
+			"Could not read virtual router offers",
+			"QueryVirtualRouterOffering",
+			err,
+		))
 		return
 	}
 
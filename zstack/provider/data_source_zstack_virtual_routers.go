@@ -109,10 +109,12 @@ func (d *vrouterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	vrouters, err := d.client.QueryVirtualRouterVm(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read virtual router instances",
-			err.Error(),
-		)
+			"Could not read virtual router instances",
+			"QueryVirtualRouterVm",
+			err,
+		))
 		return
 	}
 
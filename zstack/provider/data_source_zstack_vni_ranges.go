@@ -0,0 +1,167 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ datasource.DataSource              = &vniRangesDataSource{}
+	_ datasource.DataSourceWithConfigure = &vniRangesDataSource{}
+)
+
+type vniRangesDataSource struct {
+	client *client.ZSClient
+}
+
+type vniRangesDataSourceModel struct {
+	PoolUuid  types.String          `tfsdk:"pool_uuid"`
+	Name      types.String          `tfsdk:"name"`
+	VniRanges []vniRangesEntryModel `tfsdk:"vni_ranges"`
+}
+
+type vniRangesEntryModel struct {
+	Uuid        types.String `tfsdk:"uuid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	PoolUuid    types.String `tfsdk:"pool_uuid"`
+	StartVni    types.Int64  `tfsdk:"start_vni"`
+	EndVni      types.Int64  `tfsdk:"end_vni"`
+}
+
+func ZStackVniRangesDataSource() datasource.DataSource {
+	return &vniRangesDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *vniRangesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *vniRangesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vni_ranges"
+}
+
+// Schema implements datasource.DataSource.
+func (d *vniRangesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the VNI ranges of VXLAN network pools, optionally by pool or exact name.",
+		MarkdownDescription: "Lists the VNI ranges of VXLAN network pools, optionally by pool or exact name.",
+		Attributes: map[string]schema.Attribute{
+			"pool_uuid": schema.StringAttribute{
+				Description: "Only list the VNI ranges of this VXLAN network pool.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only list VNI ranges with this name.",
+				Optional:    true,
+			},
+			"vni_ranges": schema.ListNestedAttribute{
+				Description: "The matching VNI ranges, ordered by pool and first VNI.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "UUID of the VNI range.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the VNI range.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the VNI range.",
+							Computed:    true,
+						},
+						"pool_uuid": schema.StringAttribute{
+							Description: "UUID of the VXLAN network pool the range belongs to.",
+							Computed:    true,
+						},
+						"start_vni": schema.Int64Attribute{
+							Description: "First VNI of the range.",
+							Computed:    true,
+						},
+						"end_vni": schema.Int64Attribute{
+							Description: "Last VNI of the range.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *vniRangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vniRangesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := param.NewQueryParam()
+	if !state.PoolUuid.IsNull() {
+		q.AddQ("l2NetworkUuid=" + state.PoolUuid.ValueString())
+	}
+	if !state.Name.IsNull() {
+		q.AddQ("name=" + state.Name.ValueString())
+	}
+
+	vniRanges, err := d.client.QueryVniRange(&q)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack VNI Ranges",
+			"Could not query VNI ranges",
+			"QueryVniRange",
+			err,
+		))
+		return
+	}
+
+	state.VniRanges = make([]vniRangesEntryModel, 0, len(vniRanges))
+	for _, vniRange := range vniRanges {
+		state.VniRanges = append(state.VniRanges, vniRangesEntryModel{
+			Uuid:        types.StringValue(vniRange.UUID),
+			Name:        types.StringValue(vniRange.Name),
+			Description: stringValueOrNull(vniRange.Description),
+			PoolUuid:    types.StringValue(vniRange.L2NetworkUuid),
+			StartVni:    types.Int64Value(int64(vniRange.StartVni)),
+			EndVni:      types.Int64Value(int64(vniRange.EndVni)),
+		})
+	}
+	sort.SliceStable(state.VniRanges, func(i, j int) bool {
+		a, b := state.VniRanges[i], state.VniRanges[j]
+		if a.PoolUuid.ValueString() != b.PoolUuid.ValueString() {
+			return a.PoolUuid.ValueString() < b.PoolUuid.ValueString()
+		}
+		return a.StartVni.ValueInt64() < b.StartVni.ValueInt64()
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
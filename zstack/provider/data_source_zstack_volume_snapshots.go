@@ -161,7 +161,7 @@ func (d *volumeSnapshotsDataSource) Read(ctx context.Context, req datasource.Rea
 
 	snapshots, err := d.client.QueryVolumeSnapshot(&params)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read volume snapshots", err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Unable to read volume snapshots", "Could not read volume snapshots", "QueryVolumeSnapshot", err))
 		return
 	}
 
@@ -159,7 +159,7 @@ func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	volumes, err := d.client.QueryVolume(&params)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read volumes", err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Unable to read volumes", "Could not read volumes", "QueryVolume", err))
 		return
 	}
 
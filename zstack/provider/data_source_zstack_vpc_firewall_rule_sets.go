@@ -0,0 +1,139 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ datasource.DataSource              = &vpcFirewallRuleSetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &vpcFirewallRuleSetsDataSource{}
+)
+
+type vpcFirewallRuleSetsDataSource struct {
+	client *client.ZSClient
+}
+
+type vpcFirewallRuleSetsDataSourceModel struct {
+	Name     types.String                       `tfsdk:"name"`
+	RuleSets []vpcFirewallRuleSetsDataSourceSet `tfsdk:"rule_sets"`
+}
+
+type vpcFirewallRuleSetsDataSourceSet struct {
+	Uuid          types.String `tfsdk:"uuid"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	DefaultAction types.String `tfsdk:"default_action"`
+}
+
+func ZStackVpcFirewallRuleSetsDataSource() datasource.DataSource {
+	return &vpcFirewallRuleSetsDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *vpcFirewallRuleSetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *vpcFirewallRuleSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_firewall_rule_sets"
+}
+
+// Schema implements datasource.DataSource.
+func (d *vpcFirewallRuleSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists VPC firewall rule sets, optionally by exact name.",
+		MarkdownDescription: "Lists VPC firewall rule sets, optionally by exact name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only list rule sets with this name.",
+				Optional:    true,
+			},
+			"rule_sets": schema.ListNestedAttribute{
+				Description: "The matching rule sets.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "UUID of the rule set.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the rule set.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the rule set.",
+							Computed:    true,
+						},
+						"default_action": schema.StringAttribute{
+							Description: "Action for packets that match no rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *vpcFirewallRuleSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vpcFirewallRuleSetsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := param.NewQueryParam()
+	if !state.Name.IsNull() {
+		q.AddQ("name=" + state.Name.ValueString())
+	}
+
+	ruleSets, err := d.client.QueryFirewallRuleSet(&q)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack VPC Firewall Rule Sets",
+			"Could not query VPC firewall rule sets",
+			"QueryFirewallRuleSet",
+			err,
+		))
+		return
+	}
+
+	state.RuleSets = make([]vpcFirewallRuleSetsDataSourceSet, 0, len(ruleSets))
+	for _, ruleSet := range ruleSets {
+		state.RuleSets = append(state.RuleSets, vpcFirewallRuleSetsDataSourceSet{
+			Uuid:          types.StringValue(ruleSet.UUID),
+			Name:          types.StringValue(ruleSet.Name),
+			Description:   stringValueOrNull(ruleSet.Description),
+			DefaultAction: types.StringValue(ruleSet.ActionType),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestVpcFirewallRuleSetsDataSource_Schema(t *testing.T) {
+	var d vpcFirewallRuleSetsDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	expectedAttrs := []string{"name", "rule_sets"}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing attribute %q", attr)
+		}
+	}
+}
+
+func TestVpcFirewallRuleSetsDataSource_Metadata(t *testing.T) {
+	var d vpcFirewallRuleSetsDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vpc_firewall_rule_sets" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ datasource.DataSource              = &vpcFirewallRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &vpcFirewallRulesDataSource{}
+)
+
+type vpcFirewallRulesDataSource struct {
+	client *client.ZSClient
+}
+
+type vpcFirewallRulesDataSourceModel struct {
+	RuleSetUuid types.String                     `tfsdk:"rule_set_uuid"`
+	Action      types.String                     `tfsdk:"action"`
+	State       types.String                     `tfsdk:"state"`
+	Rules       []vpcFirewallRulesDataSourceRule `tfsdk:"rules"`
+}
+
+type vpcFirewallRulesDataSourceRule struct {
+	Uuid            types.String `tfsdk:"uuid"`
+	RuleSetUuid     types.String `tfsdk:"rule_set_uuid"`
+	RuleNumber      types.Int64  `tfsdk:"rule_number"`
+	Action          types.String `tfsdk:"action"`
+	Protocol        types.String `tfsdk:"protocol"`
+	SourceIp        types.String `tfsdk:"source_ip"`
+	DestinationIp   types.String `tfsdk:"destination_ip"`
+	SourcePort      types.String `tfsdk:"source_port"`
+	DestinationPort types.String `tfsdk:"destination_port"`
+	State           types.String `tfsdk:"state"`
+	Description     types.String `tfsdk:"description"`
+}
+
+func ZStackVpcFirewallRulesDataSource() datasource.DataSource {
+	return &vpcFirewallRulesDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *vpcFirewallRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *vpcFirewallRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_firewall_rules"
+}
+
+// Schema implements datasource.DataSource.
+func (d *vpcFirewallRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists VPC firewall rules, optionally limited to one rule set, action or state. Rules are ordered by rule set and rule number.",
+		MarkdownDescription: "Lists VPC firewall rules, optionally limited to one rule set, action or state. Rules are ordered by rule set and rule number.",
+		Attributes: map[string]schema.Attribute{
+			"rule_set_uuid": schema.StringAttribute{
+				Description: "Only list rules of this rule set.",
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Only list rules with this action: `accept`, `drop` or `reject`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "drop", "reject"),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Only list rules in this state: `enable` or `disable`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("enable", "disable"),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The matching rules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "UUID of the rule.",
+							Computed:    true,
+						},
+						"rule_set_uuid": schema.StringAttribute{
+							Description: "UUID of the rule set the rule belongs to.",
+							Computed:    true,
+						},
+						"rule_number": schema.Int64Attribute{
+							Description: "Number of the rule within its rule set.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for matching packets.",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Protocol the rule matches.",
+							Computed:    true,
+						},
+						"source_ip": schema.StringAttribute{
+							Description: "Source IP address, CIDR or range the rule matches.",
+							Computed:    true,
+						},
+						"destination_ip": schema.StringAttribute{
+							Description: "Destination IP address, CIDR or range the rule matches.",
+							Computed:    true,
+						},
+						"source_port": schema.StringAttribute{
+							Description: "Source port or port range the rule matches.",
+							Computed:    true,
+						},
+						"destination_port": schema.StringAttribute{
+							Description: "Destination port or port range the rule matches.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the rule, `enable` or `disable`.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the rule.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *vpcFirewallRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vpcFirewallRulesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	q := param.NewQueryParam()
+	if !state.RuleSetUuid.IsNull() {
+		q.AddQ("ruleSetUuid=" + state.RuleSetUuid.ValueString())
+	}
+	if !state.Action.IsNull() {
+		q.AddQ("action=" + state.Action.ValueString())
+	}
+	if !state.State.IsNull() {
+		q.AddQ("state=" + state.State.ValueString())
+	}
+
+	rules, err := d.client.QueryFirewallRule(&q)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack VPC Firewall Rules",
+			"Could not query VPC firewall rules",
+			"QueryFirewallRule",
+			err,
+		))
+		return
+	}
+
+	state.Rules = make([]vpcFirewallRulesDataSourceRule, 0, len(rules))
+	for _, rule := range rules {
+		state.Rules = append(state.Rules, vpcFirewallRulesDataSourceRule{
+			Uuid:            types.StringValue(rule.UUID),
+			RuleSetUuid:     types.StringValue(rule.RuleSetUuid),
+			RuleNumber:      types.Int64Value(int64(rule.RuleNumber)),
+			Action:          types.StringValue(rule.Action),
+			Protocol:        types.StringValue(rule.Protocol),
+			SourceIp:        stringValueOrNull(rule.SourceIp),
+			DestinationIp:   stringValueOrNull(rule.DestIp),
+			SourcePort:      stringValueOrNull(rule.SourcePort),
+			DestinationPort: stringValueOrNull(rule.DestPort),
+			State:           types.StringValue(rule.State),
+			Description:     stringValueOrNull(rule.Description),
+		})
+	}
+	sortVpcFirewallRules(state.Rules)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func sortVpcFirewallRules(rules []vpcFirewallRulesDataSourceRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		leftSet := rules[i].RuleSetUuid.ValueString()
+		rightSet := rules[j].RuleSetUuid.ValueString()
+		if leftSet != rightSet {
+			return leftSet < rightSet
+		}
+
+		return rules[i].RuleNumber.ValueInt64() < rules[j].RuleNumber.ValueInt64()
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVpcFirewallRulesDataSource_Schema(t *testing.T) {
+	var d vpcFirewallRulesDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	expectedAttrs := []string{"rule_set_uuid", "action", "state", "rules"}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing attribute %q", attr)
+		}
+	}
+}
+
+func TestVpcFirewallRulesDataSource_Metadata(t *testing.T) {
+	var d vpcFirewallRulesDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vpc_firewall_rules" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestSortVpcFirewallRules(t *testing.T) {
+	rule := func(ruleSetUuid string, ruleNumber int64) vpcFirewallRulesDataSourceRule {
+		return vpcFirewallRulesDataSourceRule{
+			RuleSetUuid: types.StringValue(ruleSetUuid),
+			RuleNumber:  types.Int64Value(ruleNumber),
+		}
+	}
+	rules := []vpcFirewallRulesDataSourceRule{rule("b", 1), rule("a", 20), rule("a", 3), rule("b", 0)}
+	sortVpcFirewallRules(rules)
+
+	expected := []struct {
+		ruleSetUuid string
+		ruleNumber  int64
+	}{{"a", 3}, {"a", 20}, {"b", 0}, {"b", 1}}
+	for i, e := range expected {
+		if rules[i].RuleSetUuid.ValueString() != e.ruleSetUuid || rules[i].RuleNumber.ValueInt64() != e.ruleNumber {
+			t.Fatalf("rule %d: got %s/%d, want %s/%d", i, rules[i].RuleSetUuid.ValueString(), rules[i].RuleNumber.ValueInt64(), e.ruleSetUuid, e.ruleNumber)
+		}
+	}
+}
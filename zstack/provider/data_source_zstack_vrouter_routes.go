@@ -0,0 +1,147 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+)
+
+var (
+	_ datasource.DataSource              = &vrouterRoutesDataSource{}
+	_ datasource.DataSourceWithConfigure = &vrouterRoutesDataSource{}
+)
+
+type vrouterRoutesDataSource struct {
+	client *client.ZSClient
+}
+
+type vrouterRoutesDataSourceModel struct {
+	VirtualRouterUuid types.String                   `tfsdk:"virtual_router_uuid"`
+	Routes            []vrouterRoutesDataSourceRoute `tfsdk:"routes"`
+}
+
+type vrouterRoutesDataSourceRoute struct {
+	Destination types.String `tfsdk:"destination"`
+	Target      types.String `tfsdk:"target"`
+	Type        types.String `tfsdk:"type"`
+	Distance    types.Int64  `tfsdk:"distance"`
+}
+
+func ZStackVRouterRoutesDataSource() datasource.DataSource {
+	return &vrouterRoutesDataSource{}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *vrouterRoutesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Metadata implements datasource.DataSource.
+func (d *vrouterRoutesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vrouter_routes"
+}
+
+// Schema implements datasource.DataSource.
+func (d *vrouterRoutesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the effective routing table of a virtual router or VPC router: directly connected networks, " +
+			"routes from its attached route table and dynamically learned routes. Routes are ordered by destination and distance.",
+		MarkdownDescription: "Fetches the effective routing table of a virtual router or VPC router: directly connected networks, " +
+			"routes from its attached route table and dynamically learned routes. Routes are ordered by destination and distance.",
+		Attributes: map[string]schema.Attribute{
+			"virtual_router_uuid": schema.StringAttribute{
+				Description: "The UUID of the virtual router VM.",
+				Required:    true,
+			},
+			"routes": schema.ListNestedAttribute{
+				Description: "Routes installed on the router.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.StringAttribute{
+							Description: "Destination CIDR of the route.",
+							Computed:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "Next hop or outgoing interface of the route; empty for blackhole routes.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Origin of the route, e.g. `DirectConnect`, `UserStatic`, `UserBlackHole` or `OSPF`.",
+							Computed:    true,
+						},
+						"distance": schema.Int64Attribute{
+							Description: "Administrative distance of the route.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *vrouterRoutesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vrouterRoutesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualRouterUuid := state.VirtualRouterUuid.ValueString()
+	routes, err := d.client.GetVRouterRouteTable(virtualRouterUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Unable to Read ZStack VRouter Routes",
+			"Could not get the routing table of virtual router "+virtualRouterUuid,
+			"GetVRouterRouteTable",
+			err,
+		))
+		return
+	}
+
+	state.Routes = make([]vrouterRoutesDataSourceRoute, 0, len(routes))
+	for _, route := range routes {
+		state.Routes = append(state.Routes, vrouterRoutesDataSourceRoute{
+			Destination: types.StringValue(route.Destination),
+			Target:      types.StringValue(route.Target),
+			Type:        types.StringValue(route.Type),
+			Distance:    types.Int64Value(int64(route.Distance)),
+		})
+	}
+	sortVRouterRoutes(state.Routes)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func sortVRouterRoutes(routes []vrouterRoutesDataSourceRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Destination.ValueString() != routes[j].Destination.ValueString() {
+			return routes[i].Destination.ValueString() < routes[j].Destination.ValueString()
+		}
+		return routes[i].Distance.ValueInt64() < routes[j].Distance.ValueInt64()
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVRouterRoutesDataSource_Schema(t *testing.T) {
+	var d vrouterRoutesDataSource
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	expectedAttrs := []string{"virtual_router_uuid", "routes"}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Fatalf("schema missing attribute %q", attr)
+		}
+	}
+}
+
+func TestVRouterRoutesDataSource_Metadata(t *testing.T) {
+	var d vrouterRoutesDataSource
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vrouter_routes" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestSortVRouterRoutes(t *testing.T) {
+	route := func(destination string, distance int64) vrouterRoutesDataSourceRoute {
+		return vrouterRoutesDataSourceRoute{
+			Destination: types.StringValue(destination),
+			Distance:    types.Int64Value(distance),
+		}
+	}
+	routes := []vrouterRoutesDataSourceRoute{route("172.16.0.0/12", 1), route("10.0.0.0/8", 128), route("10.0.0.0/8", 1)}
+	sortVRouterRoutes(routes)
+
+	if routes[0].Destination.ValueString() != "10.0.0.0/8" || routes[0].Distance.ValueInt64() != 1 {
+		t.Fatalf("unexpected first route: %v", routes[0])
+	}
+	if routes[1].Distance.ValueInt64() != 128 || routes[2].Destination.ValueString() != "172.16.0.0/12" {
+		t.Fatalf("unexpected route order: %v", routes)
+	}
+}
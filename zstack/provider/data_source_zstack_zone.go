@@ -146,10 +146,12 @@ func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	zones, err := d.client.QueryZone(&params)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read ZStack zones",
-			err.Error(),
-		)
+			"Could not read ZStack zones",
+			"QueryZone",
+			err,
+		))
 		return
 	}
 
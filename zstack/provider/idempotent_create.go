@@ -0,0 +1,251 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+// privateKeyResourceUuid is the private state key holding the client-generated
+// resource UUID sent with a create call.
+const privateKeyResourceUuid = "resource_uuid"
+
+// privateKeyPendingCreate is the private state key set when a create call
+// failed in a way that leaves its outcome unknown (timeout, dropped
+// connection). Read uses it to decide whether a missing object is expected.
+const privateKeyPendingCreate = "pending_create"
+
+// pendingCreateGracePeriod is how long after an unconfirmed create a missing
+// object is still expected to appear. Until then Read keeps the resource in
+// state, so the next apply does not create it a second time while ZStack may
+// still be working on the first create.
+const pendingCreateGracePeriod = time.Hour
+
+// privateStateData is the subset of the framework's private state data used
+// here. CreateResponse.Private, ReadRequest.Private and ReadResponse.Private
+// all satisfy it.
+type privateStateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type createRecord struct {
+	ResourceUuid string `json:"resourceUuid"`
+	Error        string `json:"error,omitempty"`
+	// Started is when the create call was made. It bounds how long Read
+	// waits for an interrupted create to finish.
+	Started time.Time `json:"started,omitzero"`
+}
+
+// newResourceUuid returns a random UUID in the format ZStack uses for
+// resource UUIDs: 32 lowercase hex digits without dashes.
+func newResourceUuid() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate resource uuid: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return hex.EncodeToString(b[:])
+}
+
+// queryLookup adapts a Query-style SDK method for adoptCreatedResource, for
+// resources whose SDK client has no Get method.
+func queryLookup[T any](queryFunc func(params *param.QueryParam) ([]T, error)) func(uuid string) (*T, error) {
+	return func(uuid string) (*T, error) {
+		return findResourceByQuery(queryFunc, uuid)
+	}
+}
+
+// recordResourceUuid generates the resource UUID for an idempotent create and
+// records it in private state before the create call is made. The caller sets
+// it as the ResourceUuid of the create parameters, and passes it to
+// adoptCreatedResource and savePendingCreate if the call fails.
+func recordResourceUuid(ctx context.Context, private privateStateData, diags *diag.Diagnostics) string {
+	resourceUuid := newResourceUuid()
+	value, _ := json.Marshal(createRecord{ResourceUuid: resourceUuid, Started: time.Now()})
+	diags.Append(private.SetKey(ctx, privateKeyResourceUuid, value)...)
+	return resourceUuid
+}
+
+// adoptCreatedResource is called when a create call with a client-generated
+// UUID failed. If ZStack did create the object (for example when the response
+// was lost to a timeout), the object is returned and the create succeeds
+// without a duplicate; otherwise createErr is returned unchanged.
+//
+// When the run itself was interrupted the object may still be being built, so
+// adoption is left to the next refresh, which also waits for it to finish.
+func adoptCreatedResource[T any](ctx context.Context, getFunc func(uuid string) (*T, error), resourceUuid string, createErr error) (*T, error) {
+	if ctx.Err() != nil {
+		return nil, createErr
+	}
+	existing, err := findResourceByGet(getFunc, resourceUuid)
+	if err != nil || existing == nil {
+		return nil, createErr
+	}
+	tflog.Warn(ctx, "create call failed but the resource exists, adopting it", map[string]any{
+		"resource_uuid": resourceUuid,
+		"error":         createErr.Error(),
+	})
+	return existing, nil
+}
+
+// isAmbiguousCreateError reports whether err leaves it unknown if ZStack went
+// on to create the object: the request may have been accepted even though no
+// response reached the provider.
+func isAmbiguousCreateError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"timeout", "timed out", "connection reset", "broken pipe", "eof", "status code 502", "status code 503", "status code 504"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// savePendingCreate handles a failed idempotent create. When the failure is
+// ambiguous, the planned values are saved to state with the client-generated
+// UUID and a pending marker, and a warning is returned instead of an error:
+// the resource is not tainted, and the next refresh either adopts the object
+// or, once pendingCreateGracePeriod has passed, drops it from state so it is
+// created again (see keepPendingCreate). Any other failure is reported as an
+// error and nothing is saved.
+//
+// Multi-step creates use this only when Read refreshes every attribute the
+// later steps set, so a step that never ran shows up as a change in the next
+// plan instead of being hidden by the saved plan values.
+func savePendingCreate(
+	ctx context.Context,
+	plan tfsdk.Plan,
+	state *tfsdk.State,
+	private privateStateData,
+	uuidPath path.Path,
+	resourceUuid string,
+	typeName string,
+	apiName string,
+	err error,
+	diags *diag.Diagnostics,
+) {
+	if !isAmbiguousCreateError(err) {
+		diags.Append(zstackErrorDiagnostic("Error creating "+typeName, "Could not create "+strings.ToLower(typeName), apiName, err))
+		return
+	}
+
+	raw, transformErr := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if transformErr != nil {
+		diags.Append(zstackErrorDiagnostic("Error creating "+typeName, "Could not create "+strings.ToLower(typeName), apiName, err))
+		return
+	}
+	state.Raw = raw
+	diags.Append(state.SetAttribute(ctx, uuidPath, resourceUuid)...)
+	if diags.HasError() {
+		return
+	}
+
+	record := createRecord{ResourceUuid: resourceUuid, Error: err.Error(), Started: time.Now()}
+	if started, ok := readCreateRecord(ctx, private, privateKeyResourceUuid); ok && !started.Started.IsZero() {
+		record.Started = started.Started
+	}
+	value, _ := json.Marshal(record)
+	diags.Append(private.SetKey(ctx, privateKeyPendingCreate, value)...)
+
+	diags.AddWarning(
+		typeName+" Creation Outcome Unknown",
+		fmt.Sprintf("The create call for %s %s failed before ZStack confirmed the result: %s\n\n"+
+			"The resource was saved to state with its client-generated UUID. "+
+			"The next refresh adopts it if ZStack created it, waiting for it to finish if it is still being created. "+
+			"If ZStack has not created it within %s of the create call, the refresh removes it from state so the next apply creates it again.",
+			strings.ToLower(typeName), resourceUuid, err.Error(), pendingCreateGracePeriod),
+	)
+}
+
+// pendingCreateUuid returns the resource UUID of an unconfirmed create
+// recorded by savePendingCreate, or "" when there is none.
+func pendingCreateUuid(ctx context.Context, private privateStateData) string {
+	record, _ := readCreateRecord(ctx, private, privateKeyPendingCreate)
+	return record.ResourceUuid
+}
+
+// keepPendingCreate reports whether a resource whose object Read did not find
+// must stay in state because an unconfirmed create may still produce it. It
+// is true, with a warning, until pendingCreateGracePeriod has passed since the
+// create call; records without a start time are not kept.
+func keepPendingCreate(ctx context.Context, private privateStateData, typeName string, diags *diag.Diagnostics) bool {
+	record, ok := readCreateRecord(ctx, private, privateKeyPendingCreate)
+	if !ok || record.ResourceUuid == "" || record.Started.IsZero() {
+		return false
+	}
+	deadline := record.Started.Add(pendingCreateGracePeriod)
+	if !time.Now().Before(deadline) {
+		return false
+	}
+
+	diags.AddWarning(
+		"Create Not Yet Confirmed",
+		fmt.Sprintf("ZStack has no %s with UUID %s yet, but its create call from %s failed before ZStack confirmed the result. "+
+			"The resource is kept in state until %s so that it is not created twice; "+
+			"if ZStack has not created it by then, the next refresh removes it from state and the next apply creates it again.",
+			typeName, record.ResourceUuid, record.Started.Format(time.RFC3339), deadline.Format(time.RFC3339)),
+	)
+	return true
+}
+
+// readCreateRecord returns the create record stored under key, and false
+// when there is none.
+func readCreateRecord(ctx context.Context, private privateStateData, key string) (createRecord, bool) {
+	var record createRecord
+	if private == nil {
+		return record, false
+	}
+	value, diags := private.GetKey(ctx, key)
+	if diags.HasError() || len(value) == 0 {
+		return record, false
+	}
+	if err := json.Unmarshal(value, &record); err != nil {
+		return createRecord{}, false
+	}
+	return record, true
+}
+
+// clearPendingCreate removes the pending marker once Read has found the
+// object a previously unconfirmed create produced.
+func clearPendingCreate(ctx context.Context, private privateStateData, diags *diag.Diagnostics) {
+	if private == nil || pendingCreateUuid(ctx, private) == "" {
+		return
+	}
+	tflog.Info(ctx, "adopted resource from a previously unconfirmed create")
+	diags.Append(private.SetKey(ctx, privateKeyPendingCreate, nil)...)
+}
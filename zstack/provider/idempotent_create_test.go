@@ -0,0 +1,246 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakePrivateState is an in-memory stand-in for the framework's private state data.
+type fakePrivateState map[string][]byte
+
+func (f fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return f[key], nil
+}
+
+func (f fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(f, key)
+		return nil
+	}
+	f[key] = value
+	return nil
+}
+
+type fakeCreated struct {
+	UUID string
+}
+
+func TestNewResourceUuid_Format(t *testing.T) {
+	format := regexp.MustCompile(`^[0-9a-f]{32}$`)
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		uuid := newResourceUuid()
+		if !format.MatchString(uuid) {
+			t.Fatalf("resource uuid %q is not in ZStack format", uuid)
+		}
+		if seen[uuid] {
+			t.Fatalf("duplicate resource uuid %q", uuid)
+		}
+		seen[uuid] = true
+	}
+}
+
+func TestRecordResourceUuid_StoresUuidBeforeCreate(t *testing.T) {
+	private := fakePrivateState{}
+	var diags diag.Diagnostics
+
+	uuid := recordResourceUuid(context.Background(), private, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	record, ok := readCreateRecord(context.Background(), private, privateKeyResourceUuid)
+	if !ok || record.ResourceUuid != uuid {
+		t.Errorf("private state should hold the generated uuid, got %s", private[privateKeyResourceUuid])
+	}
+	if record.Started.IsZero() {
+		t.Error("private state should record when the create started")
+	}
+}
+
+func TestAdoptCreatedResource_AdoptsExistingObject(t *testing.T) {
+	createErr := errors.New("Post \"http://mn/zstack/v1/images\": context deadline exceeded")
+	get := func(uuid string) (*fakeCreated, error) {
+		return &fakeCreated{UUID: uuid}, nil
+	}
+
+	got, err := adoptCreatedResource(context.Background(), get, "0123456789abcdef0123456789abcdef", createErr)
+	if err != nil {
+		t.Fatalf("expected the existing object to be adopted, got %v", err)
+	}
+	if got.UUID != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("adopted wrong object %q", got.UUID)
+	}
+}
+
+func TestAdoptCreatedResource_ReturnsCreateErrorWhenMissing(t *testing.T) {
+	createErr := errors.New("invalid argument")
+	get := func(uuid string) (*fakeCreated, error) {
+		return nil, errors.New("status code 404")
+	}
+
+	got, err := adoptCreatedResource(context.Background(), get, "0123456789abcdef0123456789abcdef", createErr)
+	if got != nil || err != createErr {
+		t.Fatalf("expected the original create error, got %v, %v", got, err)
+	}
+}
+
+func TestIsAmbiguousCreateError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":               {nil, false},
+		"deadline":          {fmt.Errorf("wait job: %w", context.DeadlineExceeded), true},
+		"net error":         {&net.OpError{Op: "read", Err: errors.New("connection refused")}, true},
+		"client timeout":    {errors.New("Client.Timeout exceeded while awaiting headers"), true},
+		"gateway timeout":   {errors.New("failed with status code 504"), true},
+		"validation failed": {errors.New("status code 400: name cannot be empty"), false},
+		"quota exceeded":    {errors.New("quota exceeded for vm.num"), false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isAmbiguousCreateError(tc.err); got != tc.want {
+				t.Errorf("isAmbiguousCreateError(%v) = %t, want %t", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func pendingCreateSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func pendingCreatePlan() tfsdk.Plan {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"uuid": tftypes.String,
+		"name": tftypes.String,
+	}}
+	return tfsdk.Plan{
+		Schema: pendingCreateSchema(),
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"uuid": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, "web"),
+		}),
+	}
+}
+
+func TestSavePendingCreate_AmbiguousFailureSavesState(t *testing.T) {
+	ctx := context.Background()
+	private := fakePrivateState{}
+	state := tfsdk.State{Schema: pendingCreateSchema()}
+	var diags diag.Diagnostics
+
+	savePendingCreate(ctx, pendingCreatePlan(), &state, private, path.Root("uuid"), "0123456789abcdef0123456789abcdef",
+		"Image", "AddImage", context.DeadlineExceeded, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("ambiguous failures should not be errors: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected one warning, got %d", diags.WarningsCount())
+	}
+
+	var uuid, name string
+	state.GetAttribute(ctx, path.Root("uuid"), &uuid)
+	state.GetAttribute(ctx, path.Root("name"), &name)
+	if uuid != "0123456789abcdef0123456789abcdef" || name != "web" {
+		t.Errorf("state should hold the planned values and generated uuid, got uuid=%q name=%q", uuid, name)
+	}
+	if got := pendingCreateUuid(ctx, private); got != uuid {
+		t.Errorf("pending marker should record %s, got %q", uuid, got)
+	}
+
+	clearPendingCreate(ctx, private, &diags)
+	if got := pendingCreateUuid(ctx, private); got != "" {
+		t.Errorf("pending marker should be cleared, got %q", got)
+	}
+}
+
+func TestSavePendingCreate_DefinitiveFailureIsError(t *testing.T) {
+	private := fakePrivateState{}
+	state := tfsdk.State{Schema: pendingCreateSchema()}
+	var diags diag.Diagnostics
+
+	savePendingCreate(context.Background(), pendingCreatePlan(), &state, private, path.Root("uuid"), "0123456789abcdef0123456789abcdef",
+		"Image", "AddImage", errors.New("status code 400: url is not reachable"), &diags)
+
+	if !diags.HasError() {
+		t.Fatal("definitive failures should be reported as errors")
+	}
+	if diags.Errors()[0].Summary() != "Error creating Image" {
+		t.Errorf("unexpected summary %q", diags.Errors()[0].Summary())
+	}
+	if !state.Raw.IsNull() {
+		t.Error("nothing should be saved to state")
+	}
+	if len(private) != 0 {
+		t.Error("no pending marker should be recorded")
+	}
+}
+
+func TestSavePendingCreate_KeepsCreateStartTime(t *testing.T) {
+	ctx := context.Background()
+	started := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	private := fakePrivateState{
+		privateKeyResourceUuid: []byte(`{"resourceUuid":"0123456789abcdef0123456789abcdef","started":"` + started.Format(time.RFC3339) + `"}`),
+	}
+	state := tfsdk.State{Schema: pendingCreateSchema()}
+	var diags diag.Diagnostics
+
+	savePendingCreate(ctx, pendingCreatePlan(), &state, private, path.Root("uuid"), "0123456789abcdef0123456789abcdef",
+		"Image", "AddImage", context.Canceled, &diags)
+
+	record, ok := readCreateRecord(ctx, private, privateKeyPendingCreate)
+	if !ok || !record.Started.Equal(started) {
+		t.Errorf("pending marker should keep the create start time %s, got %s", started, record.Started)
+	}
+}
+
+func TestKeepPendingCreate(t *testing.T) {
+	ctx := context.Background()
+	marker := func(started time.Time) fakePrivateState {
+		value, _ := json.Marshal(createRecord{ResourceUuid: "0123456789abcdef0123456789abcdef", Error: "timeout", Started: started})
+		return fakePrivateState{privateKeyPendingCreate: value}
+	}
+
+	cases := map[string]struct {
+		private fakePrivateState
+		want    bool
+	}{
+		"no pending create":   {fakePrivateState{}, false},
+		"recent create":       {marker(time.Now().Add(-time.Minute)), true},
+		"grace period passed": {marker(time.Now().Add(-pendingCreateGracePeriod - time.Minute)), false},
+		"no start time":       {marker(time.Time{}), false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if got := keepPendingCreate(ctx, tc.private, "zstack_zone", &diags); got != tc.want {
+				t.Errorf("keepPendingCreate() = %t, want %t", got, tc.want)
+			}
+			if tc.want && diags.WarningsCount() != 1 {
+				t.Errorf("expected a warning while the resource is kept, got %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// pendingCreatePollInterval is how often Read polls an object whose create
+// was interrupted while ZStack is still building it.
+var pendingCreatePollInterval = 10 * time.Second
+
+// runInterruptible runs a blocking create call and returns as soon as the
+// Terraform operation is cancelled (Ctrl-C, terraform being stopped), instead
+// of holding the provider until the ZStack job finishes. The call keeps
+// running in the background; the returned error wraps ctx.Err(), which
+// savePendingCreate treats as an unconfirmed create so the resource UUID is
+// saved to state before the provider exits.
+func runInterruptible[P, T any](ctx context.Context, call func(P) (*T, error), params P) (*T, error) {
+	type result struct {
+		value *T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := call(params)
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("create interrupted before ZStack finished: %w", ctx.Err())
+	}
+}
+
+// resumePendingCreate is called by Read once the object has been found. When
+// private state records an unconfirmed create for it, the object is adopted:
+// if ZStack is still building it, Read keeps waiting until ready reports true,
+// then clears the pending marker.
+//
+// ErrResourceNotFound is returned when the object disappears while waiting,
+// which is how ZStack reports a failed create. If the wait itself is
+// cancelled, the last seen object is returned with a warning and the marker is
+// kept, so the next run resumes waiting. Once timeout has passed since the
+// create call was made, waiting stops with an error diagnostic instead.
+func resumePendingCreate[T any](
+	ctx context.Context,
+	private privateStateData,
+	getFunc func(uuid string) (*T, error),
+	current *T,
+	ready func(*T) bool,
+	timeout time.Duration,
+	diags *diag.Diagnostics,
+) (*T, error) {
+	record, _ := readCreateRecord(ctx, private, privateKeyPendingCreate)
+	uuid := record.ResourceUuid
+	if uuid == "" {
+		return current, nil
+	}
+
+	started := record.Started
+	if started.IsZero() {
+		started = time.Now()
+	}
+	deadline := started.Add(timeout)
+
+	for !ready(current) {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			diags.AddError(
+				"Create Did Not Finish",
+				fmt.Sprintf("ZStack has not finished creating %s, started at %s by an earlier interrupted run, within the create timeout of %s. "+
+					"Check the progress of the object on the management node. Once it is ready, the next refresh adopts it; "+
+					"if its create failed, delete it in ZStack and run terraform state rm on this resource so it is created again.",
+					uuid, started.Format(time.RFC3339), timeout),
+			)
+			return current, nil
+		}
+
+		tflog.Info(ctx, "waiting for interrupted create to finish", map[string]any{"resource_uuid": uuid})
+
+		timer := time.NewTimer(min(pendingCreatePollInterval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			diags.AddWarning(
+				"Create Still In Progress",
+				fmt.Sprintf("ZStack is still creating %s from an earlier interrupted run. "+
+					"It is kept in state and the next run continues waiting for it.", uuid),
+			)
+			return current, nil
+		case <-timer.C:
+		}
+
+		next, err := findResourceByGet(getFunc, uuid)
+		if err != nil {
+			if errors.Is(err, ErrResourceNotFound) {
+				// The create failed; let Read drop the resource.
+				diags.Append(private.SetKey(ctx, privateKeyPendingCreate, nil)...)
+			}
+			return nil, err
+		}
+		current = next
+	}
+
+	clearPendingCreate(ctx, private, diags)
+	return current, nil
+}
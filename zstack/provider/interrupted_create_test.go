@@ -0,0 +1,196 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type fakeBuilding struct {
+	UUID   string
+	Status string
+}
+
+func fakeBuildingReady(o *fakeBuilding) bool {
+	return o.Status == "Ready"
+}
+
+func withFastPendingCreatePoll(t *testing.T) {
+	t.Helper()
+	previous := pendingCreatePollInterval
+	pendingCreatePollInterval = time.Millisecond
+	t.Cleanup(func() { pendingCreatePollInterval = previous })
+}
+
+func markPendingCreate(t *testing.T, uuid string) fakePrivateState {
+	t.Helper()
+	private := fakePrivateState{}
+	private[privateKeyPendingCreate] = []byte(`{"resourceUuid":"` + uuid + `"}`)
+	return private
+}
+
+func TestRunInterruptible_ReturnsResult(t *testing.T) {
+	got, err := runInterruptible(context.Background(), func(name string) (*fakeBuilding, error) {
+		return &fakeBuilding{UUID: name, Status: "Ready"}, nil
+	}, "image-1")
+	if err != nil || got.UUID != "image-1" {
+		t.Fatalf("unexpected result %v, %v", got, err)
+	}
+}
+
+func TestRunInterruptible_ReturnsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+
+	go cancel()
+	_, err := runInterruptible(ctx, func(string) (*fakeBuilding, error) {
+		<-release
+		return nil, nil
+	}, "image-1")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancellation error, got %v", err)
+	}
+	if !isAmbiguousCreateError(err) {
+		t.Error("an interrupted create must be treated as unconfirmed")
+	}
+}
+
+func TestAdoptCreatedResource_DeferredWhenInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	createErr := errors.New("create interrupted")
+
+	got, err := adoptCreatedResource(ctx, func(uuid string) (*fakeBuilding, error) {
+		return &fakeBuilding{UUID: uuid, Status: "Downloading"}, nil
+	}, "0123456789abcdef0123456789abcdef", createErr)
+	if got != nil || err != createErr {
+		t.Fatalf("adoption should be left to the next refresh, got %v, %v", got, err)
+	}
+}
+
+func TestResumePendingCreate_NoMarker(t *testing.T) {
+	current := &fakeBuilding{UUID: "image-1", Status: "Downloading"}
+	var diags diag.Diagnostics
+
+	got, err := resumePendingCreate(context.Background(), fakePrivateState{}, func(string) (*fakeBuilding, error) {
+		t.Fatal("objects without a pending create must not be polled")
+		return nil, nil
+	}, current, fakeBuildingReady, time.Hour, &diags)
+	if err != nil || got != current {
+		t.Fatalf("unexpected result %v, %v", got, err)
+	}
+}
+
+func TestResumePendingCreate_WaitsUntilReady(t *testing.T) {
+	withFastPendingCreatePoll(t)
+	private := markPendingCreate(t, "image-1")
+	statuses := []string{"Downloading", "Downloading", "Ready"}
+	polls := 0
+	var diags diag.Diagnostics
+
+	got, err := resumePendingCreate(context.Background(), private, func(uuid string) (*fakeBuilding, error) {
+		status := statuses[polls]
+		polls++
+		return &fakeBuilding{UUID: uuid, Status: status}, nil
+	}, &fakeBuilding{UUID: "image-1", Status: "Downloading"}, fakeBuildingReady, time.Hour, &diags)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != "Ready" || polls != 3 {
+		t.Errorf("expected to wait for Ready over 3 polls, got %s after %d", got.Status, polls)
+	}
+	if pendingCreateUuid(context.Background(), private) != "" {
+		t.Error("pending marker should be cleared once the object is ready")
+	}
+}
+
+func TestResumePendingCreate_FailedCreate(t *testing.T) {
+	withFastPendingCreatePoll(t)
+	private := markPendingCreate(t, "image-1")
+	var diags diag.Diagnostics
+
+	_, err := resumePendingCreate(context.Background(), private, func(string) (*fakeBuilding, error) {
+		return nil, errors.New("status code 404")
+	}, &fakeBuilding{UUID: "image-1", Status: "Downloading"}, fakeBuildingReady, time.Hour, &diags)
+
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Fatalf("expected ErrResourceNotFound, got %v", err)
+	}
+	if pendingCreateUuid(context.Background(), private) != "" {
+		t.Error("pending marker should be cleared when the object disappears, so Read drops the resource")
+	}
+}
+
+func TestResumePendingCreate_CancelledKeepsMarker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	private := markPendingCreate(t, "image-1")
+	current := &fakeBuilding{UUID: "image-1", Status: "Downloading"}
+	var diags diag.Diagnostics
+
+	got, err := resumePendingCreate(ctx, private, func(string) (*fakeBuilding, error) {
+		return current, nil
+	}, current, fakeBuildingReady, time.Hour, &diags)
+
+	if err != nil || got != current {
+		t.Fatalf("unexpected result %v, %v", got, err)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning, got %d", diags.WarningsCount())
+	}
+	if pendingCreateUuid(ctx, private) != "image-1" {
+		t.Error("pending marker must be kept so the next run resumes waiting")
+	}
+}
+
+func TestResumePendingCreate_StopsAtCreateTimeout(t *testing.T) {
+	withFastPendingCreatePoll(t)
+	started := time.Now().Add(-2 * time.Hour).UTC()
+	private := fakePrivateState{
+		privateKeyPendingCreate: []byte(`{"resourceUuid":"image-1","started":"` + started.Format(time.RFC3339) + `"}`),
+	}
+	current := &fakeBuilding{UUID: "image-1", Status: "Downloading"}
+	var diags diag.Diagnostics
+
+	got, err := resumePendingCreate(context.Background(), private, func(string) (*fakeBuilding, error) {
+		t.Fatal("a create past its timeout must not be polled")
+		return nil, nil
+	}, current, fakeBuildingReady, time.Hour, &diags)
+
+	if err != nil || got != current {
+		t.Fatalf("unexpected result %v, %v", got, err)
+	}
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Create Did Not Finish" {
+		t.Fatalf("expected a create timeout error, got %v", diags)
+	}
+	if pendingCreateUuid(context.Background(), private) != "image-1" {
+		t.Error("pending marker must be kept until the object is ready or removed")
+	}
+}
+
+func TestResumePendingCreate_TimeoutWhileWaiting(t *testing.T) {
+	withFastPendingCreatePoll(t)
+	private := markPendingCreate(t, "image-1")
+	polls := 0
+	var diags diag.Diagnostics
+
+	_, err := resumePendingCreate(context.Background(), private, func(uuid string) (*fakeBuilding, error) {
+		polls++
+		return &fakeBuilding{UUID: uuid, Status: "Downloading"}, nil
+	}, &fakeBuilding{UUID: "image-1", Status: "Downloading"}, fakeBuildingReady, 20*time.Millisecond, &diags)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if polls == 0 || !diags.HasError() {
+		t.Errorf("expected to poll until the timeout and then fail, got %d polls and %v", polls, diags)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+type l3NetworkHostRouteModel struct {
+	Prefix  types.String `tfsdk:"prefix"`
+	Nexthop types.String `tfsdk:"nexthop"`
+}
+
+var l3NetworkHostRouteAttrTypes = map[string]attr.Type{
+	"prefix":  types.StringType,
+	"nexthop": types.StringType,
+}
+
+// l3NetworkHostRouteSpec is a static route pushed to guests through DHCP
+// option 121.
+type l3NetworkHostRouteSpec struct {
+	Prefix  string
+	Nexthop string
+}
+
+// diffDnsServers returns the DNS servers to remove from and add to an L3
+// network to turn current into desired. DNS servers are kept in order, so
+// everything after the longest common prefix is removed and re-added.
+func diffDnsServers(current, desired []string) (remove, add []string) {
+	common := 0
+	for common < len(current) && common < len(desired) && current[common] == desired[common] {
+		common++
+	}
+	return current[common:], desired[common:]
+}
+
+// diffL3NetworkHostRoutes returns the host routes to remove from and add to an
+// L3 network. A route whose next hop changes is removed and added again.
+func diffL3NetworkHostRoutes(current, desired []l3NetworkHostRouteSpec) (remove, add []l3NetworkHostRouteSpec) {
+	currentSet := make(map[l3NetworkHostRouteSpec]bool, len(current))
+	for _, r := range current {
+		currentSet[r] = true
+	}
+	desiredSet := make(map[l3NetworkHostRouteSpec]bool, len(desired))
+	for _, r := range desired {
+		desiredSet[r] = true
+	}
+
+	for _, r := range current {
+		if !desiredSet[r] {
+			remove = append(remove, r)
+		}
+	}
+	for _, r := range desired {
+		if !currentSet[r] {
+			add = append(add, r)
+		}
+	}
+	sortL3NetworkHostRoutes(remove)
+	sortL3NetworkHostRoutes(add)
+	return remove, add
+}
+
+func sortL3NetworkHostRoutes(routes []l3NetworkHostRouteSpec) {
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Prefix < routes[j].Prefix
+	})
+}
+
+// l3NetworkHostRouteSpecs returns the host routes configured on an L3 network.
+func l3NetworkHostRouteSpecs(l3 *view.L3NetworkInventoryView) []l3NetworkHostRouteSpec {
+	routes := make([]l3NetworkHostRouteSpec, 0, len(l3.HostRoute))
+	for _, r := range l3.HostRoute {
+		routes = append(routes, l3NetworkHostRouteSpec{Prefix: r.Prefix, Nexthop: r.Nexthop})
+	}
+	sortL3NetworkHostRoutes(routes)
+	return routes
+}
+
+func l3NetworkHostRoutesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []l3NetworkHostRouteSpec {
+	var models []l3NetworkHostRouteModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	routes := make([]l3NetworkHostRouteSpec, 0, len(models))
+	for _, m := range models {
+		routes = append(routes, l3NetworkHostRouteSpec{Prefix: m.Prefix.ValueString(), Nexthop: m.Nexthop.ValueString()})
+	}
+	return routes
+}
+
+func l3NetworkHostRoutesToSet(routes []l3NetworkHostRouteSpec, diags *diag.Diagnostics) types.Set {
+	elemType := types.ObjectType{AttrTypes: l3NetworkHostRouteAttrTypes}
+	elems := make([]attr.Value, 0, len(routes))
+	for _, r := range routes {
+		obj, d := types.ObjectValue(l3NetworkHostRouteAttrTypes, map[string]attr.Value{
+			"prefix":  types.StringValue(r.Prefix),
+			"nexthop": types.StringValue(r.Nexthop),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	set, d := types.SetValue(elemType, elems)
+	diags.Append(d...)
+	return set
+}
+
+// reconcileL3NetworkDns removes the DNS servers of the L3 network that are
+// not desired and adds the missing ones, preserving the desired order.
+func reconcileL3NetworkDns(ctx context.Context, cli *client.ZSClient, l3 *view.L3NetworkInventoryView, desired []string, summary string, diags *diag.Diagnostics) {
+	remove, add := diffDnsServers(l3.Dns, desired)
+	tflog.Debug(ctx, "Reconciling L3 network DNS servers", map[string]any{
+		"l3_network_uuid": l3.UUID,
+		"remove":          fmt.Sprint(remove),
+		"add":             fmt.Sprint(add),
+	})
+
+	for _, dns := range remove {
+		if err := cli.RemoveDnsFromL3Network(l3.UUID, dns); err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not remove DNS server %s from L3 network %s", dns, l3.UUID), "RemoveDnsFromL3Network", err))
+			return
+		}
+	}
+
+	for _, dns := range add {
+		_, err := cli.AddDnsToL3Network(l3.UUID, param.AddDnsToL3NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddDnsToL3NetworkParamDetail{
+				Dns: dns,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not add DNS server %s to L3 network %s", dns, l3.UUID), "AddDnsToL3Network", err))
+			return
+		}
+	}
+}
+
+// reconcileL3NetworkHostRoutes removes the host routes of the L3 network that
+// are not desired and adds the missing ones.
+func reconcileL3NetworkHostRoutes(ctx context.Context, cli *client.ZSClient, l3 *view.L3NetworkInventoryView, desired []l3NetworkHostRouteSpec, summary string, diags *diag.Diagnostics) {
+	remove, add := diffL3NetworkHostRoutes(l3NetworkHostRouteSpecs(l3), desired)
+	tflog.Debug(ctx, "Reconciling L3 network host routes", map[string]any{
+		"l3_network_uuid": l3.UUID,
+		"remove":          fmt.Sprint(remove),
+		"add":             fmt.Sprint(add),
+	})
+
+	for _, route := range remove {
+		if err := cli.RemoveHostRouteFromL3Network(l3.UUID, route.Prefix); err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not remove host route %s from L3 network %s", route.Prefix, l3.UUID), "RemoveHostRouteFromL3Network", err))
+			return
+		}
+	}
+
+	for _, route := range add {
+		_, err := cli.AddHostRouteToL3Network(l3.UUID, param.AddHostRouteToL3NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddHostRouteToL3NetworkParamDetail{
+				Prefix:  route.Prefix,
+				Nexthop: route.Nexthop,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not add host route %s to L3 network %s", route.Prefix, l3.UUID), "AddHostRouteToL3Network", err))
+			return
+		}
+	}
+}
+
+// setL3NetworkMtu sets the MTU of the L3 network.
+func setL3NetworkMtu(cli *client.ZSClient, l3Uuid string, mtu int64, summary string, diags *diag.Diagnostics) {
+	_, err := cli.SetL3NetworkMtu(param.SetL3NetworkMtuParam{
+		BaseParam: param.BaseParam{},
+		Params: param.SetL3NetworkMtuParamDetail{
+			L3NetworkUuid: l3Uuid,
+			Mtu:           int(mtu),
+		},
+	})
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not set MTU %d on L3 network %s", mtu, l3Uuid), "SetL3NetworkMtu", err))
+	}
+}
+
+// getL3NetworkMtu returns the MTU of the L3 network.
+func getL3NetworkMtu(cli *client.ZSClient, l3Uuid string) (int64, error) {
+	result, err := cli.GetL3NetworkMtu(l3Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return int64(result.Mtu), nil
+}
@@ -0,0 +1,217 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+// l3NetworkServiceTypes are the network service types that can be enabled on
+// an L3 network.
+var l3NetworkServiceTypes = []string{
+	"DHCP", "DNS", "SNAT", "Eip", "PortForwarding", "LoadBalancer", "SecurityGroup",
+	"Userdata", "HostRoute", "IPsec", "VRouterRoute", "VipQos", "CentralizedDNS",
+}
+
+type l3NetworkServiceModel struct {
+	Type     types.String `tfsdk:"type"`
+	Provider types.String `tfsdk:"provider"`
+}
+
+var l3NetworkServiceAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"provider": types.StringType,
+}
+
+// l3NetworkServiceSpec is one network service enabled on an L3 network,
+// identified by the service type and the type of its provider.
+type l3NetworkServiceSpec struct {
+	Type     string
+	Provider string
+}
+
+// diffL3NetworkServices returns the services to detach from and attach to an
+// L3 network to turn current into desired. A service whose provider changes
+// is detached from the old provider and attached to the new one.
+func diffL3NetworkServices(current, desired []l3NetworkServiceSpec) (attach, detach []l3NetworkServiceSpec) {
+	currentSet := make(map[l3NetworkServiceSpec]bool, len(current))
+	for _, s := range current {
+		currentSet[s] = true
+	}
+	desiredSet := make(map[l3NetworkServiceSpec]bool, len(desired))
+	for _, s := range desired {
+		desiredSet[s] = true
+	}
+
+	for _, s := range desired {
+		if !currentSet[s] {
+			attach = append(attach, s)
+		}
+	}
+	for _, s := range current {
+		if !desiredSet[s] {
+			detach = append(detach, s)
+		}
+	}
+	sortL3NetworkServices(attach)
+	sortL3NetworkServices(detach)
+	return attach, detach
+}
+
+func sortL3NetworkServices(specs []l3NetworkServiceSpec) {
+	sort.Slice(specs, func(i, j int) bool {
+		if specs[i].Provider != specs[j].Provider {
+			return specs[i].Provider < specs[j].Provider
+		}
+		return specs[i].Type < specs[j].Type
+	})
+}
+
+// groupL3NetworkServices builds the networkServices parameter of the attach
+// and detach APIs: a map from provider UUID to service types.
+// providerUuids maps provider types to provider UUIDs.
+func groupL3NetworkServices(specs []l3NetworkServiceSpec, providerUuids map[string]string) (map[string]interface{}, error) {
+	grouped := make(map[string][]string)
+	for _, s := range specs {
+		providerUuid, ok := providerUuids[s.Provider]
+		if !ok {
+			return nil, fmt.Errorf("network service provider %q does not exist", s.Provider)
+		}
+		grouped[providerUuid] = append(grouped[providerUuid], s.Type)
+	}
+
+	networkServices := make(map[string]interface{}, len(grouped))
+	for providerUuid, serviceTypes := range grouped {
+		networkServices[providerUuid] = serviceTypes
+	}
+	return networkServices, nil
+}
+
+// queryNetworkServiceProviders returns the network service providers keyed by
+// type and by UUID.
+func queryNetworkServiceProviders(cli *client.ZSClient) (byType, byUuid map[string]string, err error) {
+	q := param.NewQueryParam()
+	providers, err := cli.QueryNetworkServiceProvider(&q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byType = make(map[string]string, len(providers))
+	byUuid = make(map[string]string, len(providers))
+	for _, p := range providers {
+		byType[p.Type] = p.UUID
+		byUuid[p.UUID] = p.Type
+	}
+	return byType, byUuid, nil
+}
+
+// l3NetworkServiceSpecs returns the services enabled on an L3 network.
+func l3NetworkServiceSpecs(l3 *view.L3NetworkInventoryView, providerTypes map[string]string) []l3NetworkServiceSpec {
+	specs := make([]l3NetworkServiceSpec, 0, len(l3.NetworkServices))
+	for _, ref := range l3.NetworkServices {
+		provider, ok := providerTypes[ref.NetworkServiceProviderUuid]
+		if !ok {
+			provider = ref.NetworkServiceProviderUuid
+		}
+		specs = append(specs, l3NetworkServiceSpec{Type: ref.NetworkServiceType, Provider: provider})
+	}
+	sortL3NetworkServices(specs)
+	return specs
+}
+
+func l3NetworkServicesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []l3NetworkServiceSpec {
+	var models []l3NetworkServiceModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	specs := make([]l3NetworkServiceSpec, 0, len(models))
+	for _, m := range models {
+		specs = append(specs, l3NetworkServiceSpec{Type: m.Type.ValueString(), Provider: m.Provider.ValueString()})
+	}
+	return specs
+}
+
+func l3NetworkServicesToSet(specs []l3NetworkServiceSpec, diags *diag.Diagnostics) types.Set {
+	elemType := types.ObjectType{AttrTypes: l3NetworkServiceAttrTypes}
+	elems := make([]attr.Value, 0, len(specs))
+	for _, s := range specs {
+		obj, d := types.ObjectValue(l3NetworkServiceAttrTypes, map[string]attr.Value{
+			"type":     types.StringValue(s.Type),
+			"provider": types.StringValue(s.Provider),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	set, d := types.SetValue(elemType, elems)
+	diags.Append(d...)
+	return set
+}
+
+// reconcileL3NetworkServices detaches the services of the L3 network that are
+// not desired and attaches the missing ones.
+func reconcileL3NetworkServices(ctx context.Context, cli *client.ZSClient, l3Uuid string, desired []l3NetworkServiceSpec, summary string, diags *diag.Diagnostics) {
+	providerUuids, providerTypes, err := queryNetworkServiceProviders(cli)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not query network service providers", "QueryNetworkServiceProvider", err))
+		return
+	}
+
+	l3, err := findResourceByQuery(cli.QueryL3Network, l3Uuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not read L3 network "+l3Uuid, "QueryL3Network", err))
+		return
+	}
+
+	attach, detach := diffL3NetworkServices(l3NetworkServiceSpecs(l3, providerTypes), desired)
+	tflog.Debug(ctx, "Reconciling L3 network services", map[string]any{
+		"l3_network_uuid": l3Uuid,
+		"attach":          fmt.Sprint(attach),
+		"detach":          fmt.Sprint(detach),
+	})
+
+	if len(detach) > 0 {
+		networkServices, err := groupL3NetworkServices(detach, providerUuids)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return
+		}
+		err = cli.DetachNetworkServiceFromL3Network(l3Uuid, param.DetachNetworkServiceFromL3NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.DetachNetworkServiceFromL3NetworkParamDetail{
+				NetworkServices: networkServices,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, "Could not detach network services from L3 network "+l3Uuid, "DetachNetworkServiceFromL3Network", err))
+			return
+		}
+	}
+
+	if len(attach) > 0 {
+		networkServices, err := groupL3NetworkServices(attach, providerUuids)
+		if err != nil {
+			diags.AddAttributeError(path.Root("network_services"), summary, err.Error())
+			return
+		}
+		_, err = cli.AttachNetworkServiceToL3Network(l3Uuid, param.AttachNetworkServiceToL3NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AttachNetworkServiceToL3NetworkParamDetail{
+				NetworkServices: networkServices,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, "Could not attach network services to L3 network "+l3Uuid, "AttachNetworkServiceToL3Network", err))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIpAddressValidator(t *testing.T) {
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: "192.168.1.1"},
+		{value: "2001:db8::1"},
+		{value: "192.168.1.256", expectError: true},
+		{value: "192.168.1.0/24", expectError: true},
+		{value: "dns.example.com", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			req := validator.StringRequest{ConfigValue: types.StringValue(tt.value)}
+			res := validator.StringResponse{}
+			ipAddressValidator{}.ValidateString(context.Background(), req, &res)
+
+			if res.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("unexpected diagnostics for %q: %v", tt.value, res.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCidrValidator(t *testing.T) {
+	tests := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: "169.254.169.254/32"},
+		{value: "10.0.0.0/8"},
+		{value: "2001:db8::/64"},
+		{value: "10.0.0.1", expectError: true},
+		{value: "10.0.0.0/33", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			req := validator.StringRequest{ConfigValue: types.StringValue(tt.value)}
+			res := validator.StringResponse{}
+			cidrValidator{}.ValidateString(context.Background(), req, &res)
+
+			if res.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("unexpected diagnostics for %q: %v", tt.value, res.Diagnostics)
+			}
+		})
+	}
+}
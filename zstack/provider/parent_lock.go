@@ -0,0 +1,126 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// parentLocks serializes mutating API calls that act on the same parent
+// resource (VM instance, L3 network, virtual router). Terraform runs
+// independent resources in parallel, but ZStack rejects a second operation
+// on a VM that is still in an intermediate state, so resources such as
+// zstack_vm_nic, zstack_vm_cdrom and zstack_volume attachments take the lock
+// of their parent for the duration of the call.
+//
+// The lock set is provider-wide: every resource instance in the provider
+// process shares it. ZStack UUIDs are globally unique, so they are used as
+// keys directly.
+var parentLocks = newParentLockSet()
+
+type parentLockSet struct {
+	mu    sync.Mutex
+	locks map[string]*parentLock
+}
+
+type parentLock struct {
+	held chan struct{}
+	refs int
+}
+
+func newParentLockSet() *parentLockSet {
+	return &parentLockSet{locks: make(map[string]*parentLock)}
+}
+
+// lockParents is shorthand for parentLocks.lock.
+func lockParents(ctx context.Context, uuids ...string) (func(), error) {
+	return parentLocks.lock(ctx, uuids...)
+}
+
+// lock blocks until it holds the lock of every given parent UUID and returns
+// the function that releases them. Empty UUIDs are ignored and duplicates are
+// locked once. Locks are always taken in sorted order, so callers locking
+// several parents cannot deadlock each other. If ctx is cancelled while
+// waiting, the locks taken so far are released and ctx.Err() is returned.
+func (s *parentLockSet) lock(ctx context.Context, uuids ...string) (func(), error) {
+	keys := make([]string, 0, len(uuids))
+	seen := make(map[string]bool, len(uuids))
+	for _, uuid := range uuids {
+		if uuid == "" || seen[uuid] {
+			continue
+		}
+		seen[uuid] = true
+		keys = append(keys, uuid)
+	}
+	sort.Strings(keys)
+
+	held := make([]string, 0, len(keys))
+	unlock := func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			s.release(held[i])
+		}
+	}
+
+	for _, key := range keys {
+		if err := s.acquire(ctx, key); err != nil {
+			unlock()
+			return nil, err
+		}
+		held = append(held, key)
+	}
+	return unlock, nil
+}
+
+func (s *parentLockSet) acquire(ctx context.Context, key string) error {
+	s.mu.Lock()
+	l, ok := s.locks[key]
+	if !ok {
+		l = &parentLock{held: make(chan struct{}, 1)}
+		s.locks[key] = l
+	}
+	l.refs++
+	s.mu.Unlock()
+
+	select {
+	case l.held <- struct{}{}:
+		return nil
+	default:
+	}
+
+	start := time.Now()
+	tflog.Debug(ctx, "waiting for lock on parent resource", map[string]any{"parent_uuid": key})
+	select {
+	case l.held <- struct{}{}:
+		tflog.Debug(ctx, "acquired lock on parent resource", map[string]any{
+			"parent_uuid": key,
+			"waited":      time.Since(start).String(),
+		})
+		return nil
+	case <-ctx.Done():
+		s.unref(key, l)
+		return ctx.Err()
+	}
+}
+
+func (s *parentLockSet) release(key string) {
+	s.mu.Lock()
+	l := s.locks[key]
+	s.mu.Unlock()
+
+	<-l.held
+	s.unref(key, l)
+}
+
+func (s *parentLockSet) unref(key string, l *parentLock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l.refs--
+	if l.refs == 0 {
+		delete(s.locks, key)
+	}
+}
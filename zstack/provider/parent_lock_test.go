@@ -0,0 +1,133 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParentLocks_SerializesSameParent(t *testing.T) {
+	locks := newParentLockSet()
+	var active, maxActive int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := locks.lock(context.Background(), "vm-1")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			defer unlock()
+
+			n := atomic.AddInt32(&active, 1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+		}()
+	}
+	wg.Wait()
+
+	if maxActive != 1 {
+		t.Fatalf("operations on the same parent overlapped: %d ran at once", maxActive)
+	}
+	if len(locks.locks) != 0 {
+		t.Errorf("released locks should be removed, %d left", len(locks.locks))
+	}
+}
+
+func TestParentLocks_DifferentParentsRunInParallel(t *testing.T) {
+	locks := newParentLockSet()
+	unlockVm1, err := locks.lock(context.Background(), "vm-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer unlockVm1()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlockVm2, err := locks.lock(ctx, "vm-2")
+	if err != nil {
+		t.Fatalf("a different parent should not wait: %v", err)
+	}
+	unlockVm2()
+}
+
+func TestParentLocks_MultipleParentsDoNotDeadlock(t *testing.T) {
+	locks := newParentLockSet()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		parents := []string{"vm-1", "l3-1"}
+		if i%2 == 1 {
+			parents = []string{"l3-1", "vm-1"}
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := locks.lock(ctx, parents...)
+			if err != nil {
+				t.Errorf("locking %v failed: %v", parents, err)
+				return
+			}
+			time.Sleep(100 * time.Microsecond)
+			unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestParentLocks_CancelledWhileWaiting(t *testing.T) {
+	locks := newParentLockSet()
+	unlock, err := locks.lock(context.Background(), "vm-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := locks.lock(ctx, "l3-1", "vm-1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	// l3-1 was taken before waiting on vm-1 and must have been released.
+	unlockL3, err := locks.lock(context.Background(), "l3-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unlockL3()
+	unlock()
+
+	if len(locks.locks) != 0 {
+		t.Errorf("abandoned waits should not leak locks, %d left", len(locks.locks))
+	}
+}
+
+func TestParentLocks_IgnoresEmptyAndDuplicateParents(t *testing.T) {
+	locks := newParentLockSet()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	unlock, err := locks.lock(ctx, "", "vm-1", "vm-1")
+	if err != nil {
+		t.Fatalf("duplicate parents must not deadlock: %v", err)
+	}
+	if len(locks.locks) != 1 {
+		t.Errorf("expected a single lock, got %d", len(locks.locks))
+	}
+	unlock()
+}
@@ -11,12 +11,18 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
@@ -31,14 +37,34 @@ type ZStackProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// readOnly is set from the read_only attribute during Configure and
+	// consulted by readOnlyGuardResource when planning resource changes.
+	readOnly bool
+
+	// proxy is the local API proxy started by the last Configure, nil when
+	// no API policy needed one. It is closed when Configure runs again.
+	proxy *apiProxy
 }
 type ZStackProviderModel struct {
 	Host            types.String `tfsdk:"host"`
 	Port            types.Int64  `tfsdk:"port"`
+	Scheme          types.String `tfsdk:"scheme"`
 	AccountName     types.String `tfsdk:"account_name"`
 	AccountPassword types.String `tfsdk:"account_password"`
 	AccessKeyId     types.String `tfsdk:"access_key_id"`
 	AccessKeySecret types.String `tfsdk:"access_key_secret"`
+	ReadOnly        types.Bool   `tfsdk:"read_only"`
+	LoginType       types.String `tfsdk:"login_type"`
+	ProjectUuid     types.String `tfsdk:"project_uuid"`
+	ProjectName     types.String `tfsdk:"project_name"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	APILogFile            types.String  `tfsdk:"api_log_file"`
+	APILogRedactFields    types.List    `tfsdk:"api_log_redact_fields"`
+	DisableReadCache      types.Bool    `tfsdk:"disable_read_cache"`
+	ReadCacheTTL          types.Int64   `tfsdk:"read_cache_ttl"`
 }
 
 // Configure implements provider.Provider.
@@ -72,6 +98,14 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.Scheme.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scheme"),
+			"Unknown ZStack Cloud API Scheme",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_SCHEME environment variable.",
+		)
+	}
+
 	if config.AccountName.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("account_name"),
@@ -104,6 +138,77 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown ZStack read_only mode",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_READ_ONLY environment variable.",
+		)
+	}
+
+	if config.LoginType.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("login_type"),
+			"Unknown ZStack login_type",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_LOGIN_TYPE environment variable.",
+		)
+	}
+
+	if config.ProjectUuid.IsUnknown() || config.ProjectName.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown ZStack IAM2 project",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_PROJECT_UUID / ZSTACK_PROJECT_NAME environment variables.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown ZStack max_concurrent_requests",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_MAX_CONCURRENT_REQUESTS environment variable.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown ZStack requests_per_second",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_REQUESTS_PER_SECOND environment variable.",
+		)
+	}
+
+	if config.APILogFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_log_file"),
+			"Unknown ZStack api_log_file",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_API_LOG_FILE environment variable.",
+		)
+	}
+
+	if config.APILogRedactFields.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_log_redact_fields"),
+			"Unknown ZStack api_log_redact_fields",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_API_LOG_REDACT_FIELDS environment variable.",
+		)
+	}
+
+	if config.DisableReadCache.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disable_read_cache"),
+			"Unknown ZStack disable_read_cache",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_DISABLE_READ_CACHE environment variable.",
+		)
+	}
+
+	if config.ReadCacheTTL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_cache_ttl"),
+			"Unknown ZStack read_cache_ttl",
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the ZSTACK_READ_CACHE_TTL environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -115,10 +220,24 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	host := os.Getenv("ZSTACK_HOST")
 	portstr := os.Getenv("ZSTACK_PORT")
+	scheme := os.Getenv("ZSTACK_SCHEME")
 	account_name := os.Getenv("ZSTACK_ACCOUNT_NAME")
 	account_password := os.Getenv("ZSTACK_ACCOUNT_PASSWORD")
 	access_key_id := os.Getenv("ZSTACK_ACCESS_KEY_ID")
 	access_key_secret := os.Getenv("ZSTACK_ACCESS_KEY_SECRET")
+	read_only := false
+	login_type := os.Getenv("ZSTACK_LOGIN_TYPE")
+	project_uuid := os.Getenv("ZSTACK_PROJECT_UUID")
+	project_name := os.Getenv("ZSTACK_PROJECT_NAME")
+	max_concurrent_requests := 0
+	requests_per_second := 0.0
+	api_log_file := os.Getenv("ZSTACK_API_LOG_FILE")
+	var api_log_redact_fields []string
+	disable_read_cache := false
+	read_cache_ttl := int(defaultReadCacheTTL / time.Second)
+	if redactFieldsStr := os.Getenv("ZSTACK_API_LOG_REDACT_FIELDS"); redactFieldsStr != "" {
+		api_log_redact_fields = strings.Split(redactFieldsStr, ",")
+	}
 
 	if portstr != "" {
 		if portInt, err := strconv.Atoi(portstr); err == nil {
@@ -126,6 +245,71 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
+	if readOnlyStr := os.Getenv("ZSTACK_READ_ONLY"); readOnlyStr != "" {
+		readOnly, err := strconv.ParseBool(readOnlyStr)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid ZSTACK_READ_ONLY value",
+				"The ZSTACK_READ_ONLY environment variable must be a boolean (true/false), got: "+readOnlyStr,
+			)
+			return
+		}
+		read_only = readOnly
+	}
+
+	if maxConcurrentStr := os.Getenv("ZSTACK_MAX_CONCURRENT_REQUESTS"); maxConcurrentStr != "" {
+		maxConcurrent, err := strconv.Atoi(maxConcurrentStr)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid ZSTACK_MAX_CONCURRENT_REQUESTS value",
+				"The ZSTACK_MAX_CONCURRENT_REQUESTS environment variable must be an integer, got: "+maxConcurrentStr,
+			)
+			return
+		}
+		max_concurrent_requests = maxConcurrent
+	}
+
+	if perSecondStr := os.Getenv("ZSTACK_REQUESTS_PER_SECOND"); perSecondStr != "" {
+		perSecond, err := strconv.ParseFloat(perSecondStr, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid ZSTACK_REQUESTS_PER_SECOND value",
+				"The ZSTACK_REQUESTS_PER_SECOND environment variable must be a number, got: "+perSecondStr,
+			)
+			return
+		}
+		requests_per_second = perSecond
+	}
+
+	if disableReadCacheStr := os.Getenv("ZSTACK_DISABLE_READ_CACHE"); disableReadCacheStr != "" {
+		disableReadCache, err := strconv.ParseBool(disableReadCacheStr)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("disable_read_cache"),
+				"Invalid ZSTACK_DISABLE_READ_CACHE value",
+				"The ZSTACK_DISABLE_READ_CACHE environment variable must be a boolean (true/false), got: "+disableReadCacheStr,
+			)
+			return
+		}
+		disable_read_cache = disableReadCache
+	}
+
+	if readCacheTTLStr := os.Getenv("ZSTACK_READ_CACHE_TTL"); readCacheTTLStr != "" {
+		readCacheTTL, err := strconv.Atoi(readCacheTTLStr)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_cache_ttl"),
+				"Invalid ZSTACK_READ_CACHE_TTL value",
+				"The ZSTACK_READ_CACHE_TTL environment variable must be an integer number of seconds, got: "+readCacheTTLStr,
+			)
+			return
+		}
+		read_cache_ttl = readCacheTTL
+	}
+
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
@@ -134,6 +318,10 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		port = int(config.Port.ValueInt64())
 	}
 
+	if !config.Scheme.IsNull() {
+		scheme = config.Scheme.ValueString()
+	}
+
 	if !config.AccountName.IsNull() {
 		account_name = config.AccountName.ValueString()
 	}
@@ -150,6 +338,57 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		access_key_secret = config.AccessKeySecret.ValueString()
 	}
 
+	if !config.ReadOnly.IsNull() {
+		read_only = config.ReadOnly.ValueBool()
+	}
+
+	if !config.LoginType.IsNull() {
+		login_type = config.LoginType.ValueString()
+	}
+
+	if !config.ProjectUuid.IsNull() {
+		project_uuid = config.ProjectUuid.ValueString()
+	}
+
+	if !config.ProjectName.IsNull() {
+		project_name = config.ProjectName.ValueString()
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() {
+		max_concurrent_requests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		requests_per_second = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	if !config.APILogFile.IsNull() {
+		api_log_file = config.APILogFile.ValueString()
+	}
+
+	if !config.DisableReadCache.IsNull() {
+		disable_read_cache = config.DisableReadCache.ValueBool()
+	}
+
+	if !config.ReadCacheTTL.IsNull() {
+		read_cache_ttl = int(config.ReadCacheTTL.ValueInt64())
+	}
+
+	if !config.APILogRedactFields.IsNull() {
+		resp.Diagnostics.Append(config.APILogRedactFields.ElementsAs(ctx, &api_log_redact_fields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if login_type == "" {
+		login_type = loginTypeAccount
+	}
+
+	if scheme == "" {
+		scheme = "http"
+	}
+
 	// If any of the expected configuration are missing, return
 	// errors with provider-specific guidance.
 
@@ -175,6 +414,70 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 				"access_key_secret value in the configuration or use the ZSTACK_ACCESS_KEY_SECRET environment variable\n")
 	}
 
+	if scheme != "http" && scheme != "https" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scheme"),
+			"Invalid ZStack Cloud API Scheme",
+			"scheme must be \"http\" or \"https\", got: "+scheme,
+		)
+	}
+
+	switch login_type {
+	case loginTypeAccount:
+		if project_uuid != "" || project_name != "" {
+			resp.Diagnostics.AddError(
+				"Invalid ZStack IAM2 project scope",
+				"project_uuid and project_name can only be used with login_type \""+loginTypeIAM2VirtualID+"\" or \""+loginTypeLdap+"\". "+
+					"Platform accounts and AccessKeys are not scoped to an IAM2 project.",
+			)
+		}
+	case loginTypeIAM2VirtualID, loginTypeLdap:
+		if account_name == "" || account_password == "" {
+			resp.Diagnostics.AddError(
+				"Missing ZStack login credentials",
+				"login_type \""+login_type+"\" requires account_name and account_password "+
+					"(or the ZSTACK_ACCOUNT_NAME and ZSTACK_ACCOUNT_PASSWORD environment variables) to hold the virtual ID name or LDAP uid and its password.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("login_type"),
+			"Invalid ZStack login_type",
+			"login_type must be one of \""+loginTypeAccount+"\", \""+loginTypeIAM2VirtualID+"\" or \""+loginTypeLdap+"\", got: "+login_type,
+		)
+	}
+
+	if project_uuid != "" && project_name != "" {
+		resp.Diagnostics.AddError(
+			"Conflicting ZStack IAM2 project scope",
+			"Set only one of project_uuid (ZSTACK_PROJECT_UUID) and project_name (ZSTACK_PROJECT_NAME).",
+		)
+	}
+
+	if max_concurrent_requests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid ZStack max_concurrent_requests",
+			"max_concurrent_requests must be 0 (unlimited) or greater, got: "+strconv.Itoa(max_concurrent_requests),
+		)
+	}
+
+	if read_cache_ttl < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_cache_ttl"),
+			"Invalid ZStack read_cache_ttl",
+			"read_cache_ttl must be at least 1 second, got: "+strconv.Itoa(read_cache_ttl)+". Use disable_read_cache to turn the read cache off.",
+		)
+	}
+
+	if requests_per_second < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid ZStack requests_per_second",
+			"requests_per_second must be 0 (unlimited) or greater, got: "+strconv.FormatFloat(requests_per_second, 'f', -1, 64),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -183,40 +486,117 @@ func (p *ZStackProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	ctx = tflog.SetField(ctx, "ZStack_host", host)
 	ctx = tflog.SetField(ctx, "ZStack_port", port)
+	ctx = tflog.SetField(ctx, "ZStack_scheme", scheme)
+	ctx = tflog.SetField(ctx, "ZStack_readOnly", read_only)
+
+	// The SDK client talks to the local API proxy instead of the management
+	// node whenever a client-side API policy is enabled.
+	apiHost, apiPort := host, port
+	proxyConfig := apiProxyConfig{
+		MaxConcurrentRequests: max_concurrent_requests,
+		RequestsPerSecond:     requests_per_second,
+		APILogFile:            api_log_file,
+		APILogRedactFields:    api_log_redact_fields,
+		Scheme:                scheme,
+	}
+	if !disable_read_cache {
+		proxyConfig.ReadCacheTTL = time.Duration(read_cache_ttl) * time.Second
+	}
 
-	if account_name != "" && account_password != "" {
+	if login_type == loginTypeIAM2VirtualID || login_type == loginTypeLdap {
+		ctx = tflog.SetField(ctx, "ZStack_loginType", login_type)
 		ctx = tflog.SetField(ctx, "ZStack_accountName", account_name)
-		ctx = tflog.SetField(ctx, "ZStack_accountPassword", account_password)
-		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ZStack_accountPassword")
-
-		tflog.Debug(ctx, "Creating ZStack client with account")
-		cli = client.NewZSClient(client.NewZSConfig(host, port, "zstack").LoginAccount(account_name, account_password).ReadOnly(false).Debug(false))
-		_, err := cli.Login(ctx)
+		ctx = tflog.SetField(ctx, "ZStack_projectUuid", project_uuid)
+		ctx = tflog.SetField(ctx, "ZStack_projectName", project_name)
+
+		tflog.Debug(ctx, "Logging in to ZStack with session login")
+		authenticator := newSessionAuthenticator(scheme, host, port)
+		session, err := newLoginSession(ctx, func(ctx context.Context) (string, error) {
+			return authenticator.sessionFor(ctx, login_type, account_name, account_password, project_uuid, project_name)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create ZStack API Client",
-				"An unexpected error occurred when creating the ZStack API client. "+
-					"It might be due to an incorrect account name and password being set"+
-					"If the error is not clear, please contact the provider developers.\n\n"+
+				"An unexpected error occurred when logging in to ZStack with login_type \""+login_type+"\". "+
+					"Check the virtual ID or LDAP credentials and, if a project is set, that the user is a member of it.\n\n"+
 					"ZStack Client Error: "+err.Error(),
 			)
 			return
 		}
+		// Sessions expire after the platform session timeout; the proxy logs
+		// in again when that happens during a long apply.
+		proxyConfig.Session = session
+	}
+
+	var proxy *apiProxy
+	if proxyConfig.enabled() {
+		ctx = tflog.SetField(ctx, "ZStack_maxConcurrentRequests", max_concurrent_requests)
+		ctx = tflog.SetField(ctx, "ZStack_requestsPerSecond", requests_per_second)
+		ctx = tflog.SetField(ctx, "ZStack_apiLogFile", api_log_file)
+		ctx = tflog.SetField(ctx, "ZStack_readCacheTTL", proxyConfig.ReadCacheTTL.String())
+
+		var err error
+		proxy, err = newAPIProxy(ctx, host, port, proxyConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create ZStack API Client",
+				"An unexpected error occurred when starting the local proxy that applies max_concurrent_requests, requests_per_second, api_log_file, the read cache, session renewal and HTTPS.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+		apiHost, apiPort = proxy.Host(), proxy.Port()
+		tflog.Debug(ctx, "Routing ZStack API calls through the local API proxy", map[string]any{"proxy_port": apiPort})
+	}
+
+	if proxyConfig.Session != nil {
+		tflog.Debug(ctx, "Creating ZStack client with session login")
+		cli = client.NewZSClient(client.NewZSConfig(apiHost, apiPort, "zstack").Session(proxyConfig.Session.current()).ReadOnly(read_only).Debug(false))
+	} else if account_name != "" && account_password != "" {
+		ctx = tflog.SetField(ctx, "ZStack_accountName", account_name)
+
+		tflog.Debug(ctx, "Creating ZStack client with account")
+		cli = client.NewZSClient(client.NewZSConfig(apiHost, apiPort, "zstack").LoginAccount(account_name, account_password).ReadOnly(read_only).Debug(false))
+		_, err := cli.Login(ctx)
+		if err != nil {
+			if proxy != nil {
+				proxy.Close()
+			}
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Unable to Create ZStack API Client",
+				"Could not log in to ZStack with the account name and password. "+
+					"It might be due to an incorrect account name and password being set",
+				"Login",
+				err,
+			))
+			return
+		}
 	} else if access_key_id != "" && access_key_secret != "" {
 		ctx = tflog.SetField(ctx, "ZStack_accessKeyId", access_key_id)
-		ctx = tflog.SetField(ctx, "ZStack_accessKeySecret", access_key_secret)
-		ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ZStack_accessKeySecret")
 
 		tflog.Debug(ctx, "Creating ZStack client with access key")
-		cli = client.NewZSClient(client.NewZSConfig(host, port, "zstack").AccessKey(access_key_id, access_key_secret).ReadOnly(false).Debug(false))
+		cli = client.NewZSClient(client.NewZSConfig(apiHost, apiPort, "zstack").AccessKey(access_key_id, access_key_secret).ReadOnly(read_only).Debug(false))
 		// no authorization validation! this access key may be invalid！
 	}
+	p.readOnly = read_only
+	p.replaceAPIProxy(ctx, proxy)
 	resp.DataSourceData = cli
 	resp.ResourceData = cli
 
 	tflog.Info(ctx, "Configured ZStack client", map[string]any{"success": true})
 }
 
+// replaceAPIProxy closes the proxy of a previous Configure, whose clients
+// are no longer handed out, and keeps proxy in its place.
+func (p *ZStackProvider) replaceAPIProxy(ctx context.Context, proxy *apiProxy) {
+	if p.proxy != nil && p.proxy != proxy {
+		if err := p.proxy.Close(); err != nil {
+			tflog.Warn(ctx, "Could not close the previous local API proxy", map[string]any{"error": err.Error()})
+		}
+	}
+	p.proxy = proxy
+}
+
 // DataSources implements provider.Provider.
 func (p *ZStackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -230,6 +610,7 @@ func (p *ZStackProvider) DataSources(ctx context.Context) []func() datasource.Da
 		ZStackMNNodeDataSource,
 		ZStackl2NetworkDataSource,
 		ZStackVRouterDataSource,
+		ZStackVRouterRoutesDataSource,
 		ZStackVirtualRouterImageDataSource,
 		ZStackVRouterOfferingDataSource,
 		ZStackVIPsDataSource,
@@ -244,6 +625,8 @@ func (p *ZStackProvider) DataSources(ctx context.Context) []func() datasource.Da
 		ZStackTagDataSource,
 		ZStackNetworkingSecGroupDataSource,
 		ZStackNetworkingSecGroupRuleDataSource,
+		ZStackVpcFirewallRuleSetsDataSource,
+		ZStackVpcFirewallRulesDataSource,
 		ZStackSdnControllerDataSource,
 		ZStackHookScriptsDataSource,
 		ZStackAccountDataSource,
@@ -251,9 +634,13 @@ func (p *ZStackProvider) DataSources(ctx context.Context) []func() datasource.Da
 		ZStackAffinityGroupDataSource,
 		ZStackSshKeyPairDataSource,
 		ZStackL2VlanNetworkDataSource,
+		ZStackL2VxlanNetworkPoolsDataSource,
+		ZStackVniRangesDataSource,
+		ZStackHostNetworkInterfacesDataSource,
 		ZStackPortForwardingRuleDataSource,
 		ZStackLoadBalancerDataSource,
 		ZStackLoadBalancerListenerDataSource,
+		ZStackLBServerGroupBackendsDataSource,
 		ZStackGpuDeviceDataSource,
 		ZStackAutoScalingGroupDataSource,
 		ZStackEipDataSource,
@@ -275,7 +662,7 @@ func (p *ZStackProvider) Metadata(ctx context.Context, req provider.MetadataRequ
 
 // Resources implements provider.Provider.
 func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return withReadOnlyGuard(p, []func() resource.Resource{
 		ImageResource,
 		InstanceResource,
 		InstanceStateResource,
@@ -287,6 +674,7 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		VirtualRouterOfferingResource,
 		VirtualRouterInstanceResource,
 		EIPResource,
+		EIPAssociationResource,
 		InstanceOfferingResource,
 		DiskOfferingResource,
 		GuestToolsResource,
@@ -297,6 +685,7 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		TagResource,
 		TagAttachmentResource,
 		SecurityGroupAttachmentResource,
+		SecurityGroupL3AttachmentResource,
 		SecurityGroupResource,
 		SecurityGroupRuleResource,
 		AccountResource,
@@ -304,6 +693,7 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		AffinityGroupResource,
 		SshKeyPairResource,
 		L2VlanNetworkResource,
+		L2NetworkResource,
 		L2NetworkClusterAttachmentResource,
 		PortForwardingRuleResource,
 		LoadBalancerResource,
@@ -312,15 +702,21 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		ZoneResource,
 		ClusterResource,
 		HostResource,
+		HostNetworkBondingResource,
 		PrimaryStorageResource,
 		BackupStorageResource,
 		SdnControllerResource,
 		L3NetworkResource,
 		VmNicResource,
+		VmNicSecurityGroupsResource,
 		IPsecConnectionResource,
 		VpcFirewallResource,
+		VpcFirewallRuleSetResource,
+		VpcFirewallRuleResource,
+		VpcFirewallRuleSetAttachmentResource,
 		VRouterRouteTableResource,
 		VRouterRouteEntryResource,
+		VRouterRouteTableAttachmentResource,
 		CephPrimaryStorageResource,
 		CephBackupStorageResource,
 		ImageStoreBackupStorageResource,
@@ -330,7 +726,9 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		VpcHaGroupResource,
 		CertificateResource,
 		LBServerGroupResource,
+		LBServerGroupBackendResource,
 		AccessControlListResource,
+		AccessControlListEntryResource,
 		VolumeBackupResource,
 		CephPoolResource,
 		AlarmResource,
@@ -345,10 +743,15 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		SchedulerTriggerResource,
 		VmCdRomResource,
 		L2VxlanNetworkResource,
+		L2VxlanNetworkPoolResource,
+		L2VxlanNetworkPoolClusterAttachmentResource,
 		VipQosResource,
 		VpcSharedQosResource,
 		PolicyRouteRuleSetResource,
 		PolicyRouteRuleResource,
+		PolicyRouteRuleSetAttachmentResource,
+		PolicyRouteTableResource,
+		PolicyRouteTableEntryResource,
 		CdpPolicyResource,
 		CdpTaskResource,
 		LdapServerResource,
@@ -378,7 +781,7 @@ func (p *ZStackProvider) Resources(ctx context.Context) []func() resource.Resour
 		DatasetResource,
 		ZBoxBackupResource,
 		LicenseResource,
-	}
+	})
 }
 
 // Schema implements provider.Provider.
@@ -393,16 +796,25 @@ func (p *ZStackProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description: "ZStack Cloud MN API port. May also be provided via ZSTACK_PORT environment variable.",
 				Optional:    true,
 			},
+			"scheme": schema.StringAttribute{
+				Description: "Scheme of the ZStack Cloud MN API endpoint, `http` or `https`. Use `https` when the management node is served over TLS; every API call then goes through the local API proxy, which connects to the management node over HTTPS. " +
+					"May also be provided via ZSTACK_SCHEME environment variable. Defaults to `http`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "https"),
+				},
+			},
 			"account_name": schema.StringAttribute{
 				Description: "Username for ZStack API. May also be provided via ZSTACK_ACCOUNT_NAME environment variable. " +
-					"Required if using Account authentication.  Only supports the platform administrator account (`admin`). " +
+					"Required if using Account authentication. With the default `login_type` this is a platform account name; " +
+					"with `login_type = \"iam2_virtual_id\"` it is the IAM2 virtual ID name and with `login_type = \"ldap\"` it is the LDAP uid. " +
 					"Mutually exclusive with `access_key_id` and `access_key_secret`. " +
 					"Using `access_key_id` and `access_key_secret` is the recommended approach for authentication, as it provides more flexibility and security.",
 				Optional: true,
 			},
 			"account_password": schema.StringAttribute{
 				Description: "Password for ZStack API. May also be provided via ZSTACK_ACCOUNT_PASSWORD environment variable." +
-					"Required if using Account authentication.  Only supports the platform administrator account (`admin`). " +
+					"Required if using Account authentication. Holds the password of the account, IAM2 virtual ID or LDAP user selected by `login_type`. " +
 					"Mutually exclusive with `access_key_id` and `access_key_secret`. " +
 					"Using `access_key_id` and `access_key_secret` is the recommended approach for authentication, as it provides more flexibility and security.",
 				Optional:  true,
@@ -419,6 +831,82 @@ func (p *ZStackProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"read_only": schema.BoolAttribute{
+				Description: "When `true`, the provider only allows data sources, refresh and import. " +
+					"Any plan that would create, update or delete a resource fails at plan time, and the ZStack client is also created in read-only mode. " +
+					"Intended for audit and reporting workspaces. May also be provided via ZSTACK_READ_ONLY environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"login_type": schema.StringAttribute{
+				Description: "How `account_name` and `account_password` are authenticated: `account` (platform account, default), " +
+					"`iam2_virtual_id` (IAM2 virtual ID) or `ldap` (LDAP user). May also be provided via ZSTACK_LOGIN_TYPE environment variable. " +
+					"IAM2 virtual ID and LDAP logins use a ZStack session; when it expires during a run the provider logs in again and retries the rejected call.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(loginTypeAccount, loginTypeIAM2VirtualID, loginTypeLdap),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				Description: "UUID of the IAM2 project to log in to. All API calls, and every resource created, are scoped to this project. " +
+					"Requires `login_type` `iam2_virtual_id`, or `ldap` with the LDAP entry bound to an IAM2 virtual ID. May also be provided via ZSTACK_PROJECT_UUID environment variable. " +
+					"Mutually exclusive with `project_name`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("project_name")),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Description: "Name of the IAM2 project to log in to. All API calls, and every resource created, are scoped to this project. " +
+					"Requires `login_type` `iam2_virtual_id`, or `ldap` with the LDAP entry bound to an IAM2 virtual ID. May also be provided via ZSTACK_PROJECT_NAME environment variable. " +
+					"Mutually exclusive with `project_uuid`.",
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of ZStack API requests the provider keeps in flight at once, across all resources and data sources. " +
+					"Requests over the limit wait for a free slot instead of failing, so high `-parallelism` does not overload the management node. " +
+					"May also be provided via ZSTACK_MAX_CONCURRENT_REQUESTS environment variable. Defaults to `0` (unlimited).",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum rate at which the provider starts ZStack API requests, including async job polling. " +
+					"Requests over the rate are queued instead of failing. Fractional values such as `0.5` are allowed. " +
+					"May also be provided via ZSTACK_REQUESTS_PER_SECOND environment variable. Defaults to `0` (unlimited).",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"api_log_file": schema.StringAttribute{
+				Description: "Path of a file the provider appends every ZStack API call to, one JSON object per line: " +
+					"time, method, path, query, HTTP status, duration, async job UUID and the request and response bodies. " +
+					"Passwords, secrets, tokens, private keys and session IDs are redacted from bodies and the query string; request headers are never logged. " +
+					"The file is created with mode 0600. May also be provided via ZSTACK_API_LOG_FILE environment variable. Disabled by default.",
+				Optional: true,
+			},
+			"api_log_redact_fields": schema.ListAttribute{
+				Description: "Additional JSON field names whose values are redacted from `api_log_file`, for example `userData`. " +
+					"Matching ignores case, `_` and `-`, and a field is redacted when its name contains one of the entries. " +
+					"May also be provided via ZSTACK_API_LOG_REDACT_FIELDS environment variable as a comma-separated list.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"disable_read_cache": schema.BoolAttribute{
+				Description: "When `true`, every query reaches the ZStack API. By default the results of query APIs, used by data sources and by resource lookups, " +
+					"are shared within a provider instance for `read_cache_ttl` seconds, and are dropped as soon as a resource of the same or a related type is created, updated or deleted. " +
+					"May also be provided via ZSTACK_DISABLE_READ_CACHE environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"read_cache_ttl": schema.Int64Attribute{
+				Description: "Number of seconds a cached query result is reused. Async job polling is never cached. " +
+					"May also be provided via ZSTACK_READ_CACHE_TTL environment variable. Defaults to `10`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
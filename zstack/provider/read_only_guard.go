@@ -0,0 +1,125 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                   = &readOnlyGuardResource{}
+	_ resource.ResourceWithConfigure      = &readOnlyGuardResource{}
+	_ resource.ResourceWithModifyPlan     = &readOnlyGuardResource{}
+	_ resource.ResourceWithValidateConfig = &readOnlyGuardResource{}
+	_ resource.ResourceWithImportState    = &importableReadOnlyGuardResource{}
+)
+
+// readOnlyGuardResource wraps every registered resource so that a provider
+// configured with `read_only = true` rejects Create/Update/Delete during
+// planning, before any mutating call can reach the ZStack API. Read is
+// passed through, except that a resource with an unconfirmed create is kept
+// in state while that create may still finish (see keepPendingCreate).
+//
+// The framework discovers optional behaviour through interface assertions,
+// so the wrapper implements each optional interface used by the provider's
+// resources and delegates when the wrapped resource implements it too.
+// ImportState is the exception: resources that support import are wrapped
+// by importableReadOnlyGuardResource instead, so the framework still rejects
+// imports of the others up front.
+type readOnlyGuardResource struct {
+	resource.Resource
+	provider *ZStackProvider
+}
+
+// importableReadOnlyGuardResource is readOnlyGuardResource for resources that
+// implement ImportState.
+type importableReadOnlyGuardResource struct {
+	*readOnlyGuardResource
+	importer resource.ResourceWithImportState
+}
+
+// withReadOnlyGuard wraps each resource factory with readOnlyGuardResource.
+func withReadOnlyGuard(p *ZStackProvider, factories []func() resource.Resource) []func() resource.Resource {
+	guarded := make([]func() resource.Resource, 0, len(factories))
+	for _, factory := range factories {
+		guarded = append(guarded, func() resource.Resource {
+			return newReadOnlyGuardResource(p, factory())
+		})
+	}
+	return guarded
+}
+
+// newReadOnlyGuardResource wraps r, implementing ImportState only when r does.
+func newReadOnlyGuardResource(p *ZStackProvider, r resource.Resource) resource.Resource {
+	guard := &readOnlyGuardResource{Resource: r, provider: p}
+	if importer, ok := r.(resource.ResourceWithImportState); ok {
+		return &importableReadOnlyGuardResource{readOnlyGuardResource: guard, importer: importer}
+	}
+	return guard
+}
+
+func (r *readOnlyGuardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+func (r *importableReadOnlyGuardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.importer.ImportState(ctx, req, resp)
+}
+
+func (r *readOnlyGuardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.Resource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || !resp.State.Raw.IsNull() {
+		return
+	}
+
+	metadata := &resource.MetadataResponse{}
+	r.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "zstack"}, metadata)
+	if keepPendingCreate(ctx, resp.Private, metadata.TypeName, &resp.Diagnostics) {
+		resp.State.Raw = req.State.Raw
+	}
+}
+
+func (r *readOnlyGuardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		inner.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (r *readOnlyGuardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		inner.ModifyPlan(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.provider == nil || !r.provider.readOnly {
+		return
+	}
+
+	var action string
+	switch {
+	case req.State.Raw.IsNull():
+		action = "create"
+	case resp.Plan.Raw.IsNull():
+		action = "delete"
+	case !resp.Plan.Raw.Equal(req.State.Raw):
+		action = "update"
+	default:
+		return
+	}
+
+	metadata := &resource.MetadataResponse{}
+	r.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "zstack"}, metadata)
+
+	resp.Diagnostics.AddError(
+		"Provider Is Read-Only",
+		"The zstack provider is configured with read_only = true, so this plan cannot "+action+" "+metadata.TypeName+". "+
+			"Only data sources, refresh and import are allowed in read-only mode. "+
+			"Remove the resource block, or unset read_only (and ZSTACK_READ_ONLY) in a workspace that is allowed to make changes.",
+	)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeGuardedResource is a minimal resource used to exercise readOnlyGuardResource.
+type fakeGuardedResource struct {
+	modifyPlanCalls int
+}
+
+func (r *fakeGuardedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fake"
+}
+
+func (r *fakeGuardedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fakeGuardedSchema()
+}
+
+func (r *fakeGuardedResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+}
+
+func (r *fakeGuardedResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *fakeGuardedResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *fakeGuardedResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *fakeGuardedResource) ModifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse) {
+	r.modifyPlanCalls++
+}
+
+func fakeGuardedSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func fakeGuardedRaw(name *string) tftypes.Value {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	if name == nil {
+		return tftypes.NewValue(objectType, nil)
+	}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, *name),
+	})
+}
+
+func runGuardedModifyPlan(t *testing.T, readOnly bool, state, plan *string) (*fakeGuardedResource, *resource.ModifyPlanResponse) {
+	t.Helper()
+
+	inner := &fakeGuardedResource{}
+	r := &readOnlyGuardResource{Resource: inner, provider: &ZStackProvider{readOnly: readOnly}}
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: fakeGuardedSchema(), Raw: fakeGuardedRaw(state)},
+		Plan:  tfsdk.Plan{Schema: fakeGuardedSchema(), Raw: fakeGuardedRaw(plan)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, resp)
+	return inner, resp
+}
+
+func TestReadOnlyGuard_BlocksMutationsWhenReadOnly(t *testing.T) {
+	before := "before"
+	after := "after"
+
+	cases := map[string]struct {
+		state, plan *string
+		action      string
+	}{
+		"create": {state: nil, plan: &after, action: "create"},
+		"update": {state: &before, plan: &after, action: "update"},
+		"delete": {state: &before, plan: nil, action: "delete"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			inner, resp := runGuardedModifyPlan(t, true, tc.state, tc.plan)
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected %s to be rejected in read-only mode", tc.action)
+			}
+			detail := resp.Diagnostics.Errors()[0].Detail()
+			if !strings.Contains(detail, tc.action+" zstack_fake") {
+				t.Errorf("diagnostic should name the action and resource type, got: %s", detail)
+			}
+			if inner.modifyPlanCalls != 1 {
+				t.Errorf("wrapped ModifyPlan should still run once, ran %d times", inner.modifyPlanCalls)
+			}
+		})
+	}
+}
+
+func TestReadOnlyGuard_AllowsNoOpPlanWhenReadOnly(t *testing.T) {
+	name := "unchanged"
+	_, resp := runGuardedModifyPlan(t, true, &name, &name)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("no-op plan should be allowed in read-only mode: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestReadOnlyGuard_AllowsMutationsWhenWritable(t *testing.T) {
+	after := "after"
+	_, resp := runGuardedModifyPlan(t, false, nil, &after)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create should be allowed when read_only is false: %v", resp.Diagnostics.Errors())
+	}
+}
+
+// fakeImportableResource is fakeGuardedResource with import support.
+type fakeImportableResource struct {
+	fakeGuardedResource
+	importCalls int
+}
+
+func (r *fakeImportableResource) ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse) {
+	r.importCalls++
+}
+
+func TestReadOnlyGuard_ImportSupportMatchesWrappedResource(t *testing.T) {
+	p := &ZStackProvider{readOnly: true}
+
+	if _, ok := newReadOnlyGuardResource(p, &fakeGuardedResource{}).(resource.ResourceWithImportState); ok {
+		t.Fatal("wrapper should not implement ImportState when the wrapped resource does not")
+	}
+
+	inner := &fakeImportableResource{}
+	importer, ok := newReadOnlyGuardResource(p, inner).(resource.ResourceWithImportState)
+	if !ok {
+		t.Fatal("wrapper should implement ImportState when the wrapped resource does")
+	}
+	importer.ImportState(context.Background(), resource.ImportStateRequest{ID: "uuid"}, &resource.ImportStateResponse{})
+	if inner.importCalls != 1 {
+		t.Errorf("wrapped ImportState should run once, ran %d times", inner.importCalls)
+	}
+}
+
+func TestProviderResourcesAreReadOnlyGuarded(t *testing.T) {
+	p := &ZStackProvider{}
+	for _, factory := range p.Resources(context.Background()) {
+		switch r := factory().(type) {
+		case *readOnlyGuardResource:
+			if _, ok := r.Resource.(resource.ResourceWithImportState); ok {
+				t.Fatalf("importable resource %T is wrapped without ImportState", r.Resource)
+			}
+		case *importableReadOnlyGuardResource:
+		default:
+			t.Fatalf("resource %T is not wrapped by readOnlyGuardResource", r)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestLimiter enforces max_concurrent_requests and requests_per_second.
+// Callers that exceed either limit queue instead of failing, so a large plan
+// with high -parallelism slows down rather than overloading the management
+// node.
+type requestLimiter struct {
+	slots    chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent requests in
+// flight and starting at most perSecond requests per second. Zero disables
+// the corresponding limit.
+func newRequestLimiter(maxConcurrent int, perSecond float64) *requestLimiter {
+	l := &requestLimiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// acquire blocks until the request may start and returns the function that
+// releases its concurrency slot. It fails only if ctx is cancelled while
+// queued.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = func() { <-l.slots }
+	}
+
+	if l.interval > 0 {
+		if wait := l.reserve(); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// reserve books the next start time and returns how long the caller has to
+// wait for it.
+func (l *requestLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	return start.Sub(now)
+}
+
+// limitedTransport applies a requestLimiter to every request of the API proxy.
+type limitedTransport struct {
+	next    http.RoundTripper
+	limiter *requestLimiter
+	// logCtx carries the provider logger; proxy requests have no Terraform
+	// context of their own.
+	logCtx context.Context
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	queued := time.Now()
+	release, err := t.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if waited := time.Since(queued); waited >= time.Millisecond {
+		tflog.Debug(t.logCtx, "ZStack API request waited for the client-side rate limit", map[string]any{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"waited": waited.String(),
+		})
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	// The request stays in flight until its response body has been consumed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiter_CapsConcurrency(t *testing.T) {
+	limiter := newRequestLimiter(3, 0)
+	var active, maxActive int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			defer release()
+
+			n := atomic.AddInt32(&active, 1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&active, -1)
+		}()
+	}
+	wg.Wait()
+
+	if maxActive > 3 {
+		t.Fatalf("expected at most 3 requests in flight, got %d", maxActive)
+	}
+}
+
+func TestRequestLimiter_SpacesRequests(t *testing.T) {
+	limiter := newRequestLimiter(0, 100)
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		release()
+	}
+
+	// The first request starts immediately, the next four 10ms apart.
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("5 requests at 100/s should take at least 40ms, took %s", elapsed)
+	}
+}
+
+func TestRequestLimiter_Unlimited(t *testing.T) {
+	limiter := newRequestLimiter(0, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for i := 0; i < 100; i++ {
+		if _, err := limiter.acquire(ctx); err != nil {
+			t.Fatalf("an unlimited limiter should never wait: %v", err)
+		}
+	}
+}
+
+func TestRequestLimiter_CancelledWhileQueued(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	release()
+	release, err = limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("an abandoned wait must not keep a slot: %v", err)
+	}
+	release()
+}
+
+func TestRequestLimiter_CancelledWhileRateLimitedReleasesSlot(t *testing.T) {
+	limiter := newRequestLimiter(1, 1)
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	select {
+	case limiter.slots <- struct{}{}:
+	default:
+		t.Fatal("the concurrency slot must be released when the rate wait is cancelled")
+	}
+}
@@ -128,12 +128,18 @@ func (r *accessControlListResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	acl, err := r.client.CreateAccessControlList(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Access Control List",
-			"Could not create access control list, unexpected error: "+err.Error(),
-		)
+		acl, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryAccessControlList), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Access Control List", "CreateAccessControlList", err, &response.Diagnostics)
 		return
 	}
 
@@ -163,12 +169,15 @@ func (r *accessControlListResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Access Control List",
-			"Could not read access control list UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read access control list UUID "+state.Uuid.ValueString(),
+			"QueryAccessControlList",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(acl.UUID)
 	state.Name = types.StringValue(acl.Name)
@@ -203,10 +212,12 @@ func (r *accessControlListResource) Update(ctx context.Context, request resource
 
 	acl, err := r.client.UpdateAccessControlList(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Access Control List",
-			"Could not update access control list, unexpected error: "+err.Error(),
-		)
+			"Could not update access control list",
+			"UpdateAccessControlList",
+			err,
+		))
 		return
 	}
 
@@ -234,7 +245,7 @@ func (r *accessControlListResource) Delete(ctx context.Context, request resource
 	err := r.client.DeleteAccessControlList(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Access Control List", "Could not delete access control list, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Access Control List", "Could not delete access control list", "DeleteAccessControlList", err))
 		return
 	}
 }
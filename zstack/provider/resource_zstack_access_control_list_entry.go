@@ -0,0 +1,538 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                   = &accessControlListEntryResource{}
+	_ resource.ResourceWithConfigure      = &accessControlListEntryResource{}
+	_ resource.ResourceWithImportState    = &accessControlListEntryResource{}
+	_ resource.ResourceWithValidateConfig = &accessControlListEntryResource{}
+)
+
+// aclEntryTypeRedirect is the type of redirect rule entries; IP entries are
+// "IpEntry".
+const aclEntryTypeRedirect = "RedirectRule"
+
+type accessControlListEntryResource struct {
+	client *client.ZSClient
+}
+
+type accessControlListEntryModel struct {
+	Uuid        types.String `tfsdk:"uuid"`
+	AclUuid     types.String `tfsdk:"acl_uuid"`
+	Type        types.String `tfsdk:"type"`
+	IpEntries   types.Set    `tfsdk:"ip_entries"`
+	Name        types.String `tfsdk:"name"`
+	Domain      types.String `tfsdk:"domain"`
+	Url         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+}
+
+func AccessControlListEntryResource() resource.Resource {
+	return &accessControlListEntryResource{}
+}
+
+func (r *accessControlListEntryResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+func (r *accessControlListEntryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_access_control_list_entry"
+}
+
+func (r *accessControlListEntryResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "This resource allows you to manage entries of a ZStack Access Control List. " +
+			"An entry is either a set of IP addresses and CIDR blocks, used by white and black list listeners, " +
+			"or a redirect rule matching a domain and URL path, used by HTTP listeners to route requests to server groups.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the entry. Changing `ip_entries` replaces the entry in ZStack and changes its UUID.",
+			},
+			"acl_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the Access Control List.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the entry: `IpEntry` or `RedirectRule`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_entries": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IP addresses and CIDR blocks, e.g. `192.168.0.10` or `10.0.0.0/8`, of the IP version of the Access Control List. " +
+					"Conflicts with `domain` and `url`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("domain"), path.MatchRoot("url")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of a redirect rule. Required for redirect rules.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "The domain a redirect rule matches, e.g. `www.example.com` or `*.example.com`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL path a redirect rule matches, e.g. `/api`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(aclEntryUrlPattern, "must start with /"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description for the entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *accessControlListEntryResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config accessControlListEntryModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IpEntries.IsUnknown() || config.Domain.IsUnknown() || config.Url.IsUnknown() {
+		return
+	}
+
+	isRedirect := !config.Domain.IsNull() || !config.Url.IsNull()
+	if config.IpEntries.IsNull() && !isRedirect {
+		response.Diagnostics.AddError(
+			"Missing Entry",
+			`One of "ip_entries", "domain" or "url" must be set.`,
+		)
+		return
+	}
+
+	if isRedirect {
+		if config.Name.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Missing Redirect Rule Name",
+				`The "name" attribute is required for redirect rules.`,
+			)
+		}
+		return
+	}
+
+	if !config.Name.IsNull() && !config.Name.IsUnknown() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Name Not Supported",
+			`The "name" attribute can only be set for redirect rules.`,
+		)
+	}
+
+	var entries []types.String
+	response.Diagnostics.Append(config.IpEntries.ElementsAs(ctx, &entries, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	var values []string
+	for _, entry := range entries {
+		if entry.IsUnknown() {
+			return
+		}
+		values = append(values, entry.ValueString())
+	}
+	if _, err := aclEntryIpVersion(values); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("ip_entries"), "Invalid IP Entries", err.Error())
+	}
+}
+
+func (r *accessControlListEntryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan accessControlListEntryModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		response.Diagnostics.AddWarning("Client Not Configured", "The client was not properly configured.")
+		return
+	}
+
+	aclUuid := plan.AclUuid.ValueString()
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var entry *view.AccessControlListEntryInventoryView
+	var apiName string
+	var err error
+	if plan.IpEntries.IsNull() {
+		apiName = "AddAccessControlListRedirectRule"
+		entry, err = r.client.AddAccessControlListRedirectRule(aclUuid, param.AddAccessControlListRedirectRuleParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddAccessControlListRedirectRuleParamDetail{
+				Name:         plan.Name.ValueString(),
+				Description:  stringPtrOrNil(plan.Description.ValueString()),
+				Domain:       stringPtrOrNil(plan.Domain.ValueString()),
+				Url:          stringPtrOrNil(plan.Url.ValueString()),
+				ResourceUuid: stringPtr(resourceUuid),
+			},
+		})
+	} else {
+		p, ok := r.ipEntryParam(ctx, plan, "Error creating Access Control List Entry", &response.Diagnostics)
+		if !ok {
+			return
+		}
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
+		apiName = "AddAccessControlListEntry"
+		entry, err = r.client.AddAccessControlListEntry(aclUuid, p)
+	}
+	if err != nil {
+		entry, err = adoptCreatedResource(ctx, r.entryLookup(aclUuid), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Access Control List Entry", apiName, err, &response.Diagnostics)
+		return
+	}
+
+	plan.Uuid = types.StringValue(entry.UUID)
+	plan.Type = types.StringValue(entry.Type)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *accessControlListEntryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state accessControlListEntryModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.findEntry(state.AclUuid.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Access Control List Entry",
+			"Could not read access control list "+state.AclUuid.ValueString(),
+			"QueryAccessControlList",
+			err,
+		))
+		return
+	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	state.Type = types.StringValue(entry.Type)
+	state.Description = stringValueOrNull(entry.Description)
+	if entry.Type == aclEntryTypeRedirect {
+		state.IpEntries = types.SetNull(types.StringType)
+		state.Name = stringValueOrNull(entry.Name)
+		state.Domain = stringValueOrNull(entry.Domain)
+		state.Url = stringValueOrNull(entry.Url)
+	} else {
+		ipEntries, d := types.SetValueFrom(ctx, types.StringType, splitAclIpEntries(entry.IpEntries))
+		response.Diagnostics.Append(d...)
+		state.IpEntries = ipEntries
+		state.Name = types.StringNull()
+		state.Domain = types.StringNull()
+		state.Url = types.StringNull()
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *accessControlListEntryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan accessControlListEntryModel
+	var state accessControlListEntryModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	aclUuid := state.AclUuid.ValueString()
+	uuid := state.Uuid.ValueString()
+
+	if !plan.IpEntries.Equal(state.IpEntries) {
+		// ZStack cannot edit the addresses of an entry. Add the new entry
+		// before removing the old one, so the list never lacks either.
+		p, ok := r.ipEntryParam(ctx, plan, "Error updating Access Control List Entry", &response.Diagnostics)
+		if !ok {
+			return
+		}
+		resourceUuid := newResourceUuid()
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
+		entry, err := r.client.AddAccessControlListEntry(aclUuid, p)
+		if err != nil {
+			entry, err = adoptCreatedResource(ctx, r.entryLookup(aclUuid), resourceUuid, err)
+		}
+		if err != nil {
+			response.Diagnostics.Append(zstackErrorDiagnostic("Error updating Access Control List Entry", "Could not add entries to access control list "+aclUuid, "AddAccessControlListEntry", err))
+			return
+		}
+
+		err = r.client.RemoveAccessControlListEntry(aclUuid, uuid)
+		if err != nil && !isZStackNotFoundError(err) {
+			// Track the new entry; the old one is left for the next apply
+			// to report.
+			plan.Uuid = types.StringValue(entry.UUID)
+			plan.Type = types.StringValue(entry.Type)
+			response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error updating Access Control List Entry",
+				fmt.Sprintf("Added entry %s but could not remove the previous entry %s; remove it manually", entry.UUID, uuid),
+				"RemoveAccessControlListEntry",
+				err,
+			))
+			return
+		}
+		uuid = entry.UUID
+	}
+
+	if !plan.Name.Equal(state.Name) && !plan.Name.IsNull() {
+		_, err := r.client.UpdateAccessControlListRedirectRule(uuid, param.UpdateAccessControlListRedirectRuleParam{
+			BaseParam: param.BaseParam{},
+			Params: param.UpdateAccessControlListRedirectRuleParamDetail{
+				Name: plan.Name.ValueString(),
+			},
+		})
+		if err != nil {
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error updating Access Control List Entry",
+				"Could not rename redirect rule "+uuid,
+				"UpdateAccessControlListRedirectRule",
+				err,
+			))
+			return
+		}
+	}
+
+	entry, err := r.findEntry(aclUuid, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating Access Control List Entry",
+			"Could not read access control list entry "+uuid+" after update",
+			"QueryAccessControlList",
+			err,
+		))
+		return
+	}
+
+	plan.Uuid = types.StringValue(entry.UUID)
+	plan.Type = types.StringValue(entry.Type)
+	if entry.Type == aclEntryTypeRedirect {
+		plan.Name = stringValueOrNull(entry.Name)
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *accessControlListEntryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state accessControlListEntryModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveAccessControlListEntry(state.AclUuid.ValueString(), state.Uuid.ValueString())
+	if err != nil && !isZStackNotFoundError(err) {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Access Control List Entry", "Could not remove access control list entry", "RemoveAccessControlListEntry", err))
+		return
+	}
+}
+
+func (r *accessControlListEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: acl_uuid:entry_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("acl_uuid"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), parts[1])...)
+}
+
+// ipEntryParam builds the parameters that add the planned IP entries as one
+// entry, after checking that they match the IP version of the Access Control
+// List.
+func (r *accessControlListEntryResource) ipEntryParam(ctx context.Context, plan accessControlListEntryModel, summary string, diags *diag.Diagnostics) (param.AddAccessControlListEntryParam, bool) {
+	var entries []string
+	diags.Append(plan.IpEntries.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return param.AddAccessControlListEntryParam{}, false
+	}
+	sort.Strings(entries)
+
+	version, err := aclEntryIpVersion(entries)
+	if err != nil {
+		diags.AddAttributeError(path.Root("ip_entries"), "Invalid IP Entries", err.Error())
+		return param.AddAccessControlListEntryParam{}, false
+	}
+
+	aclUuid := plan.AclUuid.ValueString()
+	acl, err := findResourceByQuery(r.client.QueryAccessControlList, aclUuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not read access control list "+aclUuid, "QueryAccessControlList", err))
+		return param.AddAccessControlListEntryParam{}, false
+	}
+	if acl.IpVersion != 0 && int(acl.IpVersion) != version {
+		diags.AddAttributeError(
+			path.Root("ip_entries"),
+			"IP Version Mismatch",
+			fmt.Sprintf("Access control list %s is IPv%d, but the entries are IPv%d.", aclUuid, acl.IpVersion, version),
+		)
+		return param.AddAccessControlListEntryParam{}, false
+	}
+
+	return param.AddAccessControlListEntryParam{
+		BaseParam: param.BaseParam{},
+		Params: param.AddAccessControlListEntryParamDetail{
+			Entries:     strings.Join(entries, ","),
+			Description: stringPtrOrNil(plan.Description.ValueString()),
+		},
+	}, true
+}
+
+// findEntry returns the entry of the Access Control List with the given UUID,
+// or ErrResourceNotFound when the list or the entry does not exist.
+func (r *accessControlListEntryResource) findEntry(aclUuid, uuid string) (*view.AccessControlListEntryInventoryView, error) {
+	acl, err := findResourceByQuery(r.client.QueryAccessControlList, aclUuid)
+	if err != nil {
+		return nil, err
+	}
+	for i := range acl.Entries {
+		if acl.Entries[i].UUID == uuid {
+			return &acl.Entries[i], nil
+		}
+	}
+	return nil, ErrResourceNotFound
+}
+
+// entryLookup adapts findEntry for adoptCreatedResource.
+func (r *accessControlListEntryResource) entryLookup(aclUuid string) func(uuid string) (*view.AccessControlListEntryInventoryView, error) {
+	return func(uuid string) (*view.AccessControlListEntryInventoryView, error) {
+		return r.findEntry(aclUuid, uuid)
+	}
+}
+
+var aclEntryUrlPattern = regexp.MustCompile(`^/`)
+
+// aclEntryIpVersion checks that every entry is an IP address or a CIDR block
+// and that all of them belong to one IP version, which it returns.
+func aclEntryIpVersion(entries []string) (int, error) {
+	version := 0
+	for _, entry := range entries {
+		var ip net.IP
+		if strings.Contains(entry, "/") {
+			parsed, _, err := net.ParseCIDR(entry)
+			if err != nil {
+				return 0, fmt.Errorf("%q is not a valid CIDR block", entry)
+			}
+			ip = parsed
+		} else if ip = net.ParseIP(entry); ip == nil {
+			return 0, fmt.Errorf("%q is not a valid IP address", entry)
+		}
+
+		entryVersion := 6
+		if ip.To4() != nil {
+			entryVersion = 4
+		}
+		if version != 0 && entryVersion != version {
+			return 0, fmt.Errorf("IPv4 and IPv6 entries cannot be mixed; %q is IPv%d", entry, entryVersion)
+		}
+		version = entryVersion
+	}
+	return version, nil
+}
+
+// splitAclIpEntries splits the comma-separated addresses of an IP entry.
+func splitAclIpEntries(ipEntries string) []string {
+	var entries []string
+	for _, entry := range strings.Split(ipEntries, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Strings(entries)
+	return entries
+}
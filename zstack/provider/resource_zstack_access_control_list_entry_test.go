@@ -0,0 +1,95 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestAccessControlListEntryResource_Schema(t *testing.T) {
+	var r accessControlListEntryResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	required := []string{"acl_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"uuid", "type"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestAccessControlListEntryResource_Metadata(t *testing.T) {
+	var r accessControlListEntryResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_access_control_list_entry" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAclEntryIpVersion(t *testing.T) {
+	tests := []struct {
+		entries []string
+		want    int
+		wantErr bool
+	}{
+		{entries: []string{"192.168.0.10", "10.0.0.0/8"}, want: 4},
+		{entries: []string{"fd00::1", "2001:db8::/32"}, want: 6},
+		{entries: []string{"192.168.0.10", "fd00::1"}, wantErr: true},
+		{entries: []string{"10.0.0.0/33"}, wantErr: true},
+		{entries: []string{"example.com"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := aclEntryIpVersion(tt.entries)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("aclEntryIpVersion(%v) should fail", tt.entries)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("aclEntryIpVersion(%v) failed: %v", tt.entries, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("aclEntryIpVersion(%v) = %d, want %d", tt.entries, got, tt.want)
+		}
+	}
+}
+
+func TestSplitAclIpEntries(t *testing.T) {
+	got := splitAclIpEntries("10.0.0.0/8, 192.168.0.10,,172.16.0.1")
+	want := []string{"10.0.0.0/8", "172.16.0.1", "192.168.0.10"}
+	if len(got) != len(want) {
+		t.Fatalf("splitAclIpEntries = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitAclIpEntries = %v, want %v", got, want)
+			break
+		}
+	}
+}
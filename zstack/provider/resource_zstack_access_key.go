@@ -143,10 +143,12 @@ func (r *accessKeyResource) Create(ctx context.Context, req resource.CreateReque
 
 	result, err := r.client.CreateAccessKey(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating Access Key",
-			"Could not create access key, unexpected error: "+err.Error(),
-		)
+			"Could not create access key",
+			"CreateAccessKey",
+			err,
+		))
 		return
 	}
 
@@ -183,10 +185,12 @@ func (r *accessKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Access Key",
-			"Could not read access key UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read access key UUID "+state.Uuid.ValueString(),
+			"QueryAccessKey",
+			err,
+		))
 		return
 	}
 
@@ -235,10 +239,12 @@ func (r *accessKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err := r.client.DeleteAccessKey(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Access Key",
-			"Could not delete access key, unexpected error: "+err.Error(),
-		)
+			"Could not delete access key",
+			"DeleteAccessKey",
+			err,
+		))
 		return
 	}
 
@@ -129,11 +129,18 @@ func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	account, err := r.client.CreateAccount(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Account", "Could not create account, unexpected error: "+err.Error(),
-		)
+		account, err = adoptCreatedResource(ctx, r.client.GetAccount, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Account", "CreateAccount", err, &resp.Diagnostics)
 		return
 	}
 
@@ -162,11 +169,15 @@ func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading Account", "Could not read account UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Account",
+			"Could not read account UUID "+state.Uuid.ValueString(),
+			"GetAccount",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(account.UUID)
 	state.Name = types.StringValue(account.Name)
@@ -208,18 +219,24 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if _, err := r.client.UpdateAccount(state.Uuid.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Account", "Could not update account, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating Account",
+			"Could not update account",
+			"UpdateAccount",
+			err,
+		))
 		return
 	}
 
 	// Read back the updated resource to get the full state
 	account, err := r.client.GetAccount(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading Account", "Could not read account after update, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Account",
+			"Could not read account after update",
+			"GetAccount",
+			err,
+		))
 		return
 	}
 
@@ -245,7 +262,7 @@ func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	err := r.client.DeleteAccount(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Account", "Could not delete account, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Account", "Could not delete account", "DeleteAccount", err))
 		return
 	}
 }
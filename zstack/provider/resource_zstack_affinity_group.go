@@ -165,11 +165,18 @@ func (r *affinityGroupResource) Create(ctx context.Context, req resource.CreateR
 		createParam.Params.ZoneUuid = stringPtr(plan.ZoneUuid.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	affinityGroup, err := r.client.CreateAffinityGroup(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Affinity Group", "Could not create affinity group, unexpected error: "+err.Error(),
-		)
+		affinityGroup, err = adoptCreatedResource(ctx, r.client.GetAffinityGroup, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Affinity Group", "CreateAffinityGroup", err, &resp.Diagnostics)
 		return
 	}
 
@@ -202,11 +209,15 @@ func (r *affinityGroupResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading Affinity Group", "Could not read affinity group UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Affinity Group",
+			"Could not read affinity group UUID "+state.Uuid.ValueString(),
+			"GetAffinityGroup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(affinityGroup.UUID)
 	state.Name = types.StringValue(affinityGroup.Name)
@@ -247,18 +258,24 @@ func (r *affinityGroupResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if _, err := r.client.UpdateAffinityGroup(state.Uuid.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Affinity Group", "Could not update affinity group, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating Affinity Group",
+			"Could not update affinity group",
+			"UpdateAffinityGroup",
+			err,
+		))
 		return
 	}
 
 	// Read back the updated resource to get the full state
 	affinityGroup, err := r.client.GetAffinityGroup(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading Affinity Group", "Could not read affinity group after update, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Affinity Group",
+			"Could not read affinity group after update",
+			"GetAffinityGroup",
+			err,
+		))
 		return
 	}
 
@@ -287,7 +304,7 @@ func (r *affinityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 
 	err := r.client.DeleteAffinityGroup(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Affinity Group", "Could not delete affinity group, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Affinity Group", "Could not delete affinity group", "DeleteAffinityGroup", err))
 		return
 	}
 }
@@ -174,12 +174,18 @@ func (r *alarmResource) Create(ctx context.Context, request resource.CreateReque
 		p.Params.RepeatInterval = &val
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateAlarm(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Alarm",
-			"Could not create alarm, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryAlarm), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Alarm", "CreateAlarm", err, &response.Diagnostics)
 		return
 	}
 
@@ -216,12 +222,15 @@ func (r *alarmResource) Read(ctx context.Context, request resource.ReadRequest,
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Alarm",
-			"Could not read alarm UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read alarm UUID "+state.Uuid.ValueString(),
+			"QueryAlarm",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(alarm.UUID)
 	state.Name = types.StringValue(alarm.Name)
@@ -283,17 +292,19 @@ func (r *alarmResource) Update(ctx context.Context, request resource.UpdateReque
 
 	_, err := r.client.UpdateAlarm(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Alarm",
-			"Could not update alarm, unexpected error: "+err.Error(),
-		)
+			"Could not update alarm",
+			"UpdateAlarm",
+			err,
+		))
 		return
 	}
 
 	// Re-read to get complete state (Update response may have incomplete fields)
 	alarm, err := findResourceByQuery(r.client.QueryAlarm, state.Uuid.ValueString())
 	if err != nil {
-		response.Diagnostics.AddError("Error reading alarm after update", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading alarm after update", "Could not read alarm after update", "QueryAlarm", err))
 		return
 	}
 
@@ -328,7 +339,7 @@ func (r *alarmResource) Delete(ctx context.Context, request resource.DeleteReque
 	err := r.client.DeleteAlarm(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Alarm", "Could not delete alarm, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Alarm", "Could not delete alarm", "DeleteAlarm", err))
 		return
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -128,12 +129,18 @@ func (r *aliyunNasAccessGroupResource) Create(ctx context.Context, req resource.
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateAliyunNasAccessGroup(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Aliyun NAS access group",
-			err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, r.client.GetAliyunNasAccessGroup, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Aliyun NAS Access Group", "CreateAliyunNasAccessGroup", err, &resp.Diagnostics)
 		return
 	}
 
@@ -162,12 +169,15 @@ func (r *aliyunNasAccessGroupResource) Read(ctx context.Context, req resource.Re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Aliyun NAS access group",
-			err.Error(),
-		)
+			"Could not read Aliyun NAS access group",
+			"GetAliyunNasAccessGroup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	if item == nil {
 		resp.State.RemoveResource(ctx)
@@ -205,10 +215,12 @@ func (r *aliyunNasAccessGroupResource) Update(ctx context.Context, req resource.
 
 	item, err := r.client.UpdateAliyunNasAccessGroup(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Aliyun NAS access group",
-			err.Error(),
-		)
+			"Could not update Aliyun NAS access group",
+			"UpdateAliyunNasAccessGroup",
+			err,
+		))
 		return
 	}
 
@@ -233,10 +245,12 @@ func (r *aliyunNasAccessGroupResource) Delete(ctx context.Context, req resource.
 
 	err := r.client.DeleteAliyunNasAccessGroup(state.Uuid.ValueString(), "")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Aliyun NAS access group",
-			err.Error(),
-		)
+			"Could not delete Aliyun NAS access group",
+			"DeleteAliyunNasAccessGroup",
+			err,
+		))
 		return
 	}
 
@@ -144,12 +144,18 @@ func (r *aliyunProxyVpcResource) Create(ctx context.Context, req resource.Create
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateAliyunProxyVpc(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Aliyun Proxy VPC",
-			"Could not create aliyun proxy vpc, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, r.client.GetAliyunProxyVpc, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Aliyun Proxy VPC", "CreateAliyunProxyVpc", err, &resp.Diagnostics)
 		return
 	}
 
@@ -175,12 +181,15 @@ func (r *aliyunProxyVpcResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Aliyun Proxy VPC",
-			"Could not read aliyun proxy vpc, unexpected error: "+err.Error(),
-		)
+			"Could not read aliyun proxy vpc",
+			"GetAliyunProxyVpc",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := aliyunProxyVpcModelFromView(result)
 	diags = resp.State.Set(ctx, &refreshedState)
@@ -214,10 +223,12 @@ func (r *aliyunProxyVpcResource) Update(ctx context.Context, req resource.Update
 
 	result, err := r.client.UpdateAliyunProxyVpc(uuid, updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Aliyun Proxy VPC",
-			"Could not update aliyun proxy vpc, unexpected error: "+err.Error(),
-		)
+			"Could not update aliyun proxy vpc",
+			"UpdateAliyunProxyVpc",
+			err,
+		))
 		return
 	}
 
@@ -239,10 +250,12 @@ func (r *aliyunProxyVpcResource) Delete(ctx context.Context, req resource.Delete
 	tflog.Info(ctx, fmt.Sprintf("Deleting Aliyun Proxy VPC: %s", uuid))
 
 	if err := r.client.DeleteAliyunProxyVpc(uuid, param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Aliyun Proxy VPC",
-			"Could not delete aliyun proxy vpc, unexpected error: "+err.Error(),
-		)
+			"Could not delete aliyun proxy vpc",
+			"DeleteAliyunProxyVpc",
+			err,
+		))
 		return
 	}
 }
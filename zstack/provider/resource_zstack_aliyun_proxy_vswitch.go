@@ -130,12 +130,18 @@ func (r *aliyunProxyVSwitchResource) Create(ctx context.Context, req resource.Cr
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	view, err := r.client.CreateAliyunProxyVSwitch(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Aliyun Proxy VSwitch",
-			"Could not create aliyun proxy vswitch, unexpected error: "+err.Error(),
-		)
+		view, err = adoptCreatedResource(ctx, r.client.GetAliyunProxyVSwitch, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Aliyun Proxy VSwitch", "CreateAliyunProxyVSwitch", err, &resp.Diagnostics)
 		return
 	}
 
@@ -168,12 +174,15 @@ func (r *aliyunProxyVSwitchResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Aliyun Proxy VSwitch",
-			"Could not read aliyun proxy vswitch, unexpected error: "+err.Error(),
-		)
+			"Could not read aliyun proxy vswitch",
+			"GetAliyunProxyVSwitch",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	if view == nil {
 		resp.State.RemoveResource(ctx)
@@ -227,10 +236,12 @@ func (r *aliyunProxyVSwitchResource) Update(ctx context.Context, req resource.Up
 
 	view, err := r.client.UpdateAliyunProxyVSwitch(state.UUID.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Aliyun Proxy VSwitch",
-			"Could not update aliyun proxy vswitch, unexpected error: "+err.Error(),
-		)
+			"Could not update aliyun proxy vswitch",
+			"UpdateAliyunProxyVSwitch",
+			err,
+		))
 		return
 	}
 
@@ -261,10 +272,12 @@ func (r *aliyunProxyVSwitchResource) Delete(ctx context.Context, req resource.De
 	// Delete the resource
 	err := r.client.DeleteAliyunProxyVSwitch(state.UUID.ValueString(), "")
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Aliyun Proxy VSwitch",
-			"Could not delete aliyun proxy vswitch, unexpected error: "+err.Error(),
-		)
+			"Could not delete aliyun proxy vswitch",
+			"DeleteAliyunProxyVSwitch",
+			err,
+		))
 		return
 	}
 
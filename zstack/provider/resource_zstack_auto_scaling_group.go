@@ -162,9 +162,18 @@ func (r *autoScalingGroupResource) Create(ctx context.Context, req resource.Crea
 		createParam.Params.Description = stringPtr(plan.Description.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	group, err := r.client.CreateAutoScalingGroup(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Auto Scaling Group", "Could not create auto scaling group, unexpected error: "+err.Error())
+		group, err = adoptCreatedResource(ctx, r.client.GetAutoScalingGroup, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Auto Scaling Group", "CreateAutoScalingGroup", err, &resp.Diagnostics)
 		return
 	}
 
@@ -189,9 +198,10 @@ func (r *autoScalingGroupResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Auto Scaling Group", "Could not read auto scaling group, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Auto Scaling Group", "Could not read auto scaling group", "GetAutoScalingGroup", err))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := autoScalingGroupModelFromView(group)
 
@@ -226,14 +236,14 @@ func (r *autoScalingGroupResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	if _, err := r.client.UpdateAutoScalingGroup(uuid, updateParam); err != nil {
-		resp.Diagnostics.AddError("Error updating Auto Scaling Group", "Could not update auto scaling group, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Auto Scaling Group", "Could not update auto scaling group", "UpdateAutoScalingGroup", err))
 		return
 	}
 
 	// Read back the updated resource
 	group, err := r.client.GetAutoScalingGroup(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Auto Scaling Group", "Could not read updated auto scaling group: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Auto Scaling Group", "Could not read updated auto scaling group", "GetAutoScalingGroup", err))
 		return
 	}
 
@@ -258,7 +268,7 @@ func (r *autoScalingGroupResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	if err := r.client.DeleteAutoScalingGroup(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Auto Scaling Group", "Could not delete auto scaling group, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Auto Scaling Group", "Could not delete auto scaling group", "DeleteAutoScalingGroup", err))
 		return
 	}
 }
@@ -202,7 +202,13 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
-	var bsUuid string
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var bsUuid, apiName string
+	var createErr error
 
 	switch plan.Type.ValueString() {
 	case "ImageStoreBackupStorage":
@@ -221,13 +227,13 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 			port := int(plan.SshPort.ValueInt64())
 			p.Params.SshPort = &port
 		}
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
 
 		result, err := r.client.AddImageStoreBackupStorage(p)
-		if err != nil {
-			response.Diagnostics.AddError("Failed to create ImageStore backup storage", err.Error())
-			return
+		if err == nil {
+			bsUuid = result.UUID
 		}
-		bsUuid = result.UUID
+		apiName, createErr = "AddImageStoreBackupStorage", err
 
 	case "CephBackupStorage":
 		monUrls := listToStringSlice(plan.MonUrls)
@@ -245,13 +251,13 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 		if !plan.Url.IsNull() && !plan.Url.IsUnknown() {
 			p.Params.Url = stringPtr(plan.Url.ValueString())
 		}
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
 
 		result, err := r.client.AddCephBackupStorage(p)
-		if err != nil {
-			response.Diagnostics.AddError("Failed to create Ceph backup storage", err.Error())
-			return
+		if err == nil {
+			bsUuid = result.UUID
 		}
-		bsUuid = result.UUID
+		apiName, createErr = "AddCephBackupStorage", err
 
 	case "SftpBackupStorage":
 		p := param.AddSftpBackupStorageParam{
@@ -269,23 +275,32 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 			port := int(plan.SshPort.ValueInt64())
 			p.Params.SshPort = &port
 		}
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
 
 		result, err := r.client.AddSftpBackupStorage(p)
-		if err != nil {
-			response.Diagnostics.AddError("Failed to create Sftp backup storage", err.Error())
-			return
+		if err == nil {
+			bsUuid = result.UUID
 		}
-		bsUuid = result.UUID
+		apiName, createErr = "AddSftpBackupStorage", err
 
 	default:
 		response.Diagnostics.AddError("Unsupported backup storage type", fmt.Sprintf("Type %q is not supported. Use ImageStoreBackupStorage, CephBackupStorage, or SftpBackupStorage.", plan.Type.ValueString()))
 		return
 	}
 
+	if createErr != nil {
+		adopted, err := adoptCreatedResource(ctx, r.client.GetBackupStorage, resourceUuid, createErr)
+		if err != nil {
+			savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Backup Storage", apiName, err, &response.Diagnostics)
+			return
+		}
+		bsUuid = adopted.UUID
+	}
+
 	// Save partial state so the backup storage UUID is tracked even if zone attachment fails
 	partialBs, err := r.client.GetBackupStorage(bsUuid)
 	if err != nil {
-		response.Diagnostics.AddError("Failed to read backup storage after creation", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Failed to read backup storage after creation", "Could not read backup storage after creation", "GetBackupStorage", err))
 		return
 	}
 	partialModel := backupStorageModelFromView(partialBs, plan)
@@ -302,8 +317,12 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 			BaseParam: param.BaseParam{},
 		}
 		if _, err := r.client.AttachBackupStorageToZone(zoneUuid, bsUuid, attachParam); err != nil {
-			response.Diagnostics.AddError("Failed to attach backup storage to zone",
-				fmt.Sprintf("Error attaching backup storage %s to zone %s: %s", bsUuid, zoneUuid, err.Error()))
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Failed to attach backup storage to zone",
+				fmt.Sprintf("Error attaching backup storage %s to zone %s", bsUuid, zoneUuid),
+				"AttachBackupStorageToZone",
+				err,
+			))
 			return
 		}
 	}
@@ -311,7 +330,7 @@ func (r *backupStorageResource) Create(ctx context.Context, request resource.Cre
 	// Read back the created resource to get full state
 	bs, err := r.client.GetBackupStorage(bsUuid)
 	if err != nil {
-		response.Diagnostics.AddError("Failed to read backup storage after creation", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Failed to read backup storage after creation", "Could not read backup storage after creation", "GetBackupStorage", err))
 		return
 	}
 
@@ -336,12 +355,15 @@ func (r *backupStorageResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Backup Storage",
-			"Could not read backup storage, unexpected error: "+err.Error(),
-		)
+			"Could not read backup storage",
+			"GetBackupStorage",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	model := backupStorageModelFromView(bs, state)
 
@@ -378,7 +400,7 @@ func (r *backupStorageResource) Update(ctx context.Context, request resource.Upd
 
 		_, err := r.client.UpdateBackupStorage(uuid, updateParam)
 		if err != nil {
-			response.Diagnostics.AddError("Failed to update backup storage", err.Error())
+			response.Diagnostics.Append(zstackErrorDiagnostic("Failed to update backup storage", "Could not update backup storage", "UpdateBackupStorage", err))
 			return
 		}
 	}
@@ -403,8 +425,12 @@ func (r *backupStorageResource) Update(ctx context.Context, request resource.Upd
 				BaseParam: param.BaseParam{},
 			}
 			if _, err := r.client.AttachBackupStorageToZone(zoneUuid, uuid, attachParam); err != nil {
-				response.Diagnostics.AddError("Failed to attach backup storage to zone",
-					fmt.Sprintf("Error attaching backup storage %s to zone %s: %s", uuid, zoneUuid, err.Error()))
+				response.Diagnostics.Append(zstackErrorDiagnostic(
+					"Failed to attach backup storage to zone",
+					fmt.Sprintf("Error attaching backup storage %s to zone %s", uuid, zoneUuid),
+					"AttachBackupStorageToZone",
+					err,
+				))
 				return
 			}
 		}
@@ -414,8 +440,12 @@ func (r *backupStorageResource) Update(ctx context.Context, request resource.Upd
 	for _, zoneUuid := range currentZones {
 		if !desiredZoneMap[zoneUuid] {
 			if err := r.client.DetachBackupStorageFromZone(zoneUuid, uuid, param.DeleteModePermissive); err != nil {
-				response.Diagnostics.AddError("Failed to detach backup storage from zone",
-					fmt.Sprintf("Error detaching backup storage %s from zone %s: %s", uuid, zoneUuid, err.Error()))
+				response.Diagnostics.Append(zstackErrorDiagnostic(
+					"Failed to detach backup storage from zone",
+					fmt.Sprintf("Error detaching backup storage %s from zone %s", uuid, zoneUuid),
+					"DetachBackupStorageFromZone",
+					err,
+				))
 				return
 			}
 		}
@@ -424,7 +454,7 @@ func (r *backupStorageResource) Update(ctx context.Context, request resource.Upd
 	// Read back the updated resource
 	bs, err := r.client.GetBackupStorage(uuid)
 	if err != nil {
-		response.Diagnostics.AddError("Failed to read backup storage after update", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Failed to read backup storage after update", "Could not read backup storage after update", "GetBackupStorage", err))
 		return
 	}
 
@@ -448,15 +478,19 @@ func (r *backupStorageResource) Delete(ctx context.Context, request resource.Del
 	zoneUuids := listToStringSlice(state.AttachedZoneUuids)
 	for _, zoneUuid := range zoneUuids {
 		if err := r.client.DetachBackupStorageFromZone(zoneUuid, uuid, param.DeleteModePermissive); err != nil {
-			response.Diagnostics.AddError("Failed to detach backup storage from zone",
-				fmt.Sprintf("Error detaching backup storage %s from zone %s: %s", uuid, zoneUuid, err.Error()))
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Failed to detach backup storage from zone",
+				fmt.Sprintf("Error detaching backup storage %s from zone %s", uuid, zoneUuid),
+				"DetachBackupStorageFromZone",
+				err,
+			))
 			return
 		}
 	}
 
 	// Delete the backup storage
 	if err := r.client.DeleteBackupStorage(uuid, param.DeleteModePermissive); err != nil {
-		response.Diagnostics.AddError("Failed to delete backup storage", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Failed to delete backup storage", "Could not delete backup storage", "DeleteBackupStorage", err))
 		return
 	}
 }
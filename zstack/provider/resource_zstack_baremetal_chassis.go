@@ -161,12 +161,18 @@ func (r *baremetalChassisResource) Create(ctx context.Context, request resource.
 		p.Params.IpmiPort = intPtr(int(plan.IpmiPort.ValueInt64()))
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	chassis, err := r.client.CreateBaremetalChassis(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Baremetal Chassis",
-			"Could not create baremetal chassis, unexpected error: "+err.Error(),
-		)
+		chassis, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryBaremetalChassis), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Baremetal Chassis", "CreateBaremetalChassis", err, &response.Diagnostics)
 		return
 	}
 
@@ -199,12 +205,15 @@ func (r *baremetalChassisResource) Read(ctx context.Context, request resource.Re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Baremetal Chassis",
-			"Could not read baremetal chassis UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read baremetal chassis UUID "+state.Uuid.ValueString(),
+			"QueryBaremetalChassis",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(chassis.UUID)
 	state.Name = types.StringValue(chassis.Name)
@@ -250,10 +259,12 @@ func (r *baremetalChassisResource) Update(ctx context.Context, request resource.
 
 	chassis, err := r.client.UpdateBaremetalChassis(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Baremetal Chassis",
-			"Could not update baremetal chassis, unexpected error: "+err.Error(),
-		)
+			"Could not update baremetal chassis",
+			"UpdateBaremetalChassis",
+			err,
+		))
 		return
 	}
 
@@ -283,7 +294,7 @@ func (r *baremetalChassisResource) Delete(ctx context.Context, request resource.
 
 	err := r.client.DeleteBaremetalChassis(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Baremetal Chassis", "Could not delete baremetal chassis, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Baremetal Chassis", "Could not delete baremetal chassis", "DeleteBaremetalChassis", err))
 		return
 	}
 }
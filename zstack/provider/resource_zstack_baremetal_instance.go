@@ -186,12 +186,18 @@ func (r *baremetalInstanceResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	instance, err := r.client.CreateBaremetalInstance(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Baremetal Instance",
-			"Could not create baremetal instance, unexpected error: "+err.Error(),
-		)
+		instance, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryBaremetalInstance), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Baremetal Instance", "CreateBaremetalInstance", err, &response.Diagnostics)
 		return
 	}
 
@@ -226,12 +232,15 @@ func (r *baremetalInstanceResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Baremetal Instance",
-			"Could not read baremetal instance UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read baremetal instance UUID "+state.Uuid.ValueString(),
+			"QueryBaremetalInstance",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(instance.UUID)
 	state.Name = types.StringValue(instance.Name)
@@ -274,10 +283,12 @@ func (r *baremetalInstanceResource) Update(ctx context.Context, request resource
 
 	instance, err := r.client.UpdateBaremetalInstance(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Baremetal Instance",
-			"Could not update baremetal instance, unexpected error: "+err.Error(),
-		)
+			"Could not update baremetal instance",
+			"UpdateBaremetalInstance",
+			err,
+		))
 		return
 	}
 
@@ -309,7 +320,7 @@ func (r *baremetalInstanceResource) Delete(ctx context.Context, request resource
 
 	err := r.client.DestroyBaremetalInstance(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Baremetal Instance", "Could not delete baremetal instance, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Baremetal Instance", "Could not delete baremetal instance", "DestroyBaremetalInstance", err))
 		return
 	}
 }
@@ -196,12 +196,18 @@ func (r *baremetalPxeServerResource) Create(ctx context.Context, request resourc
 		p.Params.SshPort = intPtr(int(plan.SshPort.ValueInt64()))
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	pxeServer, err := r.client.CreateBaremetalPxeServer(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Baremetal PXE Server",
-			"Could not create baremetal PXE server, unexpected error: "+err.Error(),
-		)
+		pxeServer, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryBaremetalPxeServer), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Baremetal PXE Server", "CreateBaremetalPxeServer", err, &response.Diagnostics)
 		return
 	}
 
@@ -239,12 +245,15 @@ func (r *baremetalPxeServerResource) Read(ctx context.Context, request resource.
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Baremetal PXE Server",
-			"Could not read baremetal PXE server UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read baremetal PXE server UUID "+state.Uuid.ValueString(),
+			"QueryBaremetalPxeServer",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(pxeServer.UUID)
 	state.Name = types.StringValue(pxeServer.Name)
@@ -291,10 +300,12 @@ func (r *baremetalPxeServerResource) Update(ctx context.Context, request resourc
 
 	pxeServer, err := r.client.UpdateBaremetalPxeServer(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Baremetal PXE Server",
-			"Could not update baremetal PXE server, unexpected error: "+err.Error(),
-		)
+			"Could not update baremetal PXE server",
+			"UpdateBaremetalPxeServer",
+			err,
+		))
 		return
 	}
 
@@ -329,7 +340,7 @@ func (r *baremetalPxeServerResource) Delete(ctx context.Context, request resourc
 
 	err := r.client.DeleteBaremetalPxeServer(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Baremetal PXE Server", "Could not delete baremetal PXE server, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Baremetal PXE Server", "Could not delete baremetal PXE server", "DeleteBaremetalPxeServer", err))
 		return
 	}
 }
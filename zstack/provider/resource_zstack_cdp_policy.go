@@ -187,9 +187,18 @@ func (r *cdpPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		p.Params.FullBackupIntervalInDay = intPtr(int(plan.FullBackupIntervalInDay.ValueInt64()))
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateCdpPolicy(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating CDP Policy", "Could not create CDP policy, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCdpPolicy), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "CDP Policy", "CreateCdpPolicy", err, &resp.Diagnostics)
 		return
 	}
 
@@ -222,12 +231,15 @@ func (r *cdpPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading CDP Policy",
-			"Could not read CDP policy UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read CDP policy UUID "+state.Uuid.ValueString(),
+			"QueryCdpPolicy",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -283,7 +295,7 @@ func (r *cdpPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 
 	item, err := r.client.UpdateCdpPolicy(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating CDP Policy", "Could not update CDP policy, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating CDP Policy", "Could not update CDP policy", "UpdateCdpPolicy", err))
 		return
 	}
 
@@ -312,7 +324,7 @@ func (r *cdpPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 
 
 	if err := r.client.DeleteCdpPolicy(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting CDP Policy", "Could not delete CDP policy, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting CDP Policy", "Could not delete CDP policy", "DeleteCdpPolicy", err))
 		return
 	}
 }
@@ -184,9 +184,18 @@ func (r *cdpTaskResource) Create(ctx context.Context, req resource.CreateRequest
 		p.Params.MaxLatency = int64Ptr(plan.MaxLatency.ValueInt64())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateCdpTask(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating CDP Task", "Could not create CDP task, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCdpTask), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "CDP Task", "CreateCdpTask", err, &resp.Diagnostics)
 		return
 	}
 
@@ -220,12 +229,15 @@ func (r *cdpTaskResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading CDP Task",
-			"Could not read CDP task UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read CDP task UUID "+state.Uuid.ValueString(),
+			"QueryCdpTask",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -296,7 +308,7 @@ func (r *cdpTaskResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	item, err := r.client.UpdateCdpTask(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating CDP Task", "Could not update CDP task, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating CDP Task", "Could not update CDP task", "UpdateCdpTask", err))
 		return
 	}
 
@@ -326,7 +338,7 @@ func (r *cdpTaskResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	if err := r.client.DeleteCdpTask(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting CDP Task", "Could not delete CDP task, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting CDP Task", "Could not delete CDP task", "DeleteCdpTask", err))
 		return
 	}
 }
@@ -185,12 +185,18 @@ func (r *cephBackupStorageResource) Create(ctx context.Context, req resource.Cre
 		"name": createParam.Params.Name,
 	})
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	storage, err := r.client.AddCephBackupStorage(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Ceph Backup Storage",
-			"Could not create ceph backup storage, unexpected error: "+err.Error(),
-		)
+		storage, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCephBackupStorage), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Ceph Backup Storage", "AddCephBackupStorage", err, &resp.Diagnostics)
 		return
 	}
 
@@ -233,12 +239,15 @@ func (r *cephBackupStorageResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Ceph Backup Storage",
-			"Could not read ceph backup storage UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read ceph backup storage UUID "+state.Uuid.ValueString(),
+			"QueryCephBackupStorage",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(storage.UUID)
 	state.Name = types.StringValue(storage.Name)
@@ -284,10 +293,12 @@ func (r *cephBackupStorageResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.client.DeleteBackupStorage(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Ceph Backup Storage",
-			"Could not delete ceph backup storage UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not delete ceph backup storage UUID "+state.Uuid.ValueString(),
+			"DeleteBackupStorage",
+			err,
+		))
 		return
 	}
 
@@ -145,12 +145,18 @@ func (r *cephPoolResource) Create(ctx context.Context, request resource.CreateRe
 		p.Params.IsCreate = plan.IsCreate.ValueBool()
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.AddCephPrimaryStoragePool(plan.PrimaryStorageUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Ceph Pool",
-			"Could not create ceph pool, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCephPrimaryStoragePool), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Ceph Pool", "AddCephPrimaryStoragePool", err, &response.Diagnostics)
 		return
 	}
 
@@ -183,12 +189,15 @@ func (r *cephPoolResource) Read(ctx context.Context, request resource.ReadReques
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Ceph Pool",
-			"Could not read ceph pool UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read ceph pool UUID "+state.Uuid.ValueString(),
+			"QueryCephPrimaryStoragePool",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(cephPool.UUID)
 	state.PoolName = types.StringValue(cephPool.PoolName)
@@ -232,10 +241,12 @@ func (r *cephPoolResource) Update(ctx context.Context, request resource.UpdateRe
 
 	result, err := r.client.UpdateCephPrimaryStoragePool(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Ceph Pool",
-			"Could not update ceph pool, unexpected error: "+err.Error(),
-		)
+			"Could not update ceph pool",
+			"UpdateCephPrimaryStoragePool",
+			err,
+		))
 		return
 	}
 
@@ -266,7 +277,7 @@ func (r *cephPoolResource) Delete(ctx context.Context, request resource.DeleteRe
 	err := r.client.DeleteCephPrimaryStoragePool(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Ceph Pool", "Could not delete ceph pool, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Ceph Pool", "Could not delete ceph pool", "DeleteCephPrimaryStoragePool", err))
 		return
 	}
 }
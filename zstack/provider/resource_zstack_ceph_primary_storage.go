@@ -193,12 +193,18 @@ func (r *cephPrimaryStorageResource) Create(ctx context.Context, req resource.Cr
 		"name": createParam.Params.Name,
 	})
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	storage, err := r.client.AddCephPrimaryStorage(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Ceph Primary Storage",
-			"Could not create ceph primary storage, unexpected error: "+err.Error(),
-		)
+		storage, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCephPrimaryStorage), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Ceph Primary Storage", "AddCephPrimaryStorage", err, &resp.Diagnostics)
 		return
 	}
 
@@ -241,12 +247,15 @@ func (r *cephPrimaryStorageResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Ceph Primary Storage",
-			"Could not read ceph primary storage UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read ceph primary storage UUID "+state.Uuid.ValueString(),
+			"QueryCephPrimaryStorage",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(storage.UUID)
 	state.Name = types.StringValue(storage.Name)
@@ -293,10 +302,12 @@ func (r *cephPrimaryStorageResource) Delete(ctx context.Context, req resource.De
 
 	err := r.client.DeletePrimaryStorage(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Ceph Primary Storage",
-			"Could not delete ceph primary storage UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not delete ceph primary storage UUID "+state.Uuid.ValueString(),
+			"DeletePrimaryStorage",
+			err,
+		))
 		return
 	}
 
@@ -121,12 +121,18 @@ func (r *certificateResource) Create(ctx context.Context, request resource.Creat
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	certificate, err := r.client.CreateCertificate(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Certificate",
-			"Could not create certificate, unexpected error: "+err.Error(),
-		)
+		certificate, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryCertificate), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Certificate", "CreateCertificate", err, &response.Diagnostics)
 		return
 	}
 
@@ -156,12 +162,15 @@ func (r *certificateResource) Read(ctx context.Context, request resource.ReadReq
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Certificate",
-			"Could not read certificate UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read certificate UUID "+state.Uuid.ValueString(),
+			"QueryCertificate",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(certificate.UUID)
 	state.Name = types.StringValue(certificate.Name)
@@ -196,10 +205,12 @@ func (r *certificateResource) Update(ctx context.Context, request resource.Updat
 
 	certificate, err := r.client.UpdateCertificate(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Certificate",
-			"Could not update certificate, unexpected error: "+err.Error(),
-		)
+			"Could not update certificate",
+			"UpdateCertificate",
+			err,
+		))
 		return
 	}
 
@@ -227,7 +238,7 @@ func (r *certificateResource) Delete(ctx context.Context, request resource.Delet
 	err := r.client.DeleteCertificate(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Certificate", "Could not delete certificate, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Certificate", "Could not delete certificate", "DeleteCertificate", err))
 		return
 	}
 }
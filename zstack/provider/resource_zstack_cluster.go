@@ -178,12 +178,18 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		createParam.Params.Architecture = stringPtr(plan.Architecture.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	cluster, err := r.client.CreateCluster(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Cluster",
-			"Could not create cluster, unexpected error: "+err.Error(),
-		)
+		cluster, err = adoptCreatedResource(ctx, r.client.GetCluster, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Cluster", "CreateCluster", err, &resp.Diagnostics)
 		return
 	}
 
@@ -197,10 +203,12 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		cluster, err = r.client.ChangeClusterState(cluster.UUID, stateParam)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing Cluster state",
-				"Could not change cluster state to Disabled: "+err.Error(),
-			)
+				"Could not change cluster state to Disabled",
+				"ChangeClusterState",
+				err,
+			))
 			return
 		}
 	}
@@ -226,12 +234,15 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Cluster",
-			"Could not read cluster, unexpected error: "+err.Error(),
-		)
+			"Could not read cluster",
+			"GetCluster",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := clusterModelFromView(cluster)
 
@@ -264,10 +275,12 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if _, err := r.client.UpdateCluster(uuid, updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Cluster",
-			"Could not update cluster, unexpected error: "+err.Error(),
-		)
+			"Could not update cluster",
+			"UpdateCluster",
+			err,
+		))
 		return
 	}
 
@@ -288,10 +301,12 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 				},
 			}
 			if _, err := r.client.ChangeClusterState(uuid, stateParam); err != nil {
-				resp.Diagnostics.AddError(
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
 					"Error changing Cluster state",
-					"Could not change cluster state: "+err.Error(),
-				)
+					"Could not change cluster state",
+					"ChangeClusterState",
+					err,
+				))
 				return
 			}
 		}
@@ -300,10 +315,12 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Read back the updated resource
 	cluster, err := r.client.GetCluster(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Cluster",
-			"Could not read updated cluster: "+err.Error(),
-		)
+			"Could not read updated cluster",
+			"GetCluster",
+			err,
+		))
 		return
 	}
 
@@ -328,10 +345,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	if err := r.client.DeleteCluster(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Cluster",
-			"Could not delete cluster, unexpected error: "+err.Error(),
-		)
+			"Could not delete cluster",
+			"DeleteCluster",
+			err,
+		))
 		return
 	}
 }
@@ -152,12 +152,18 @@ func (r *containerManagementEndpointResource) Create(ctx context.Context, reques
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	endpoint, err := r.client.AddContainerManagementEndpoint(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Container Management Endpoint",
-			"Could not create container management endpoint, unexpected error: "+err.Error(),
-		)
+		endpoint, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryContainerManagementEndpoint), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Container Management Endpoint", "AddContainerManagementEndpoint", err, &response.Diagnostics)
 		return
 	}
 
@@ -187,12 +193,15 @@ func (r *containerManagementEndpointResource) Read(ctx context.Context, request
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Container Management Endpoint",
-			"Could not read container management endpoint UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read container management endpoint UUID "+state.Uuid.ValueString(),
+			"QueryContainerManagementEndpoint",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(endpoint.UUID)
 	state.Name = types.StringValue(endpoint.Name)
@@ -229,10 +238,12 @@ func (r *containerManagementEndpointResource) Update(ctx context.Context, reques
 
 	endpoint, err := r.client.UpdateContainerManagementEndpoint(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Container Management Endpoint",
-			"Could not update container management endpoint, unexpected error: "+err.Error(),
-		)
+			"Could not update container management endpoint",
+			"UpdateContainerManagementEndpoint",
+			err,
+		))
 		return
 	}
 
@@ -259,10 +270,12 @@ func (r *containerManagementEndpointResource) Delete(ctx context.Context, reques
 
 	err := r.client.DeleteContainerManagementEndpoint(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Container Management Endpoint",
-			"Could not delete container management endpoint, unexpected error: "+err.Error(),
-		)
+			"Could not delete container management endpoint",
+			"DeleteContainerManagementEndpoint",
+			err,
+		))
 		return
 	}
 }
@@ -138,12 +138,18 @@ func (r *databaseBackupResource) Create(ctx context.Context, request resource.Cr
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateDatabaseBackup(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Database Backup",
-			"Could not create database backup, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryDatabaseBackup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Database Backup", "CreateDatabaseBackup", err, &response.Diagnostics)
 		return
 	}
 
@@ -176,12 +182,15 @@ func (r *databaseBackupResource) Read(ctx context.Context, request resource.Read
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Database Backup",
-			"Could not read database backup UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read database backup UUID "+state.Uuid.ValueString(),
+			"QueryDatabaseBackup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(databaseBackup.UUID)
 	state.Name = stringValueOrNull(databaseBackup.Name)
@@ -216,10 +225,12 @@ func (r *databaseBackupResource) Delete(ctx context.Context, request resource.De
 
 	err := r.client.DeleteDatabaseBackup(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Database Backup",
-			"Could not delete database backup, unexpected error: "+err.Error(),
-		)
+			"Could not delete database backup",
+			"DeleteDatabaseBackup",
+			err,
+		))
 		return
 	}
 }
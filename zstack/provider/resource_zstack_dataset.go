@@ -146,12 +146,18 @@ func (r *datasetResource) Create(ctx context.Context, request resource.CreateReq
 		p.Params.System = boolPtr(plan.System.ValueBool())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateDataset(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Dataset",
-			"Could not create dataset, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryDataset), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Dataset", "CreateDataset", err, &response.Diagnostics)
 		return
 	}
 
@@ -182,12 +188,15 @@ func (r *datasetResource) Read(ctx context.Context, request resource.ReadRequest
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Dataset",
-			"Could not read dataset UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read dataset UUID "+state.Uuid.ValueString(),
+			"QueryDataset",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -224,10 +233,12 @@ func (r *datasetResource) Update(ctx context.Context, request resource.UpdateReq
 
 	item, err := r.client.UpdateDataset(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Dataset",
-			"Could not update dataset, unexpected error: "+err.Error(),
-		)
+			"Could not update dataset",
+			"UpdateDataset",
+			err,
+		))
 		return
 	}
 
@@ -255,10 +266,12 @@ func (r *datasetResource) Delete(ctx context.Context, request resource.DeleteReq
 
 	err := r.client.DeleteDataset(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Dataset",
-			"Could not delete dataset, unexpected error: "+err.Error(),
-		)
+			"Could not delete dataset",
+			"DeleteDataset",
+			err,
+		))
 		return
 	}
 }
@@ -134,12 +134,18 @@ func (r *directoryResource) Create(ctx context.Context, request resource.CreateR
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	directory, err := r.client.CreateDirectory(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Directory",
-			"Could not create directory, unexpected error: "+err.Error(),
-		)
+		directory, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryDirectory), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Directory", "CreateDirectory", err, &response.Diagnostics)
 		return
 	}
 
@@ -169,12 +175,15 @@ func (r *directoryResource) Read(ctx context.Context, request resource.ReadReque
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Directory",
-			"Could not read directory UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read directory UUID "+state.Uuid.ValueString(),
+			"QueryDirectory",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(directory.UUID)
 	state.Name = types.StringValue(directory.Name)
@@ -210,10 +219,12 @@ func (r *directoryResource) Update(ctx context.Context, request resource.UpdateR
 
 	directory, err := r.client.UpdateDirectory(p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Directory",
-			"Could not update directory, unexpected error: "+err.Error(),
-		)
+			"Could not update directory",
+			"UpdateDirectory",
+			err,
+		))
 		return
 	}
 
@@ -240,10 +251,12 @@ func (r *directoryResource) Delete(ctx context.Context, request resource.DeleteR
 
 	err := r.client.DeleteDirectory(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Directory",
-			"Could not delete directory, unexpected error: "+err.Error(),
-		)
+			"Could not delete directory",
+			"DeleteDirectory",
+			err,
+		))
 		return
 	}
 }
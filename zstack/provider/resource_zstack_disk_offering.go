@@ -81,13 +81,19 @@ func (r *diskOfferingResource) Create(ctx context.Context, req resource.CreateRe
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	offerParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	disk_offer, err := r.client.CreateDiskOffering(offerParam)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Disk Offering",
-			"Could not create disk offering, unexpected error: "+err.Error(),
-		)
+		disk_offer, err = adoptCreatedResource(ctx, r.client.GetDiskOffering, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Disk Offering", "CreateDiskOffering", err, &resp.Diagnostics)
 		return
 	}
 
@@ -116,10 +122,12 @@ func (r *diskOfferingResource) Delete(ctx context.Context, req resource.DeleteRe
 	err := r.client.DeleteDiskOffering(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Disk Offering",
-			"Could not delete disk offering, unexpected error: "+err.Error(),
-		)
+			"Could not delete disk offering",
+			"DeleteDiskOffering",
+			err,
+		))
 		return
 	}
 }
@@ -143,12 +151,15 @@ func (r *diskOfferingResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Disk Offering",
-			"Could not read disk offering UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read disk offering UUID "+state.Uuid.ValueString(),
+			"GetDiskOffering",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(disk_offer.UUID)
 	state.Description = types.StringValue(disk_offer.Description)
@@ -227,19 +238,23 @@ func (r *diskOfferingResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	if _, err := r.client.UpdateDiskOffering(state.Uuid.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Disk Offering",
-			"Could not update disk offering, unexpected error: "+err.Error(),
-		)
+			"Could not update disk offering",
+			"UpdateDiskOffering",
+			err,
+		))
 		return
 	}
 
 	diskOffer, err := r.client.GetDiskOffering(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Disk Offering",
-			"Could not read disk offering after update, unexpected error: "+err.Error(),
-		)
+			"Could not read disk offering after update",
+			"GetDiskOffering",
+			err,
+		))
 		return
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
 )
@@ -98,10 +100,13 @@ func (r *eipResource) Schema(_ context.Context, request resource.SchemaRequest,
 				},
 			},
 			"vm_nic_uuid": schema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the virtual machine NIC.",
+				Optional: true,
+				Computed: true,
+				Description: "The UUID of the virtual machine NIC the EIP is bound to. Changing it moves the EIP to the new NIC " +
+					"without releasing the address; removing it from the configuration keeps the current binding. " +
+					"Leave it unset when the binding is managed by `zstack_eip_association`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 		},
@@ -127,16 +132,22 @@ func (r *eipResource) Create(ctx context.Context, request resource.CreateRequest
 			Name:        plan.Name.ValueString(),
 			Description: stringPtr(plan.Description.ValueString()),
 			VipUuid:     plan.VipUuid.ValueString(),
-			VmNicUuid:   stringPtr(plan.VmNicUuid.ValueString()),
+			VmNicUuid:   stringPtrOrNil(plan.VmNicUuid.ValueString()),
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	eip, err := r.client.CreateEip(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating EIP",
-			"Could not create EIP, unexpected error: "+err.Error(),
-		)
+		eip, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryEip), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "EIP", "CreateEip", err, &response.Diagnostics)
 		return
 	}
 
@@ -144,7 +155,7 @@ func (r *eipResource) Create(ctx context.Context, request resource.CreateRequest
 	plan.Name = types.StringValue(eip.Name)
 	plan.Description = types.StringValue(eip.Description)
 	plan.VipUuid = types.StringValue(eip.VipUuid)
-	plan.VmNicUuid = types.StringValue(eip.VmNicUuid)
+	plan.VmNicUuid = stringValueOrNull(eip.VmNicUuid)
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -168,18 +179,21 @@ func (r *eipResource) Read(ctx context.Context, request resource.ReadRequest, re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading EIP",
-			"Could not read EIP UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read EIP UUID "+state.Uuid.ValueString(),
+			"QueryEip",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(eip.UUID)
 	state.Name = types.StringValue(eip.Name)
 	state.Description = types.StringValue(eip.Description)
 	state.VipUuid = types.StringValue(eip.VipUuid)
-	state.VmNicUuid = types.StringValue(eip.VmNicUuid)
+	state.VmNicUuid = stringValueOrNull(eip.VmNicUuid)
 
 	diags = response.State.Set(ctx, &state)
 	response.Diagnostics.Append(diags...)
@@ -209,12 +223,32 @@ func (r *eipResource) Update(ctx context.Context, request resource.UpdateRequest
 		},
 	}
 
-	eip, err := r.client.UpdateEip(state.Uuid.ValueString(), p)
-	if err != nil {
-		response.Diagnostics.AddError(
+	uuid := state.Uuid.ValueString()
+	if _, err := r.client.UpdateEip(uuid, p); err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating EIP",
-			"Could not update EIP, unexpected error: "+err.Error(),
-		)
+			"Could not update EIP",
+			"UpdateEip",
+			err,
+		))
+		return
+	}
+
+	if !plan.VmNicUuid.IsUnknown() && !plan.VmNicUuid.Equal(state.VmNicUuid) {
+		moveEip(ctx, r.client, uuid, state.VmNicUuid.ValueString(), plan.VmNicUuid.ValueString(), "Error updating EIP", &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	eip, err := findResourceByQuery(r.client.QueryEip, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading EIP",
+			"Could not read EIP UUID "+uuid+" after update",
+			"QueryEip",
+			err,
+		))
 		return
 	}
 
@@ -222,7 +256,7 @@ func (r *eipResource) Update(ctx context.Context, request resource.UpdateRequest
 	plan.Name = types.StringValue(eip.Name)
 	plan.Description = stringValueOrNull(eip.Description)
 	plan.VipUuid = types.StringValue(eip.VipUuid)
-	plan.VmNicUuid = types.StringValue(eip.VmNicUuid)
+	plan.VmNicUuid = stringValueOrNull(eip.VmNicUuid)
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -239,10 +273,12 @@ func (r *eipResource) Delete(ctx context.Context, request resource.DeleteRequest
 	err := r.client.DeleteEip(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting EIP",
-			"Could not delete EIP, unexpected error: "+err.Error(),
-		)
+			"Could not delete EIP",
+			"DeleteEip",
+			err,
+		))
 		return
 	}
 
@@ -251,3 +287,34 @@ func (r *eipResource) Delete(ctx context.Context, request resource.DeleteRequest
 func (r *eipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
+
+// moveEip detaches the EIP from fromNicUuid and attaches it to toNicUuid,
+// keeping the VIP allocated. Either UUID may be empty to only attach or only
+// detach. It holds the EIP's lock so concurrent moves are serialized.
+func moveEip(ctx context.Context, cli *client.ZSClient, eipUuid, fromNicUuid, toNicUuid, summary string, diags *diag.Diagnostics) {
+	unlock, err := lockParents(ctx, eipUuid)
+	if err != nil {
+		diags.AddError(summary, "Could not lock EIP "+eipUuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	if fromNicUuid != "" {
+		tflog.Debug(ctx, "Detaching EIP", map[string]any{"eip_uuid": eipUuid, "vm_nic_uuid": fromNicUuid})
+		if err := cli.DetachEip(eipUuid, param.DeleteModePermissive); err != nil && !isZStackNotFoundError(err) {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not detach EIP %s from VM NIC %s", eipUuid, fromNicUuid), "DetachEip", err))
+			return
+		}
+	}
+
+	if toNicUuid != "" {
+		tflog.Debug(ctx, "Attaching EIP", map[string]any{"eip_uuid": eipUuid, "vm_nic_uuid": toNicUuid})
+		_, err := cli.AttachEip(eipUuid, toNicUuid, param.AttachEipParam{
+			BaseParam: param.BaseParam{},
+			Params:    param.AttachEipParamDetail{},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not attach EIP %s to VM NIC %s", eipUuid, toNicUuid), "AttachEip", err))
+		}
+	}
+}
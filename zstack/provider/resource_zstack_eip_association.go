@@ -0,0 +1,226 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &eipAssociationResource{}
+	_ resource.ResourceWithConfigure   = &eipAssociationResource{}
+	_ resource.ResourceWithImportState = &eipAssociationResource{}
+)
+
+type eipAssociationResource struct {
+	client *client.ZSClient
+}
+
+type eipAssociationModel struct {
+	EipUuid   types.String `tfsdk:"eip_uuid"`
+	VmNicUuid types.String `tfsdk:"vm_nic_uuid"`
+}
+
+func EIPAssociationResource() resource.Resource {
+	return &eipAssociationResource{}
+}
+
+func (r *eipAssociationResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+func (r *eipAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_eip_association"
+}
+
+func (r *eipAssociationResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "This resource binds an existing EIP to a virtual machine NIC, separately from the lifetime of the EIP. " +
+			"Changing `vm_nic_uuid` moves the EIP to the new NIC in place, and destroying this resource detaches the EIP without releasing its address. " +
+			"If the EIP is bound to another NIC when this resource is created, it is moved. Do not set `vm_nic_uuid` on the `zstack_eip` resource as well.",
+		Attributes: map[string]schema.Attribute{
+			"eip_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the EIP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_nic_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the virtual machine NIC to bind the EIP to.",
+			},
+		},
+	}
+}
+
+func (r *eipAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan eipAssociationModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		response.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	eipUuid := plan.EipUuid.ValueString()
+	eip, err := findResourceByQuery(r.client.QueryEip, eipUuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating EIP Association",
+			"Could not read EIP UUID "+eipUuid,
+			"QueryEip",
+			err,
+		))
+		return
+	}
+
+	if eip.VmNicUuid != plan.VmNicUuid.ValueString() {
+		if eip.VmNicUuid != "" {
+			tflog.Warn(ctx, "EIP is bound to another VM NIC, moving it", map[string]any{
+				"eip_uuid":    eipUuid,
+				"vm_nic_uuid": eip.VmNicUuid,
+			})
+		}
+		moveEip(ctx, r.client, eipUuid, eip.VmNicUuid, plan.VmNicUuid.ValueString(), "Error creating EIP Association", &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *eipAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state eipAssociationModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	eip, err := findResourceByQuery(r.client.QueryEip, state.EipUuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading EIP Association",
+			"Could not read EIP UUID "+state.EipUuid.ValueString(),
+			"QueryEip",
+			err,
+		))
+		return
+	}
+
+	if eip.VmNicUuid == "" {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	state.VmNicUuid = types.StringValue(eip.VmNicUuid)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *eipAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan eipAssociationModel
+	var state eipAssociationModel
+
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	eipUuid := state.EipUuid.ValueString()
+	moveEip(ctx, r.client, eipUuid, state.VmNicUuid.ValueString(), plan.VmNicUuid.ValueString(), "Error updating EIP Association", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	eip, err := findResourceByQuery(r.client.QueryEip, eipUuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading EIP Association",
+			"Could not read EIP UUID "+eipUuid+" after update",
+			"QueryEip",
+			err,
+		))
+		return
+	}
+	plan.VmNicUuid = types.StringValue(eip.VmNicUuid)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *eipAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state eipAssociationModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		response.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	eipUuid := state.EipUuid.ValueString()
+	eip, err := findResourceByQuery(r.client.QueryEip, eipUuid)
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting EIP Association",
+			"Could not read EIP UUID "+eipUuid,
+			"QueryEip",
+			err,
+		))
+		return
+	}
+	if eip.VmNicUuid == "" {
+		return
+	}
+
+	moveEip(ctx, r.client, eipUuid, eip.VmNicUuid, "", "Error deleting EIP Association", &response.Diagnostics)
+}
+
+func (r *eipAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("eip_uuid"), req, resp)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestEIPAssociationResource_Schema(t *testing.T) {
+	var r eipAssociationResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"eip_uuid", "vm_nic_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+}
+
+func TestEIPAssociationResource_Metadata(t *testing.T) {
+	var r eipAssociationResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_eip_association" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccEIPAssociationResource(t *testing.T) {
+	env := loadEnvData(t)
+
+	vipUUID, vmNicUUID, skipReason := selectEIPFixture(env)
+	if skipReason != "" {
+		t.Skip(skipReason)
+	}
+
+	name := testAccName("eip-assoc")
+	config := func(withAssociation bool) string {
+		association := ""
+		if withAssociation {
+			association = fmt.Sprintf(`
+resource "zstack_eip_association" "test" {
+  eip_uuid    = zstack_eip.test.uuid
+  vm_nic_uuid = %q
+}
+`, vmNicUUID)
+		}
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_eip" "test" {
+  name     = %q
+  vip_uuid = %q
+}
+`, name, vipUUID) + association
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckEipDestroy,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_eip_association.test", tfjsonpath.New("vm_nic_uuid"), knownvalue.StringExact(vmNicUUID)),
+				},
+			},
+			{
+				ResourceName:                         "zstack_eip_association.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_eip.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "eip_uuid",
+			},
+			{
+				// Removing the association detaches the EIP but keeps it.
+				Config: config(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_eip.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
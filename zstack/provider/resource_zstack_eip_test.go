@@ -23,7 +23,7 @@ func TestEIPResource_Schema(t *testing.T) {
 		t.Fatal("schema should not be empty")
 	}
 
-	required := []string{"name", "vip_uuid", "vm_nic_uuid"}
+	required := []string{"name", "vip_uuid"}
 	for _, attr := range required {
 		a, ok := resp.Schema.Attributes[attr]
 		if !ok {
@@ -34,7 +34,11 @@ func TestEIPResource_Schema(t *testing.T) {
 		}
 	}
 
-	computed := []string{"uuid", "description"}
+	if !resp.Schema.Attributes["vm_nic_uuid"].IsOptional() {
+		t.Error("attribute \"vm_nic_uuid\" should be optional so the binding can be managed by zstack_eip_association")
+	}
+
+	computed := []string{"uuid", "description", "vm_nic_uuid"}
 	for _, attr := range computed {
 		a, ok := resp.Schema.Attributes[attr]
 		if !ok {
@@ -159,12 +159,18 @@ func (r *emailMediaResource) Create(ctx context.Context, req resource.CreateRequ
 		p.Params.Password = stringPtrOrNil(plan.Password.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateEmailMedia(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Email Media",
-			"Could not create email media, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryEmailMedia), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Email Media", "CreateEmailMedia", err, &resp.Diagnostics)
 		return
 	}
 
@@ -192,12 +198,15 @@ func (r *emailMediaResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Email Media",
-			"Could not read email media UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read email media UUID "+state.Uuid.ValueString(),
+			"QueryEmailMedia",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -250,10 +259,12 @@ func (r *emailMediaResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	item, err := r.client.UpdateEmailMedia(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Email Media",
-			"Could not update email media, unexpected error: "+err.Error(),
-		)
+			"Could not update email media",
+			"UpdateEmailMedia",
+			err,
+		))
 		return
 	}
 
@@ -280,10 +291,12 @@ func (r *emailMediaResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 
 	if err := r.client.DeleteMedia(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Email Media",
-			"Could not delete email media, unexpected error: "+err.Error(),
-		)
+			"Could not delete email media",
+			"DeleteMedia",
+			err,
+		))
 		return
 	}
 }
@@ -164,12 +164,18 @@ func (r *fiSecSecurityMachineResource) Create(ctx context.Context, req resource.
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddFiSecSecurityMachine(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating FI Security Machine",
-			"Could not create FI security machine, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySecurityMachine), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "FI Security Machine", "AddFiSecSecurityMachine", err, &resp.Diagnostics)
 		return
 	}
 
@@ -193,12 +199,15 @@ func (r *fiSecSecurityMachineResource) Read(ctx context.Context, req resource.Re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Machine",
-			"Could not read Security Machine, unexpected error: "+err.Error(),
-		)
+			"Could not read Security Machine",
+			"QuerySecurityMachine",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = fiSecSecurityMachineModelFromView(item, state)
 
@@ -231,10 +240,12 @@ func (r *fiSecSecurityMachineResource) Update(ctx context.Context, req resource.
 
 	item, err := r.client.UpdateFiSecSecurityMachine(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating FI Security Machine",
-			"Could not update FI security machine, unexpected error: "+err.Error(),
-		)
+			"Could not update FI security machine",
+			"UpdateFiSecSecurityMachine",
+			err,
+		))
 		return
 	}
 
@@ -254,10 +265,12 @@ func (r *fiSecSecurityMachineResource) Delete(ctx context.Context, req resource.
 
 
 	if err := r.client.DeleteSecurityMachine(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting FI Security Machine",
-			"Could not delete FI security machine, unexpected error: "+err.Error(),
-		)
+			"Could not delete FI security machine",
+			"DeleteSecurityMachine",
+			err,
+		))
 		return
 	}
 }
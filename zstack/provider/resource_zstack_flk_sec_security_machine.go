@@ -164,12 +164,18 @@ func (r *flkSecSecurityMachineResource) Create(ctx context.Context, req resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddFlkSecSecurityMachine(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating FLK Security Machine",
-			"Could not create FLK security machine, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySecurityMachine), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "FLK Security Machine", "AddFlkSecSecurityMachine", err, &resp.Diagnostics)
 		return
 	}
 
@@ -193,12 +199,15 @@ func (r *flkSecSecurityMachineResource) Read(ctx context.Context, req resource.R
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Machine",
-			"Could not read Security Machine, unexpected error: "+err.Error(),
-		)
+			"Could not read Security Machine",
+			"QuerySecurityMachine",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = flkSecSecurityMachineModelFromView(item, state)
 
@@ -231,10 +240,12 @@ func (r *flkSecSecurityMachineResource) Update(ctx context.Context, req resource
 
 	item, err := r.client.UpdateFlkSecSecurityMachine(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating FLK Security Machine",
-			"Could not update FLK security machine, unexpected error: "+err.Error(),
-		)
+			"Could not update FLK security machine",
+			"UpdateFlkSecSecurityMachine",
+			err,
+		))
 		return
 	}
 
@@ -254,10 +265,12 @@ func (r *flkSecSecurityMachineResource) Delete(ctx context.Context, req resource
 
 
 	if err := r.client.DeleteSecurityMachine(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting FLK Security Machine",
-			"Could not delete FLK security machine, unexpected error: "+err.Error(),
-		)
+			"Could not delete FLK security machine",
+			"DeleteSecurityMachine",
+			err,
+		))
 		return
 	}
 }
@@ -130,12 +130,18 @@ func (r *flowCollectorResource) Create(ctx context.Context, request resource.Cre
 		p.Params.Port = int64Ptr(plan.Port.ValueInt64())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	flowCollector, err := r.client.CreateFlowCollector(plan.FlowMeterUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Flow Collector",
-			"Could not create flow collector, unexpected error: "+err.Error(),
-		)
+		flowCollector, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryFlowCollector), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Flow Collector", "CreateFlowCollector", err, &response.Diagnostics)
 		return
 	}
 
@@ -167,12 +173,15 @@ func (r *flowCollectorResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Flow Collector",
-			"Could not read flow collector, unexpected error: "+err.Error(),
-		)
+			"Could not read flow collector",
+			"QueryFlowCollector",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(flowCollector.UUID)
 	state.Name = stringValueOrNull(flowCollector.Name)
@@ -212,10 +221,12 @@ func (r *flowCollectorResource) Update(ctx context.Context, request resource.Upd
 
 	flowCollector, err := r.client.UpdateFlowCollector(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Flow Collector",
-			"Could not update flow collector, unexpected error: "+err.Error(),
-		)
+			"Could not update flow collector",
+			"UpdateFlowCollector",
+			err,
+		))
 		return
 	}
 
@@ -243,10 +254,12 @@ func (r *flowCollectorResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DeleteFlowCollector(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Flow Collector",
-			"Could not delete flow collector, unexpected error: "+err.Error(),
-		)
+			"Could not delete flow collector",
+			"DeleteFlowCollector",
+			err,
+		))
 		return
 	}
 }
@@ -188,12 +188,18 @@ func (r *flowMeterResource) Create(ctx context.Context, request resource.CreateR
 		p.Params.Port = int64Ptr(plan.Port.ValueInt64())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	flowMeter, err := r.client.CreateFlowMeter(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Flow Meter",
-			"Could not create flow meter, unexpected error: "+err.Error(),
-		)
+		flowMeter, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryFlowMeter), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Flow Meter", "CreateFlowMeter", err, &response.Diagnostics)
 		return
 	}
 
@@ -227,12 +233,15 @@ func (r *flowMeterResource) Read(ctx context.Context, request resource.ReadReque
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Flow Meter",
-			"Could not read Flow Meter, unexpected error: "+err.Error(),
-		)
+			"Could not read Flow Meter",
+			"QueryFlowMeter",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(flowMeter.UUID)
 	state.Name = stringValueOrNull(flowMeter.Name)
@@ -279,10 +288,12 @@ func (r *flowMeterResource) Update(ctx context.Context, request resource.UpdateR
 
 	flowMeter, err := r.client.UpdateFlowMeter(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Flow Meter",
-			"Could not update flow meter, unexpected error: "+err.Error(),
-		)
+			"Could not update flow meter",
+			"UpdateFlowMeter",
+			err,
+		))
 		return
 	}
 
@@ -324,10 +335,12 @@ func (r *flowMeterResource) Delete(ctx context.Context, request resource.DeleteR
 
 	err := r.client.DeleteFlowMeter(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Flow Meter",
-			"Could not delete flow meter, unexpected error: "+err.Error(),
-		)
+			"Could not delete flow meter",
+			"DeleteFlowMeter",
+			err,
+		))
 		return
 	}
 }
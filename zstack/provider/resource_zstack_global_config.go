@@ -124,10 +124,13 @@ func (r *globalConfigResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	if _, err := r.client.UpdateGlobalConfig(plan.Category.ValueString(), plan.Name.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnosticWithHint(
 			"Error creating Global Config",
-			"Could not create global config, unexpected error: "+err.Error()+"\n\nUse data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
-		)
+			"Could not create global config",
+			"UpdateGlobalConfig",
+			"Use data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
+			err,
+		))
 		return
 	}
 
@@ -139,10 +142,12 @@ func (r *globalConfigResource) Create(ctx context.Context, req resource.CreateRe
 		return r.client.QueryGlobalConfig(queryParam)
 	}, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error re-reading Global Config after create",
-			"Could not re-query global config, unexpected error: "+err.Error(),
-		)
+			"Could not re-query global config",
+			"QueryGlobalConfig",
+			err,
+		))
 		return
 	}
 
@@ -187,10 +192,12 @@ func (r *globalConfigResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Global Config",
-			"Could not read global config, unexpected error: "+err.Error(),
-		)
+			"Could not read global config",
+			"QueryGlobalConfig",
+			err,
+		))
 		return
 	}
 
@@ -223,10 +230,13 @@ func (r *globalConfigResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	if _, err := r.client.UpdateGlobalConfig(plan.Category.ValueString(), plan.Name.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnosticWithHint(
 			"Error updating Global Config",
-			"Could not update global config, unexpected error: "+err.Error()+"\n\nUse data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
-		)
+			"Could not update global config",
+			"UpdateGlobalConfig",
+			"Use data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
+			err,
+		))
 		return
 	}
 
@@ -238,10 +248,12 @@ func (r *globalConfigResource) Update(ctx context.Context, req resource.UpdateRe
 		return r.client.QueryGlobalConfig(queryParam)
 	}, plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error re-reading Global Config after update",
-			"Could not re-query global config, unexpected error: "+err.Error(),
-		)
+			"Could not re-query global config",
+			"QueryGlobalConfig",
+			err,
+		))
 		return
 	}
 
@@ -281,10 +293,13 @@ func (r *globalConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	_, err := r.client.UpdateGlobalConfig(state.Category.ValueString(), state.Name.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnosticWithHint(
 			"Error deleting Global Config",
-			"Could not delete global config, unexpected error: "+err.Error()+"\n\nUse data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
-		)
+			"Could not delete global config",
+			"UpdateGlobalConfig",
+			"Use data \"zstack_global_configs\" without filters to inspect valid category/name pairs for the target ZStack environment.",
+			err,
+		))
 		return
 	}
 
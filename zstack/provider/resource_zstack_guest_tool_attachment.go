@@ -115,22 +115,35 @@ func (r *guestToolsResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
-	_, err := r.client.AttachGuestToolsIsoToVm(instance_uuid, param.AttachGuestToolsIsoToVmParam{})
-
+	unlock, err := lockParents(ctx, instance_uuid)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error attaching Guest Tools to VM Instance",
-			"Could not attach guest tools to VM instance, unexpected error: "+err.Error(),
+			"Could not lock VM instance "+instance_uuid+": "+err.Error(),
 		)
 		return
 	}
+	_, err = r.client.AttachGuestToolsIsoToVm(instance_uuid, param.AttachGuestToolsIsoToVmParam{})
+	unlock()
+
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error attaching Guest Tools to VM Instance",
+			"Could not attach guest tools to VM instance",
+			"AttachGuestToolsIsoToVm",
+			err,
+		))
+		return
+	}
 	tflog.Info(ctx, "Guest tools ISO attached to VM instance successfully.")
 	guest_tools, err := r.client.GetVmGuestToolsInfo(instance_uuid)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Guest Tool Attachment",
-			"Could not read guest tool attachment after attach: "+err.Error(),
-		)
+			"Could not read guest tool attachment after attach",
+			"GetVmGuestToolsInfo",
+			err,
+		))
 		return
 	}
 
@@ -162,10 +175,12 @@ func (r *guestToolsResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Guest Tools Attachment",
-			"Could not read guest tools info, unexpected error: "+err.Error(),
-		)
+			"Could not read guest tools info",
+			"GetVmGuestToolsInfo",
+			err,
+		))
 		return
 	}
 
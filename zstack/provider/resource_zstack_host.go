@@ -202,12 +202,18 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		createParam.Params.Description = stringPtr(plan.Description.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	host, err := r.client.AddKVMHost(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Host",
-			"Could not create host, unexpected error: "+err.Error(),
-		)
+		host, err = adoptCreatedResource(ctx, r.client.GetHost, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Host", "AddKVMHost", err, &resp.Diagnostics)
 		return
 	}
 
@@ -222,10 +228,12 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing Host state",
-				"Could not change host state to Disabled: "+err.Error(),
-			)
+				"Could not change host state to Disabled",
+				"ChangeHostState",
+				err,
+			))
 			return
 		}
 		state.State = types.StringValue("Disabled")
@@ -250,12 +258,15 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Host",
-			"Could not read host, unexpected error: "+err.Error(),
-		)
+			"Could not read host",
+			"GetHost",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	// Preserve sensitive fields from prior state since API doesn't return them
 	refreshedState := hostModelFromView(host, state)
@@ -293,10 +304,12 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 
 		if _, err := r.client.UpdateHost(uuid, updateParam); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating Host",
-				"Could not update host, unexpected error: "+err.Error(),
-			)
+				"Could not update host",
+				"UpdateHost",
+				err,
+			))
 			return
 		}
 	}
@@ -321,10 +334,12 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 
 		if _, err := r.client.UpdateKVMHost(uuid, updateKVMParam); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating KVM Host",
-				"Could not update KVM host, unexpected error: "+err.Error(),
-			)
+				"Could not update KVM host",
+				"UpdateKVMHost",
+				err,
+			))
 			return
 		}
 	}
@@ -355,10 +370,12 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 				StateEvent: stateEvent,
 			},
 		}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing Host state",
-				"Could not change host state: "+err.Error(),
-			)
+				"Could not change host state",
+				"ChangeHostState",
+				err,
+			))
 			return
 		}
 	}
@@ -366,10 +383,12 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Read back the updated resource
 	host, err := r.client.GetHost(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Host",
-			"Could not read updated host: "+err.Error(),
-		)
+			"Could not read updated host",
+			"GetHost",
+			err,
+		))
 		return
 	}
 
@@ -394,10 +413,12 @@ func (r *hostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	if err := r.client.DeleteHost(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Host",
-			"Could not delete host, unexpected error: "+err.Error(),
-		)
+			"Could not delete host",
+			"DeleteHost",
+			err,
+		))
 		return
 	}
 }
@@ -0,0 +1,375 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                   = &hostNetworkBondingResource{}
+	_ resource.ResourceWithConfigure      = &hostNetworkBondingResource{}
+	_ resource.ResourceWithImportState    = &hostNetworkBondingResource{}
+	_ resource.ResourceWithValidateConfig = &hostNetworkBondingResource{}
+)
+
+// bondingModes are the Linux bonding modes ZStack can configure.
+var bondingModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+// bondingXmitHashPolicies are the transmit hash policies of the modes that
+// hash outgoing traffic over the member NICs.
+var bondingXmitHashPolicies = []string{"layer2", "layer2+3", "layer3+4", "encap2+3", "encap3+4"}
+
+type hostNetworkBondingResource struct {
+	client *client.ZSClient
+}
+
+type hostNetworkBondingModel struct {
+	Uuid           types.String `tfsdk:"uuid"`
+	HostUuid       types.String `tfsdk:"host_uuid"`
+	BondName       types.String `tfsdk:"bond_name"`
+	Mode           types.String `tfsdk:"mode"`
+	XmitHashPolicy types.String `tfsdk:"xmit_hash_policy"`
+	SlaveNames     types.Set    `tfsdk:"slave_names"`
+	Mac            types.String `tfsdk:"mac"`
+	MiiStatus      types.String `tfsdk:"mii_status"`
+}
+
+func HostNetworkBondingResource() resource.Resource {
+	return &hostNetworkBondingResource{}
+}
+
+func (r *hostNetworkBondingResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *hostNetworkBondingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_host_network_bonding"
+}
+
+func (r *hostNetworkBondingResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Manages a bond interface on a ZStack KVM host. The member NICs are enslaved to the bond, which can then be used as " +
+			"the physical interface of L2 networks. VLAN sub-interfaces are not managed here: ZStack creates them when a `zstack_l2vlan_network` on the bond is attached to the cluster. " +
+			"Use the `zstack_host_network_interfaces` data source to find the NIC names of a host.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the bond.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the host to create the bond on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bond_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The interface name of the bond on the host, e.g. `bond0`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 15),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required:    true,
+				Description: "The bonding mode: `balance-rr`, `active-backup`, `balance-xor`, `broadcast`, `802.3ad`, `balance-tlb` or `balance-alb`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(bondingModes...),
+				},
+			},
+			"xmit_hash_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The transmit hash policy, only valid with the `802.3ad` and `balance-xor` modes: `layer2`, `layer2+3`, `layer3+4`, `encap2+3` or `encap3+4`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(bondingXmitHashPolicies...),
+				},
+			},
+			"slave_names": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The names of the host NICs enslaved to the bond, e.g. `[\"eth1\", \"eth2\"]`. Members can be added and removed in place.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"mac": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MAC address of the bond.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mii_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MII link status of the bond, `up` or `down`.",
+			},
+		},
+	}
+}
+
+func (r *hostNetworkBondingResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config hostNetworkBondingModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mode.IsNull() || config.Mode.IsUnknown() || config.XmitHashPolicy.IsNull() || config.XmitHashPolicy.IsUnknown() {
+		return
+	}
+
+	if err := checkBondingXmitHashPolicy(config.Mode.ValueString(), config.XmitHashPolicy.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("xmit_hash_policy"), "Invalid Transmit Hash Policy", err.Error())
+	}
+}
+
+func (r *hostNetworkBondingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan hostNetworkBondingModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	slaveNames := bondingSlaveNamesFromSet(ctx, plan.SlaveNames, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params := param.CreateBondingParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreateBondingParamDetail{
+			HostUuid:   plan.HostUuid.ValueString(),
+			BondName:   plan.BondName.ValueString(),
+			Mode:       plan.Mode.ValueString(),
+			SlaveNames: slaveNames,
+		},
+	}
+	if !plan.XmitHashPolicy.IsNull() && !plan.XmitHashPolicy.IsUnknown() {
+		params.Params.XmitHashPolicy = stringPtr(plan.XmitHashPolicy.ValueString())
+	}
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	unlock, err := lockParents(ctx, plan.HostUuid.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error creating Host Network Bonding",
+			"Could not lock host "+plan.HostUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	tflog.Info(ctx, "Creating host network bonding", map[string]any{
+		"host_uuid": plan.HostUuid.ValueString(),
+		"bond_name": plan.BondName.ValueString(),
+		"mode":      plan.Mode.ValueString(),
+	})
+
+	bonding, err := r.client.CreateBonding(params)
+	if err != nil {
+		bonding, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryHostNetworkBonding), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Host Network Bonding", "CreateBonding", err, &response.Diagnostics)
+		return
+	}
+
+	state := hostNetworkBondingModelFromView(ctx, bonding, &response.Diagnostics)
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *hostNetworkBondingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state hostNetworkBondingModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bonding, err := findResourceByQuery(r.client.QueryHostNetworkBonding, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading Host Network Bonding", "Could not read host network bonding", "QueryHostNetworkBonding", err))
+		return
+	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	refreshed := hostNetworkBondingModelFromView(ctx, bonding, &response.Diagnostics)
+	diags = response.State.Set(ctx, &refreshed)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *hostNetworkBondingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state hostNetworkBondingModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := state.Uuid.ValueString()
+
+	slaveNames := bondingSlaveNamesFromSet(ctx, plan.SlaveNames, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params := param.UpdateBondingParam{
+		BaseParam: param.BaseParam{},
+		Params: param.UpdateBondingParamDetail{
+			Mode:       stringPtr(plan.Mode.ValueString()),
+			SlaveNames: slaveNames,
+		},
+	}
+	if !plan.XmitHashPolicy.IsNull() && !plan.XmitHashPolicy.IsUnknown() {
+		params.Params.XmitHashPolicy = stringPtr(plan.XmitHashPolicy.ValueString())
+	}
+
+	unlock, err := lockParents(ctx, state.HostUuid.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error updating Host Network Bonding",
+			"Could not lock host "+state.HostUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	if _, err := r.client.UpdateBonding(uuid, params); err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating Host Network Bonding", "Could not update host network bonding "+uuid, "UpdateBonding", err))
+		return
+	}
+
+	bonding, err := findResourceByQuery(r.client.QueryHostNetworkBonding, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error re-reading Host Network Bonding after update", "Could not re-query host network bonding", "QueryHostNetworkBonding", err))
+		return
+	}
+
+	refreshed := hostNetworkBondingModelFromView(ctx, bonding, &response.Diagnostics)
+	diags := response.State.Set(ctx, &refreshed)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *hostNetworkBondingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state hostNetworkBondingModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockParents(ctx, state.HostUuid.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error deleting Host Network Bonding",
+			"Could not lock host "+state.HostUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	if err := r.client.DeleteBonding(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Host Network Bonding", "Could not delete host network bonding", "DeleteBonding", err))
+		return
+	}
+}
+
+func (r *hostNetworkBondingResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), request, response)
+}
+
+func hostNetworkBondingModelFromView(ctx context.Context, bonding *view.HostNetworkBondingInventoryView, diags *diag.Diagnostics) hostNetworkBondingModel {
+	slaveNames := make([]string, 0, len(bonding.Slaves))
+	for _, slave := range bonding.Slaves {
+		slaveNames = append(slaveNames, slave.InterfaceName)
+	}
+	sort.Strings(slaveNames)
+
+	slaveSet, d := types.SetValueFrom(ctx, types.StringType, slaveNames)
+	diags.Append(d...)
+
+	return hostNetworkBondingModel{
+		Uuid:           types.StringValue(bonding.UUID),
+		HostUuid:       types.StringValue(bonding.HostUuid),
+		BondName:       types.StringValue(bonding.BondingName),
+		Mode:           types.StringValue(bonding.Mode),
+		XmitHashPolicy: stringValueOrNull(bonding.XmitHashPolicy),
+		SlaveNames:     slaveSet,
+		Mac:            stringValueOrNull(bonding.Mac),
+		MiiStatus:      stringValueOrNull(bonding.MiiStatus),
+	}
+}
+
+func bondingSlaveNamesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var slaveNames []string
+	diags.Append(set.ElementsAs(ctx, &slaveNames, false)...)
+	sort.Strings(slaveNames)
+	return slaveNames
+}
+
+// checkBondingXmitHashPolicy reports a transmit hash policy set on a mode
+// that does not hash traffic over the member NICs.
+func checkBondingXmitHashPolicy(mode, xmitHashPolicy string) error {
+	if xmitHashPolicy == "" {
+		return nil
+	}
+	switch mode {
+	case "802.3ad", "balance-xor":
+		return nil
+	}
+	return fmt.Errorf("xmit_hash_policy %q requires mode 802.3ad or balance-xor, got %q", xmitHashPolicy, mode)
+}
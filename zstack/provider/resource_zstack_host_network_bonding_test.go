@@ -0,0 +1,131 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestHostNetworkBondingResource_Schema(t *testing.T) {
+	var r hostNetworkBondingResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"host_uuid", "bond_name", "mode", "slave_names"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"uuid", "xmit_hash_policy", "mac", "mii_status"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestHostNetworkBondingResource_Metadata(t *testing.T) {
+	var r hostNetworkBondingResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_host_network_bonding" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestCheckBondingXmitHashPolicy(t *testing.T) {
+	tests := []struct {
+		mode           string
+		xmitHashPolicy string
+		expectError    bool
+	}{
+		{mode: "802.3ad", xmitHashPolicy: "layer3+4"},
+		{mode: "balance-xor", xmitHashPolicy: "layer2"},
+		{mode: "active-backup"},
+		{mode: "active-backup", xmitHashPolicy: "layer2+3", expectError: true},
+		{mode: "balance-rr", xmitHashPolicy: "layer2", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode+"/"+tt.xmitHashPolicy, func(t *testing.T) {
+			err := checkBondingXmitHashPolicy(tt.mode, tt.xmitHashPolicy)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("checkBondingXmitHashPolicy(%q, %q) error = %v, expectError %v", tt.mode, tt.xmitHashPolicy, err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestAccHostNetworkBondingResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	hostUuid := os.Getenv("ZSTACK_BONDING_TEST_HOST_UUID")
+	slaves := strings.Split(os.Getenv("ZSTACK_BONDING_TEST_SLAVES"), ",")
+	if hostUuid == "" || len(slaves) < 2 || slaves[0] == "" || slaves[1] == "" {
+		t.Skip("set ZSTACK_BONDING_TEST_HOST_UUID and ZSTACK_BONDING_TEST_SLAVES to a host and two comma-separated unused NICs before running this acceptance test")
+	}
+
+	config := func(mode, members string) string {
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_host_network_bonding" "test" {
+  host_uuid   = %q
+  bond_name   = "bondacc0"
+  mode        = %q
+  slave_names = [%s]
+}
+`, hostUuid, mode, members)
+	}
+	first := fmt.Sprintf("%q", slaves[0])
+	both := fmt.Sprintf("%q, %q", slaves[0], slaves[1])
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHostNetworkBondingDestroy,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config("active-backup", first),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_host_network_bonding.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("zstack_host_network_bonding.test", tfjsonpath.New("slave_names"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			{
+				Config: config("802.3ad", both),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_host_network_bonding.test", tfjsonpath.New("mode"), knownvalue.StringExact("802.3ad")),
+					statecheck.ExpectKnownValue("zstack_host_network_bonding.test", tfjsonpath.New("slave_names"), knownvalue.SetSizeExact(2)),
+				},
+			},
+			{
+				ResourceName:                         "zstack_host_network_bonding.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_host_network_bonding.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"mii_status"},
+			},
+		},
+	})
+}
@@ -149,12 +149,18 @@ func (r *iam2OrganizationResource) Create(ctx context.Context, req resource.Crea
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateIAM2Organization(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating IAM2 Organization",
-			"Could not create IAM2 organization, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryIAM2Organization), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "IAM2 Organization", "CreateIAM2Organization", err, &resp.Diagnostics)
 		return
 	}
 
@@ -192,12 +198,15 @@ func (r *iam2OrganizationResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading IAM2 Organization",
-			"Could not read IAM2 organization UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read IAM2 organization UUID "+state.Uuid.ValueString(),
+			"QueryIAM2Organization",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Name = types.StringValue(org.Name)
 	state.Description = stringValueOrNull(org.Description)
@@ -238,10 +247,12 @@ func (r *iam2OrganizationResource) Update(ctx context.Context, req resource.Upda
 
 	result, err := r.client.UpdateIAM2Organization(state.Uuid.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating IAM2 Organization",
-			"Could not update IAM2 organization, unexpected error: "+err.Error(),
-		)
+			"Could not update IAM2 organization",
+			"UpdateIAM2Organization",
+			err,
+		))
 		return
 	}
 
@@ -272,10 +283,12 @@ func (r *iam2OrganizationResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.client.DeleteIAM2Organization(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting IAM2 Organization",
-			"Could not delete IAM2 organization, unexpected error: "+err.Error(),
-		)
+			"Could not delete IAM2 organization",
+			"DeleteIAM2Organization",
+			err,
+		))
 		return
 	}
 
@@ -122,12 +122,18 @@ func (r *iam2ProjectResource) Create(ctx context.Context, req resource.CreateReq
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	project, err := r.client.CreateIAM2Project(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating IAM2 Project",
-			"Could not create IAM2 project, unexpected error: "+err.Error(),
-		)
+		project, err = adoptCreatedResource(ctx, r.client.GetIAM2Project, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "IAM2 Project", "CreateIAM2Project", err, &resp.Diagnostics)
 		return
 	}
 
@@ -155,12 +161,15 @@ func (r *iam2ProjectResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading IAM2 Project",
-			"Could not read IAM2 project UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read IAM2 project UUID "+state.Uuid.ValueString(),
+			"GetIAM2Project",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(project.UUID)
 	state.Name = types.StringValue(project.Name)
@@ -196,20 +205,24 @@ func (r *iam2ProjectResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if _, err := r.client.UpdateIAM2Project(state.Uuid.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating IAM2 Project",
-			"Could not update IAM2 project, unexpected error: "+err.Error(),
-		)
+			"Could not update IAM2 project",
+			"UpdateIAM2Project",
+			err,
+		))
 		return
 	}
 
 	// Read back the updated resource to get the full state
 	project, err := r.client.GetIAM2Project(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading IAM2 Project",
-			"Could not read IAM2 project after update, unexpected error: "+err.Error(),
-		)
+			"Could not read IAM2 project after update",
+			"GetIAM2Project",
+			err,
+		))
 		return
 	}
 
@@ -234,10 +247,12 @@ func (r *iam2ProjectResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	uuid := state.Uuid.ValueString()
 	if err := r.client.DeleteIAM2Project(uuid, param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting IAM2 Project",
-			"Could not delete IAM2 project, unexpected error: "+err.Error(),
-		)
+			"Could not delete IAM2 project",
+			"DeleteIAM2Project",
+			err,
+		))
 		return
 	}
 
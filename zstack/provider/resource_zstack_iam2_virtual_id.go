@@ -129,12 +129,18 @@ func (r *iam2VirtualIDResource) Create(ctx context.Context, request resource.Cre
 		createParam.Params.Description = stringPtrOrNil(plan.Description.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateIAM2VirtualID(createParam)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating IAM2 Virtual ID",
-			"Could not create IAM2 virtual ID, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryIAM2VirtualID), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "IAM2 Virtual ID", "CreateIAM2VirtualID", err, &response.Diagnostics)
 		return
 	}
 
@@ -168,12 +174,15 @@ func (r *iam2VirtualIDResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading IAM2 Virtual ID",
-			"Could not read IAM2 virtual ID UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read IAM2 virtual ID UUID "+state.Uuid.ValueString(),
+			"QueryIAM2VirtualID",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Name = types.StringValue(virtualID.Name)
 	state.Description = stringValueOrNull(virtualID.Description)
@@ -213,10 +222,12 @@ func (r *iam2VirtualIDResource) Update(ctx context.Context, request resource.Upd
 
 	result, err := r.client.UpdateIAM2VirtualID(state.Uuid.ValueString(), updateParam)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating IAM2 Virtual ID",
-			"Could not update IAM2 virtual ID, unexpected error: "+err.Error(),
-		)
+			"Could not update IAM2 virtual ID",
+			"UpdateIAM2VirtualID",
+			err,
+		))
 		return
 	}
 
@@ -243,10 +254,12 @@ func (r *iam2VirtualIDResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DeleteIAM2VirtualID(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting IAM2 Virtual ID",
-			"Could not delete IAM2 virtual ID, unexpected error: "+err.Error(),
-		)
+			"Could not delete IAM2 virtual ID",
+			"DeleteIAM2VirtualID",
+			err,
+		))
 		return
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,8 +23,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
 )
 
+// imageCreateTimeout bounds how long Read waits for an image import
+// interrupted by an earlier run to finish downloading.
+const imageCreateTimeout = 2 * time.Hour
+
 var (
 	_ resource.Resource                = &imageResource{}
 	_ resource.ResourceWithConfigure   = &imageResource{}
@@ -89,10 +95,12 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	if imagePlan.BackupStorageUuids.IsNull() {
 		storage, err := r.client.QueryBackupStorage(&param.QueryParam{})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating Image",
-				fmt.Sprintf("Could not create image because backup storage could not be read: %v", err),
-			)
+				"Could not create image because backup storage could not be read",
+				"QueryBackupStorage",
+				err,
+			))
 			return
 		}
 		backupStorageUuids = []string{storage[0].UUID}
@@ -152,18 +160,24 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 			Platform:           stringPtr(imagePlan.Platform.ValueString()),
 			BackupStorageUuids: backupStorageUuids,
 			//Type:               imagePlan.Type.ValueString(),
-			//ResourceUuid: stringPtr(""),
 			Architecture: stringPtr(imagePlan.Architecture.ValueString()),
 			Virtio:       boolPtr(imagePlan.Virtio.ValueString() == "true"),
 		},
 	}
 
 	ctx = tflog.SetField(ctx, "url", imagePlan.Url)
-	image, err := r.client.AddImage(imageParam)
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	imageParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	image, err := runInterruptible(ctx, r.client.AddImage, imageParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Image", "Could not create image, unexpected error: "+err.Error(),
-		)
+		image, err = adoptCreatedResource(ctx, r.client.GetImage, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Image", "AddImage", err, &resp.Diagnostics)
 		return
 	}
 
@@ -202,7 +216,7 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	err := r.client.DeleteImage(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Image", "Could not delete image, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Image", "Could not delete image", "DeleteImage", err))
 		return
 	}
 
@@ -211,9 +225,12 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 		err = r.client.ExpungeImage(state.Uuid.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error expunging Image", "Could not expunge image, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error expunging Image",
+				"Could not expunge image",
+				"ExpungeImage",
+				err,
+			))
 			return
 		}
 	}
@@ -238,9 +255,31 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading Image", "Could not read image UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Image",
+			"Could not read image UUID "+state.Uuid.ValueString(),
+			"GetImage",
+			err,
+		))
+		return
+	}
+
+	// An import interrupted by a previous run may still be downloading.
+	image, err = resumePendingCreate(ctx, resp.Private, r.client.GetImage, image, imageReady, imageCreateTimeout, &resp.Diagnostics)
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Image",
+			"Could not read image UUID "+state.Uuid.ValueString(),
+			"GetImage",
+			err,
+		))
+		return
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -260,6 +299,11 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 }
 
+// imageReady reports whether ZStack has finished importing the image.
+func imageReady(image *view.ImageInventoryView) bool {
+	return image.Status == "Ready"
+}
+
 // Schema implements resource.Resource.
 func (r *imageResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -440,10 +484,12 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if _, err := r.client.UpdateImage(uuid, updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Image",
-			"Could not update image, unexpected error: "+err.Error(),
-		)
+			"Could not update image",
+			"UpdateImage",
+			err,
+		))
 		return
 	}
 
@@ -451,10 +497,12 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// but reading via GetImage keeps Update / Read state-construction in lockstep.
 	image, err := findResourceByGet(r.client.GetImage, uuid)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Image",
-			"Could not refresh image after update: "+err.Error(),
-		)
+			"Could not refresh image after update",
+			"GetImage",
+			err,
+		))
 		return
 	}
 
@@ -189,12 +189,18 @@ func (r *imageStoreBackupStorageResource) Create(ctx context.Context, request re
 		createParam.Params.SshPort = &sshPort
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.AddImageStoreBackupStorage(createParam)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Image Store Backup Storage",
-			"Could not create image store backup storage, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryImageStoreBackupStorage), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Image Store Backup Storage", "AddImageStoreBackupStorage", err, &response.Diagnostics)
 		return
 	}
 
@@ -235,10 +241,12 @@ func (r *imageStoreBackupStorageResource) Read(ctx context.Context, request reso
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Image Store Backup Storage",
-			"Could not read image store backup storage UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read image store backup storage UUID "+state.Uuid.ValueString(),
+			"QueryImageStoreBackupStorage",
+			err,
+		))
 		return
 	}
 
@@ -251,6 +259,7 @@ func (r *imageStoreBackupStorageResource) Read(ctx context.Context, request reso
 	}
 
 	backupStorage := backupStorages[0]
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Name = types.StringValue(backupStorage.Name)
 	state.Description = stringValueOrNull(backupStorage.Description)
@@ -310,10 +319,12 @@ func (r *imageStoreBackupStorageResource) Update(ctx context.Context, request re
 
 	_, err := r.client.UpdateImageStoreBackupStorage(state.Uuid.ValueString(), updateParam)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Image Store Backup Storage",
-			"Could not update image store backup storage, unexpected error: "+err.Error(),
-		)
+			"Could not update image store backup storage",
+			"UpdateImageStoreBackupStorage",
+			err,
+		))
 		return
 	}
 
@@ -323,10 +334,12 @@ func (r *imageStoreBackupStorageResource) Update(ctx context.Context, request re
 
 	backupStorages, err := r.client.QueryImageStoreBackupStorage(&queryParam)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Image Store Backup Storage",
-			"Could not read image store backup storage UUID "+state.Uuid.ValueString()+" after update: "+err.Error(),
-		)
+			"Could not read image store backup storage UUID "+state.Uuid.ValueString()+" after update",
+			"QueryImageStoreBackupStorage",
+			err,
+		))
 		return
 	}
 
@@ -339,6 +352,7 @@ func (r *imageStoreBackupStorageResource) Update(ctx context.Context, request re
 	}
 
 	backupStorage := backupStorages[0]
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	plan.Uuid = types.StringValue(backupStorage.UUID)
 	plan.Name = types.StringValue(backupStorage.Name)
@@ -370,10 +384,12 @@ func (r *imageStoreBackupStorageResource) Delete(ctx context.Context, request re
 
 	err := r.client.DeleteBackupStorage(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Image Store Backup Storage",
-			"Could not delete image store backup storage, unexpected error: "+err.Error(),
-		)
+			"Could not delete image store backup storage",
+			"DeleteBackupStorage",
+			err,
+		))
 		return
 	}
 
@@ -174,9 +174,18 @@ func (r *infoSecSecurityMachineResource) Create(ctx context.Context, req resourc
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddInfoSecSecurityMachine(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating InfoSec Security Machine", "Could not create InfoSec security machine, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySecurityMachine), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "InfoSec Security Machine", "AddInfoSecSecurityMachine", err, &resp.Diagnostics)
 		return
 	}
 
@@ -200,12 +209,15 @@ func (r *infoSecSecurityMachineResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Machine",
-			"Could not read Security Machine, unexpected error: "+err.Error(),
-		)
+			"Could not read Security Machine",
+			"QuerySecurityMachine",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = infoSecSecurityMachineModelFromView(item, state)
 
@@ -239,7 +251,7 @@ func (r *infoSecSecurityMachineResource) Update(ctx context.Context, req resourc
 
 	item, err := r.client.UpdateInfoSecSecurityMachine(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating InfoSec Security Machine", "Could not update InfoSec security machine, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating InfoSec Security Machine", "Could not update InfoSec security machine", "UpdateInfoSecSecurityMachine", err))
 		return
 	}
 
@@ -259,7 +271,7 @@ func (r *infoSecSecurityMachineResource) Delete(ctx context.Context, req resourc
 
 
 	if err := r.client.DeleteSecurityMachine(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting InfoSec Security Machine", "Could not delete InfoSec security machine, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting InfoSec Security Machine", "Could not delete InfoSec security machine", "DeleteSecurityMachine", err))
 		return
 	}
 }
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 	"terraform-provider-zstack/zstack/utils"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -59,6 +61,10 @@ func (v noConsecutiveHyphenValidator) ValidateString(ctx context.Context, req va
 	}
 }
 
+// vmInstanceCreateTimeout bounds how long Read waits for a VM instance create
+// interrupted by an earlier run to finish.
+const vmInstanceCreateTimeout = 30 * time.Minute
+
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
@@ -231,10 +237,13 @@ func (r *instanceResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 						"static_ip": schema.StringAttribute{
 							Optional:    true,
 							Computed:    true,
-							Description: "Static IP address to assign. Optional — if omitted, the server picks one and reports it back. The format will be converted to system tag `staticIp::<l3_uuid>::<ip>`.",
+							Description: "Static IPv4 or IPv6 address to assign. Optional — if omitted, the server picks one and reports it back. The format will be converted to system tag `staticIp::<l3_uuid>::<ip>`; the colons of an IPv6 address are encoded as `--`.",
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{
+								ipAddressValidator{},
+							},
 						},
 					},
 				},
@@ -687,7 +696,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 			staticIp = types.StringNull()
 		} else {
 			staticIp = nic.StaticIp
-			systemTags = append(systemTags, fmt.Sprintf("staticIp::%s::%s", l3uuid, staticIp.ValueString()))
+			systemTags = append(systemTags, staticIpSystemTag(l3uuid, staticIp.ValueString()))
 		}
 
 		createNics = append(createNics, NetworkInterfaceModel{
@@ -712,10 +721,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// SET IMAGE
 	image, err := r.client.GetImage(plan.ImageUuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating VM Instance",
-			fmt.Sprintf("Could not create vm instance, failed to find image %s: %v", plan.ImageUuid.ValueString(), err),
-		)
+			fmt.Sprintf("Could not create vm instance, failed to find image %s", plan.ImageUuid.ValueString()),
+			"GetImage",
+			err,
+		))
 		return
 	}
 
@@ -834,10 +845,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	if !plan.InstanceOfferingUuid.IsNull() && plan.InstanceOfferingUuid.ValueString() != "" {
 		instanceOffering, err := r.client.GetInstanceOffering(plan.InstanceOfferingUuid.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating VM Instance",
-				fmt.Sprintf("Could not create vm instance, failed to get instance offering %s: %v", plan.InstanceOfferingUuid.ValueString(), err),
-			)
+				fmt.Sprintf("Could not create vm instance, failed to get instance offering %s", plan.InstanceOfferingUuid.ValueString()),
+				"GetInstanceOffering",
+				err,
+			))
 			return
 		}
 		memorySize = instanceOffering.MemorySize
@@ -889,12 +902,18 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		},
 	}
 
-	instance, err := r.client.CreateVmInstance(createVmInstanceParam)
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createVmInstanceParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	instance, err := runInterruptible(ctx, r.client.CreateVmInstance, createVmInstanceParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating VM Instance",
-			fmt.Sprintf("Could not create vm instance, unexpected error: %v", err),
-		)
+		instance, err = adoptCreatedResource(ctx, r.client.GetVmInstance, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "VM Instance", "CreateVmInstance", err, &resp.Diagnostics)
 		return
 	}
 
@@ -1038,6 +1057,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 }
 
+// vmInstanceCreated reports whether ZStack has finished creating the VM
+// instance, i.e. it has left the Created and Starting states.
+func vmInstanceCreated(vm *view.VmInstanceInventoryView) bool {
+	return vm.State != "Created" && vm.State != "Starting"
+}
+
 // Read implements resource.Resource.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state vmInstanceDataSourceModel
@@ -1057,10 +1082,31 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VM Instance",
-			"Could not read VM instance UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read VM instance UUID "+state.Uuid.ValueString(),
+			"GetVmInstance",
+			err,
+		))
+		return
+	}
+
+	// A create interrupted by a previous run may still be starting the VM.
+	vm, err = resumePendingCreate(ctx, resp.Private, r.client.GetVmInstance, vm, vmInstanceCreated, vmInstanceCreateTimeout, &resp.Diagnostics)
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VM Instance",
+			"Could not read VM instance UUID "+state.Uuid.ValueString(),
+			"GetVmInstance",
+			err,
+		))
+		return
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -1098,6 +1144,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(diags...)
 
 	networkInterfaces := normalizeNetworkInterfacesFromVM(vm)
+	keepConfiguredStaticIps(ctx, state.NetworkInterfaces, vm, networkInterfaces)
 	state.NetworkInterfaces, _ = types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"l3_network_uuid": types.StringType,
@@ -1174,18 +1221,24 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	if updateVm {
 		preserveInstanceNameForUpdate(&updateVmInstanceParam, plan.Name)
 		if _, err := r.client.UpdateVmInstance(uuid, updateVmInstanceParam); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating VM Instance",
-				"Could not update vm instance, unexpected error: "+err.Error())
+				"Could not update vm instance",
+				"UpdateVmInstance",
+				err,
+			))
 			return
 		}
 
 		// Refresh from server to keep Update / Read state-construction in lockstep.
 		vm, err := findResourceByGet(r.client.GetVmInstance, uuid)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating VM Instance",
-				"Could not refresh vm instance after update: "+err.Error())
+				"Could not refresh vm instance after update",
+				"GetVmInstance",
+				err,
+			))
 			return
 		}
 
@@ -1230,9 +1283,12 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	//Delete existing vm instance
 	err = r.client.DestroyVmInstance(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error destroying VM Instance", "Could not destroy vm instance, unexpected error: "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error destroying VM Instance",
+			"Could not destroy vm instance",
+			"DestroyVmInstance",
+			err,
+		))
 		return
 	}
 
@@ -1240,9 +1296,12 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	for _, uuid := range volumeUuids {
 		err = r.client.DeleteDataVolume(uuid, param.DeleteModePermissive)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error deleting Data Volume", "Could not delete data volume UUID "+uuid+": "+err.Error(),
-			)
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Data Volume",
+				"Could not delete data volume UUID "+uuid,
+				"DeleteDataVolume",
+				err,
+			))
 			return
 		}
 	}
@@ -1257,9 +1316,12 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		//Expunge vm instance
 		err = r.client.ExpungeVmInstance(state.Uuid.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error expunging VM Instance", "Could not expunge vm instance, unexpected error: "+err.Error(),
-			)
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error expunging VM Instance",
+				"Could not expunge vm instance",
+				"ExpungeVmInstance",
+				err,
+			))
 			return
 		}
 
@@ -1267,9 +1329,12 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		for _, uuid := range volumeUuids {
 			err = r.client.ExpungeDataVolume(uuid)
 			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error expunging Data Volume", "Could not expunge data volume UUID "+uuid+": "+err.Error(),
-				)
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
+					"Error expunging Data Volume",
+					"Could not expunge data volume UUID "+uuid,
+					"ExpungeDataVolume",
+					err,
+				))
 				return
 			}
 		}
@@ -1343,6 +1408,67 @@ func hostnameSystemTagForCreate(hostname types.String) string {
 	return fmt.Sprintf("hostname::%s", hostname.ValueString())
 }
 
+// staticIpSystemTag returns the system tag that pins a NIC on the L3 network
+// to ip. ZStack separates tag tokens with "::", so the colons of an IPv6
+// address are encoded as "--".
+func staticIpSystemTag(l3Uuid, ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+		ip = strings.ReplaceAll(parsed.String(), ":", "--")
+	}
+	return fmt.Sprintf("staticIp::%s::%s", l3Uuid, ip)
+}
+
+// vmNicHasIp reports whether ip is one of the addresses of the NIC. A
+// dual-stack NIC reports its IPv4 address in Ip and both addresses in UsedIps.
+func vmNicHasIp(nic view.VmNicInventoryView, ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	if parsed.Equal(net.ParseIP(nic.Ip)) {
+		return true
+	}
+	for _, used := range nic.UsedIps {
+		if parsed.Equal(net.ParseIP(used.Ip)) {
+			return true
+		}
+	}
+	return false
+}
+
+// keepConfiguredStaticIps keeps the static IPs from prior that the VM still
+// holds, so an IPv6 address written in a different notation, or the IPv6
+// address of a dual-stack NIC, does not show up as a diff.
+func keepConfiguredStaticIps(ctx context.Context, prior types.List, vm *view.VmInstanceInventoryView, nics []NetworkInterfaceModel) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return
+	}
+	var priorNics []NetworkInterfaceModel
+	if diags := prior.ElementsAs(ctx, &priorNics, false); diags.HasError() {
+		return
+	}
+
+	configured := make(map[string]types.String, len(priorNics))
+	for _, nic := range priorNics {
+		if !nic.StaticIp.IsNull() && !nic.StaticIp.IsUnknown() && nic.StaticIp.ValueString() != "" {
+			configured[nic.L3NetworkUuid.ValueString()] = nic.StaticIp
+		}
+	}
+
+	for i := range nics {
+		staticIp, ok := configured[nics[i].L3NetworkUuid.ValueString()]
+		if !ok {
+			continue
+		}
+		for _, vmNic := range vm.VmNics {
+			if vmNic.L3NetworkUuid == nics[i].L3NetworkUuid.ValueString() && vmNicHasIp(vmNic, staticIp.ValueString()) {
+				nics[i].StaticIp = staticIp
+				break
+			}
+		}
+	}
+}
+
 func normalizeNetworkInterfacesFromVM(vm *view.VmInstanceInventoryView) []NetworkInterfaceModel {
 	networkInterfaces := make([]NetworkInterfaceModel, 0, len(vm.VmNics))
 	for _, nic := range vm.VmNics {
@@ -1383,6 +1509,7 @@ func buildUpdatedStateFromVM(ctx context.Context, current vmInstanceDataSourceMo
 	updated.VMNics = vmNicsValue
 
 	networkInterfaces := normalizeNetworkInterfacesFromVM(vm)
+	keepConfiguredStaticIps(ctx, current.NetworkInterfaces, vm, networkInterfaces)
 	networkInterfacesValue, diags := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"l3_network_uuid": types.StringType,
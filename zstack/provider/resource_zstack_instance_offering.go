@@ -89,12 +89,19 @@ func (r *instanceOfferingResource) Create(ctx context.Context, req resource.Crea
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	offerParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	instance_offer, err := r.client.CreateInstanceOffering(offerParam)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Instance Offering", "Could not create instance offering, unexpected error: "+err.Error(),
-		)
+		instance_offer, err = adoptCreatedResource(ctx, r.client.GetInstanceOffering, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Instance Offering", "CreateInstanceOffering", err, &resp.Diagnostics)
 		return
 	}
 
@@ -125,7 +132,7 @@ func (r *instanceOfferingResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteInstanceOffering(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Instance Offering", "Could not delete instance offering, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Instance Offering", "Could not delete instance offering", "DeleteInstanceOffering", err))
 		return
 	}
 }
@@ -149,11 +156,15 @@ func (r *instanceOfferingResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading Instance Offering", "Could not read instance offering UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Instance Offering",
+			"Could not read instance offering UUID "+state.Uuid.ValueString(),
+			"GetInstanceOffering",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Type = types.StringValue(instance_offer.Type)
 	if instance_offer.Type == "" {
@@ -209,12 +209,18 @@ func (r *scriptResource) Create(ctx context.Context, request resource.CreateRequ
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	Param.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	script, err := r.client.CreateGuestVmScript(Param)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Script",
-			"Could not create script, unexpected error: "+err.Error(),
-		)
+		script, err = adoptCreatedResource(ctx, r.client.GetGuestVmScript, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VM Instance Script", "CreateGuestVmScript", err, &response.Diagnostics)
 		return
 	}
 
@@ -265,12 +271,15 @@ func (r *scriptResource) Read(ctx context.Context, request resource.ReadRequest,
 			"uuid":  state.Uuid.ValueString(),
 			"error": err.Error(),
 		})
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Script",
-			"Could not read script UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read script UUID "+state.Uuid.ValueString(),
+			"GetGuestVmScript",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(scripts.UUID)
 	state.Name = types.StringValue(scripts.Name)
@@ -384,10 +393,12 @@ func (r *scriptResource) Update(ctx context.Context, request resource.UpdateRequ
 
 	_, err := r.client.UpdateGuestVmScript(state.Uuid.ValueString(), Param)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Script",
-			"Could not update script, unexpected error: "+err.Error(),
-		)
+			"Could not update script",
+			"UpdateGuestVmScript",
+			err,
+		))
 		return
 	}
 
@@ -417,10 +428,12 @@ func (r *scriptResource) Delete(ctx context.Context, request resource.DeleteRequ
 
 	err := r.client.DeleteGuestVmScript(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Script",
-			"Could not delete script, unexpected error: "+err.Error(),
-		)
+			"Could not delete script",
+			"DeleteGuestVmScript",
+			err,
+		))
 		return
 	}
 
@@ -180,10 +180,12 @@ func (r *scriptExecutionResource) Create(ctx context.Context, request resource.C
 
 	scriptExecuteResult, err := r.client.ExecuteGuestVmScript(plan.ScriptUuid.ValueString(), executeParam)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating Script Execution",
-			"Could not create script execution, unexpected error: "+err.Error(),
-		)
+			"Could not create script execution",
+			"ExecuteGuestVmScript",
+			err,
+		))
 		return
 	}
 
@@ -209,10 +211,12 @@ func (r *scriptExecutionResource) Create(ctx context.Context, request resource.C
 
 		record, err = r.client.GetGuestVmScriptExecutedRecord(recordUuid)
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating Script Execution",
-				"Could not create script execution because the execution record could not be read: "+err.Error(),
-			)
+				"Could not create script execution because the execution record could not be read",
+				"GetGuestVmScriptExecutedRecord",
+				err,
+			))
 			return
 		}
 
@@ -291,10 +295,12 @@ func (r *scriptExecutionResource) Read(ctx context.Context, request resource.Rea
 			"id":    state.Uuid.ValueString(),
 			"error": err.Error(),
 		})
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Script Execution",
-			"Could not read script execution record ID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read script execution record ID "+state.Uuid.ValueString(),
+			"GetGuestVmScriptExecutedRecord",
+			err,
+		))
 		return
 	}
 
@@ -138,10 +138,12 @@ func (r *instanceStateResource) Create(ctx context.Context, req resource.CreateR
 
 	state, err := r.reconcileInstanceState(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error managing VM Instance State",
-			"Could not set VM instance state: "+err.Error(),
-		)
+			"Could not set VM instance state",
+			"",
+			err,
+		))
 		return
 	}
 
@@ -178,10 +180,12 @@ func (r *instanceStateResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VM Instance State",
-			"Could not read VM instance UUID "+state.VmInstanceUuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read VM instance UUID "+state.VmInstanceUuid.ValueString(),
+			"GetVmInstance",
+			err,
+		))
 		return
 	}
 
@@ -219,10 +223,12 @@ func (r *instanceStateResource) Update(ctx context.Context, req resource.UpdateR
 
 	state, err := r.reconcileInstanceState(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error managing VM Instance State",
-			"Could not set VM instance state: "+err.Error(),
-		)
+			"Could not set VM instance state",
+			"",
+			err,
+		))
 		return
 	}
 
@@ -260,6 +266,14 @@ func (r *instanceStateResource) reconcileInstanceState(ctx context.Context, plan
 	uuid := plan.VmInstanceUuid.ValueString()
 	desiredState := plan.State.ValueString()
 
+	// Hold the VM lock until the transition finishes so NIC, CD-ROM and
+	// volume changes do not hit the VM while it is Starting or Stopping.
+	unlock, err := lockParents(ctx, uuid)
+	if err != nil {
+		return "", fmt.Errorf("lock VM instance %s: %w", uuid, err)
+	}
+	defer unlock()
+
 	vm, err := findResourceByGet(r.client.GetVmInstance, uuid)
 	if err != nil {
 		if errors.Is(err, ErrResourceNotFound) {
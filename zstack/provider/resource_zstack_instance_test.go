@@ -67,6 +67,23 @@ func TestVMResource_Metadata(t *testing.T) {
 	}
 }
 
+func TestStaticIpSystemTag(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{ip: "192.168.1.10", want: "staticIp::l3-uuid::192.168.1.10"},
+		{ip: "2001:db8::10", want: "staticIp::l3-uuid::2001--db8----10"},
+		{ip: "2001:DB8:0:0:0:0:0:10", want: "staticIp::l3-uuid::2001--db8----10"},
+	}
+
+	for _, tt := range tests {
+		if got := staticIpSystemTag("l3-uuid", tt.ip); got != tt.want {
+			t.Errorf("staticIpSystemTag(%q) = %q, want %q", tt.ip, got, tt.want)
+		}
+	}
+}
+
 func TestAccInstanceResource(t *testing.T) {
 	env := loadEnvData(t)
 	if len(env.Images) == 0 {
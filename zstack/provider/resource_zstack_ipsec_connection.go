@@ -227,12 +227,18 @@ func (r *ipsecConnectionResource) Create(ctx context.Context, request resource.C
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	ipsecConnection, err := r.client.CreateIPsecConnection(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating IPsec Connection",
-			"Could not create IPsec connection, unexpected error: "+err.Error(),
-		)
+		ipsecConnection, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryIPSecConnection), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "IPsec Connection", "CreateIPsecConnection", err, &response.Diagnostics)
 		return
 	}
 
@@ -273,12 +279,15 @@ func (r *ipsecConnectionResource) Read(ctx context.Context, request resource.Rea
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading IPsec Connection",
-			"Could not read IPsec Connection, unexpected error: "+err.Error(),
-		)
+			"Could not read IPsec Connection",
+			"QueryIPSecConnection",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(ipsecConnection.UUID)
 	state.Name = types.StringValue(ipsecConnection.Name)
@@ -330,10 +339,12 @@ func (r *ipsecConnectionResource) Update(ctx context.Context, request resource.U
 
 	ipsecConnection, err := r.client.UpdateIPsecConnection(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating IPsec Connection",
-			"Could not update IPsec connection, unexpected error: "+err.Error(),
-		)
+			"Could not update IPsec connection",
+			"UpdateIPsecConnection",
+			err,
+		))
 		return
 	}
 
@@ -372,7 +383,7 @@ func (r *ipsecConnectionResource) Delete(ctx context.Context, request resource.D
 	err := r.client.DeleteIPsecConnection(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting IPsec Connection", "Could not delete IPsec connection, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting IPsec Connection", "Could not delete IPsec connection", "DeleteIPsecConnection", err))
 		return
 	}
 }
@@ -142,12 +142,18 @@ func (r *iscsiServerResource) Create(ctx context.Context, request resource.Creat
 		p.Params.ChapUserPassword = stringPtr(plan.ChapUserPassword.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	server, err := r.client.AddIscsiServer(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating iSCSI Server",
-			"Could not create iSCSI server, unexpected error: "+err.Error(),
-		)
+		server, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryIscsiServer), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "iSCSI Server", "AddIscsiServer", err, &response.Diagnostics)
 		return
 	}
 
@@ -176,12 +182,15 @@ func (r *iscsiServerResource) Read(ctx context.Context, request resource.ReadReq
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading ISCSI Server",
-			"Could not read ISCSI Server, unexpected error: "+err.Error(),
-		)
+			"Could not read ISCSI Server",
+			"QueryIscsiServer",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(server.UUID)
 	state.Name = types.StringValue(server.Name)
@@ -223,10 +232,12 @@ func (r *iscsiServerResource) Update(ctx context.Context, request resource.Updat
 
 	server, err := r.client.UpdateIscsiServer(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating iSCSI Server",
-			"Could not update iSCSI server, unexpected error: "+err.Error(),
-		)
+			"Could not update iSCSI server",
+			"UpdateIscsiServer",
+			err,
+		))
 		return
 	}
 
@@ -252,7 +263,7 @@ func (r *iscsiServerResource) Delete(ctx context.Context, request resource.Delet
 
 	err := r.client.DeleteIscsiServer(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting iSCSI Server", "Could not delete iSCSI server, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting iSCSI Server", "Could not delete iSCSI server", "DeleteIscsiServer", err))
 		return
 	}
 }
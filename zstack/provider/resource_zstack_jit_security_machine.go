@@ -164,9 +164,18 @@ func (r *jitSecurityMachineResource) Create(ctx context.Context, req resource.Cr
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddJitSecurityMachine(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating JIT Security Machine", "Could not create JIT security machine, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySecurityMachine), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "JIT Security Machine", "AddJitSecurityMachine", err, &resp.Diagnostics)
 		return
 	}
 
@@ -190,12 +199,15 @@ func (r *jitSecurityMachineResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Machine",
-			"Could not read Security Machine, unexpected error: "+err.Error(),
-		)
+			"Could not read Security Machine",
+			"QuerySecurityMachine",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = jitSecurityMachineModelFromView(item, state)
 
@@ -228,7 +240,7 @@ func (r *jitSecurityMachineResource) Update(ctx context.Context, req resource.Up
 
 	item, err := r.client.UpdateJitSecurityMachine(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating JIT Security Machine", "Could not update JIT security machine, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating JIT Security Machine", "Could not update JIT security machine", "UpdateJitSecurityMachine", err))
 		return
 	}
 
@@ -248,7 +260,7 @@ func (r *jitSecurityMachineResource) Delete(ctx context.Context, req resource.De
 
 
 	if err := r.client.DeleteSecurityMachine(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting JIT Security Machine", "Could not delete JIT security machine, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting JIT Security Machine", "Could not delete JIT security machine", "DeleteSecurityMachine", err))
 		return
 	}
 }
@@ -0,0 +1,290 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                = &l2NetworkResource{}
+	_ resource.ResourceWithConfigure   = &l2NetworkResource{}
+	_ resource.ResourceWithImportState = &l2NetworkResource{}
+)
+
+type l2NetworkResource struct {
+	client *client.ZSClient
+}
+
+type l2NetworkResourceModel struct {
+	Uuid                 types.String `tfsdk:"uuid"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	ZoneUuid             types.String `tfsdk:"zone_uuid"`
+	PhysicalInterface    types.String `tfsdk:"physical_interface"`
+	Type                 types.String `tfsdk:"type"`
+	VSwitchType          types.String `tfsdk:"vswitch_type"`
+	AttachedClusterUuids types.List   `tfsdk:"attached_cluster_uuids"`
+}
+
+func L2NetworkResource() resource.Resource {
+	return &l2NetworkResource{}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *l2NetworkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata implements resource.Resource.
+func (r *l2NetworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2_network"
+}
+
+// Schema implements resource.Resource.
+func (r *l2NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage ZStack flat (NoVlan) L2 networks. The network uses the physical interface untagged; " +
+			"attach it to clusters with `zstack_l2_network_cluster_attachment`, which also supports per-host interface overrides.",
+		MarkdownDescription: "Manage ZStack flat (NoVlan) L2 networks. The network uses the physical interface untagged; " +
+			"attach it to clusters with `zstack_l2_network_cluster_attachment`, which also supports per-host interface overrides.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the L2 network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the L2 network.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The description of the L2 network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the zone where the L2 network resides.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"physical_interface": schema.StringAttribute{
+				Required:    true,
+				Description: "The physical network interface (e.g., eth0, bond0) used by this L2 network on every host, unless overridden per host in the cluster attachment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the L2 network (L2NoVlanNetwork).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vswitch_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The virtual switch type (LinuxBridge or OvsDpdk). Defaults to LinuxBridge.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("LinuxBridge", "OvsDpdk"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attached_cluster_uuids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The UUIDs of the clusters the L2 network is attached to.",
+			},
+		},
+	}
+}
+
+// Create implements resource.Resource.
+func (r *l2NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan l2NetworkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating L2 network", map[string]any{"name": plan.Name.ValueString(), "physical_interface": plan.PhysicalInterface.ValueString()})
+
+	createParam := param.CreateL2NoVlanNetworkParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreateL2NoVlanNetworkParamDetail{
+			Name:              plan.Name.ValueString(),
+			ZoneUuid:          plan.ZoneUuid.ValueString(),
+			PhysicalInterface: plan.PhysicalInterface.ValueString(),
+		},
+	}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() && plan.Description.ValueString() != "" {
+		createParam.Params.Description = stringPtr(plan.Description.ValueString())
+	}
+	if !plan.VSwitchType.IsNull() && !plan.VSwitchType.IsUnknown() && plan.VSwitchType.ValueString() != "" {
+		createParam.Params.VSwitchType = stringPtr(plan.VSwitchType.ValueString())
+	}
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	l2Network, err := r.client.CreateL2NoVlanNetwork(createParam)
+	if err != nil {
+		l2Network, err = adoptCreatedResource(ctx, r.client.GetL2Network, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "L2 Network", "CreateL2NoVlanNetwork", err, &resp.Diagnostics)
+		return
+	}
+
+	state := l2NetworkModelFromView(l2Network)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read implements resource.Resource.
+func (r *l2NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state l2NetworkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	l2Network, err := r.client.GetL2Network(state.Uuid.ValueString())
+	if err != nil {
+		if isZStackNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 Network", "Could not read L2 network", "GetL2Network", err))
+		return
+	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
+
+	refreshedState := l2NetworkModelFromView(l2Network)
+	diags = resp.State.Set(ctx, &refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update implements resource.Resource.
+func (r *l2NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan l2NetworkResourceModel
+	var state l2NetworkResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := state.Uuid.ValueString()
+
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
+		updateParam := param.UpdateL2NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.UpdateL2NetworkParamDetail{
+				Name:        plan.Name.ValueString(),
+				Description: stringPtrOrNil(plan.Description.ValueString()),
+			},
+		}
+
+		if _, err := r.client.UpdateL2Network(uuid, updateParam); err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating L2 Network", "Could not update L2 network", "UpdateL2Network", err))
+			return
+		}
+	}
+
+	l2Network, err := r.client.GetL2Network(uuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 Network", "Could not read L2 network after update", "GetL2Network", err))
+		return
+	}
+
+	refreshedState := l2NetworkModelFromView(l2Network)
+	diags = resp.State.Set(ctx, &refreshedState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete implements resource.Resource.
+func (r *l2NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state l2NetworkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteL2Network(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting L2 Network", "Could not delete L2 network", "DeleteL2Network", err))
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *l2NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
+
+func l2NetworkModelFromView(l2Network *view.L2NetworkInventoryView) l2NetworkResourceModel {
+	return l2NetworkResourceModel{
+		Uuid:                 types.StringValue(l2Network.UUID),
+		Name:                 types.StringValue(l2Network.Name),
+		Description:          stringValueOrNull(l2Network.Description),
+		ZoneUuid:             types.StringValue(l2Network.ZoneUuid),
+		PhysicalInterface:    types.StringValue(l2Network.PhysicalInterface),
+		Type:                 stringValueOrNull(l2Network.Type),
+		VSwitchType:          stringValueOrNull(l2Network.VSwitchType),
+		AttachedClusterUuids: stringSliceToList(l2Network.AttachedClusterUuids),
+	}
+}
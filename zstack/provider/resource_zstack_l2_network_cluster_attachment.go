@@ -6,13 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
@@ -33,6 +39,9 @@ type l2NetworkClusterAttachmentModel struct {
 	ID            types.String `tfsdk:"id"`
 	L2NetworkUuid types.String `tfsdk:"l2_network_uuid"`
 	ClusterUuid   types.String `tfsdk:"cluster_uuid"`
+	// HostPhysicalInterfaces maps host UUIDs to the interface that host uses
+	// instead of the physical interface of the L2 network.
+	HostPhysicalInterfaces types.Map `tfsdk:"host_physical_interfaces"`
 }
 
 func L2NetworkClusterAttachmentResource() resource.Resource {
@@ -84,6 +93,20 @@ func (r *l2NetworkClusterAttachmentResource) Schema(_ context.Context, _ resourc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"host_physical_interfaces": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Per-host physical interface overrides, keyed by host UUID, for hosts of the cluster whose uplink NIC name differs from " +
+					"the physical interface of the L2 network. Hosts not listed use the L2 network's interface. " +
+					"Overrides are applied when the L2 network is attached, so changing them re-attaches the L2 network to the cluster.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 		},
 	}
 }
@@ -107,23 +130,40 @@ func (r *l2NetworkClusterAttachmentResource) Create(ctx context.Context, req res
 
 	attached, err := r.isL2NetworkAttachedToCluster(l2NetworkUuid, clusterUuid)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating L2 Network Cluster Attachment",
-			"Could not query L2 network cluster attachment: "+err.Error(),
-		)
+			"Could not query L2 network cluster attachment",
+			"GetL2Network",
+			err,
+		))
 		return
 	}
 
+	overrides := hostPhysicalInterfacesFromMap(ctx, plan.HostPhysicalInterfaces, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attached && len(overrides) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Host Physical Interface Overrides Not Applied",
+			fmt.Sprintf("L2 network %s is already attached to cluster %s, so host_physical_interfaces was not applied. "+
+				"Detach the L2 network from the cluster and apply again to use the overrides.", l2NetworkUuid, clusterUuid),
+		)
+	}
+
 	if !attached {
 		_, err = r.client.AttachL2NetworkToCluster(l2NetworkUuid, clusterUuid, param.AttachL2NetworkToClusterParam{
-			BaseParam: param.BaseParam{},
+			BaseParam: param.BaseParam{SystemTags: hostPhysicalInterfaceSystemTags(overrides)},
 			Params:    param.AttachL2NetworkToClusterParamDetail{},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating L2 Network Cluster Attachment",
-				fmt.Sprintf("Could not attach L2 network %s to cluster %s: %s", l2NetworkUuid, clusterUuid, err.Error()),
-			)
+				fmt.Sprintf("Could not attach L2 network %s to cluster %s", l2NetworkUuid, clusterUuid),
+				"AttachL2NetworkToCluster",
+				err,
+			))
 			return
 		}
 	}
@@ -151,10 +191,12 @@ func (r *l2NetworkClusterAttachmentResource) Read(ctx context.Context, req resou
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading L2 Network Cluster Attachment",
-			"Could not query L2 network cluster attachment: "+err.Error(),
-		)
+			"Could not query L2 network cluster attachment",
+			"GetL2Network",
+			err,
+		))
 		return
 	}
 
@@ -164,6 +206,12 @@ func (r *l2NetworkClusterAttachmentResource) Read(ctx context.Context, req resou
 	}
 
 	state.ID = types.StringValue(l2NetworkClusterAttachmentID(state.L2NetworkUuid.ValueString(), state.ClusterUuid.ValueString()))
+	if !state.HostPhysicalInterfaces.IsNull() {
+		state.HostPhysicalInterfaces = r.readHostPhysicalInterfaces(ctx, state.L2NetworkUuid.ValueString(), state.HostPhysicalInterfaces, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -204,17 +252,21 @@ func (r *l2NetworkClusterAttachmentResource) Delete(ctx context.Context, req res
 				resp.State.RemoveResource(ctx)
 				return
 			}
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error deleting L2 Network Cluster Attachment",
-				fmt.Sprintf("Detach failed (%s) and could not verify attachment status: %s", err.Error(), queryErr.Error()),
-			)
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"GetL2Network",
+				queryErr,
+			))
 			return
 		}
 		if attached {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error deleting L2 Network Cluster Attachment",
-				fmt.Sprintf("Could not detach L2 network %s from cluster %s: %s", l2NetworkUuid, clusterUuid, err.Error()),
-			)
+				fmt.Sprintf("Could not detach L2 network %s from cluster %s", l2NetworkUuid, clusterUuid),
+				"DetachL2NetworkFromCluster",
+				err,
+			))
 			return
 		}
 	}
@@ -251,6 +303,83 @@ func (r *l2NetworkClusterAttachmentResource) isL2NetworkAttachedToCluster(l2Netw
 	return false, nil
 }
 
+// readHostPhysicalInterfaces reads the interface overrides of the hosts in
+// prior back from the system tags of the L2 network. Overrides of hosts not in
+// prior belong to other clusters and are ignored.
+func (r *l2NetworkClusterAttachmentResource) readHostPhysicalInterfaces(ctx context.Context, l2NetworkUuid string, prior types.Map, diags *diag.Diagnostics) types.Map {
+	q := param.NewQueryParam()
+	q.AddQ("resourceUuid=" + l2NetworkUuid)
+	tags, err := r.client.QuerySystemTag(&q)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(
+			"Error reading L2 Network Cluster Attachment",
+			"Could not read host physical interface overrides",
+			"QuerySystemTag",
+			err,
+		))
+		return prior
+	}
+
+	tagValues := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagValues = append(tagValues, tag.Tag)
+	}
+	observed := parseHostPhysicalInterfaceTags(tagValues)
+
+	overrides := make(map[string]string)
+	for hostUuid := range hostPhysicalInterfacesFromMap(ctx, prior, diags) {
+		if physicalInterface, ok := observed[hostUuid]; ok {
+			overrides[hostUuid] = physicalInterface
+		}
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, overrides)
+	diags.Append(d...)
+	return result
+}
+
+func hostPhysicalInterfacesFromMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	overrides := make(map[string]string)
+	if m.IsNull() || m.IsUnknown() {
+		return overrides
+	}
+	diags.Append(m.ElementsAs(ctx, &overrides, false)...)
+	return overrides
+}
+
+// hostPhysicalInterfaceSystemTags returns the attach system tags that make
+// each host use its own physical interface, sorted by host UUID.
+func hostPhysicalInterfaceSystemTags(overrides map[string]string) []string {
+	if len(overrides) == 0 {
+		return nil
+	}
+	hostUuids := make([]string, 0, len(overrides))
+	for hostUuid := range overrides {
+		hostUuids = append(hostUuids, hostUuid)
+	}
+	sort.Strings(hostUuids)
+
+	tags := make([]string, 0, len(hostUuids))
+	for _, hostUuid := range hostUuids {
+		tags = append(tags, fmt.Sprintf("hostUuid::%s::physicalInterface::%s", hostUuid, overrides[hostUuid]))
+	}
+	return tags
+}
+
+// parseHostPhysicalInterfaceTags extracts the host interface overrides from
+// the system tags of an L2 network, skipping unrelated tags.
+func parseHostPhysicalInterfaceTags(tags []string) map[string]string {
+	overrides := make(map[string]string)
+	for _, tag := range tags {
+		parts := strings.Split(tag, "::")
+		if len(parts) != 4 || parts[0] != "hostUuid" || parts[2] != "physicalInterface" || parts[1] == "" || parts[3] == "" {
+			continue
+		}
+		overrides[parts[1]] = parts[3]
+	}
+	return overrides
+}
+
 func l2NetworkClusterAttachmentID(l2NetworkUuid, clusterUuid string) string {
 	return fmt.Sprintf("%s:%s", l2NetworkUuid, clusterUuid)
 }
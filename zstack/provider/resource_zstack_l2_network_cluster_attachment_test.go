@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -34,6 +35,10 @@ func TestL2NetworkClusterAttachmentResource_Schema(t *testing.T) {
 		}
 	}
 
+	if a, ok := resp.Schema.Attributes["host_physical_interfaces"]; !ok || !a.IsOptional() {
+		t.Error("attribute \"host_physical_interfaces\" should be optional")
+	}
+
 	id, ok := resp.Schema.Attributes["id"]
 	if !ok {
 		t.Fatal("schema missing computed attribute \"id\"")
@@ -69,6 +74,31 @@ func TestParseL2NetworkClusterAttachmentID(t *testing.T) {
 	}
 }
 
+func TestHostPhysicalInterfaceSystemTags(t *testing.T) {
+	overrides := map[string]string{
+		"host-b": "ens1f0",
+		"host-a": "bond0",
+	}
+
+	tags := hostPhysicalInterfaceSystemTags(overrides)
+	want := []string{
+		"hostUuid::host-a::physicalInterface::bond0",
+		"hostUuid::host-b::physicalInterface::ens1f0",
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Fatalf("hostPhysicalInterfaceSystemTags() = %v, want %v", tags, want)
+	}
+
+	if tags := hostPhysicalInterfaceSystemTags(nil); tags != nil {
+		t.Fatalf("expected no tags without overrides, got %v", tags)
+	}
+
+	parsed := parseHostPhysicalInterfaceTags(append(tags, "l2NetworkUuid::l2::clusterUuid::c::cidr::{10.0.0.0/24}", "hostUuid::::physicalInterface::eth0"))
+	if !reflect.DeepEqual(parsed, overrides) {
+		t.Fatalf("parseHostPhysicalInterfaceTags() = %v, want %v", parsed, overrides)
+	}
+}
+
 func TestAccL2NetworkClusterAttachmentResource(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("acceptance test skipped unless TF_ACC is set")
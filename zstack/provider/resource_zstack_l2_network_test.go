@@ -0,0 +1,101 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestL2NetworkResource_Schema(t *testing.T) {
+	var r l2NetworkResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"name", "zone_uuid", "physical_interface"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"uuid", "type", "vswitch_type", "attached_cluster_uuids"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestL2NetworkResource_Metadata(t *testing.T) {
+	var r l2NetworkResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_l2_network" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccL2NetworkResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	_, zoneUuid := testAccLiveCluster(t)
+	name := testAccName("l2-novlan")
+
+	config := func(description string) string {
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_l2_network" "test" {
+  name               = %q
+  description        = %q
+  zone_uuid          = %q
+  physical_interface = "eth0"
+}
+`, name, description, zoneUuid)
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckL2NetworkDestroy,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config("created by acceptance test"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_l2_network.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("zstack_l2_network.test", tfjsonpath.New("type"), knownvalue.StringExact("L2NoVlanNetwork")),
+					statecheck.ExpectKnownValue("zstack_l2_network.test", tfjsonpath.New("vswitch_type"), knownvalue.StringExact("LinuxBridge")),
+				},
+			},
+			{
+				Config: config("updated by acceptance test"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_l2_network.test", tfjsonpath.New("description"), knownvalue.StringExact("updated by acceptance test")),
+				},
+			},
+			{
+				ResourceName:                         "zstack_l2_network.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_l2_network.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
@@ -183,16 +183,25 @@ func (r *l2VlanNetworkResource) Create(ctx context.Context, req resource.CreateR
 		createParam.Params.VSwitchType = stringPtr(plan.VSwitchType.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	l2Network, err := r.client.CreateL2VlanNetwork(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating L2 VLAN Network", "Could not create L2 VLAN network, unexpected error: "+err.Error())
+		l2Network, err = adoptCreatedResource(ctx, r.client.GetL2VlanNetwork, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "L2 VLAN Network", "CreateL2VlanNetwork", err, &resp.Diagnostics)
 		return
 	}
 
 	// Save partial state so the L2 VLAN network UUID is tracked even if cluster attachment fails
 	partialState, err := r.readL2VlanNetwork(l2Network.UUID)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading L2 VLAN Network", "Could not read L2 VLAN network after create: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VLAN Network", "Could not read L2 VLAN network after create", "GetL2VlanNetwork", err))
 		return
 	}
 	diags = resp.State.Set(ctx, &partialState)
@@ -205,10 +214,12 @@ func (r *l2VlanNetworkResource) Create(ctx context.Context, req resource.CreateR
 	desiredClusters := listToStringSlice(plan.AttachedClusterUuids)
 	for _, clusterUuid := range desiredClusters {
 		if err := r.attachCluster(l2Network.UUID, clusterUuid); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error attaching Cluster to L2 VLAN Network",
-				fmt.Sprintf("Could not attach cluster %s to L2 VLAN network: %s", clusterUuid, err.Error()),
-			)
+				fmt.Sprintf("Could not attach cluster %s to L2 VLAN network", clusterUuid),
+				"AttachL2NetworkToCluster",
+				err,
+			))
 			return
 		}
 	}
@@ -216,7 +227,7 @@ func (r *l2VlanNetworkResource) Create(ctx context.Context, req resource.CreateR
 	// Read back the created resource
 	state, err := r.readL2VlanNetwork(l2Network.UUID)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading L2 VLAN Network", "Could not read L2 VLAN network after create: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VLAN Network", "Could not read L2 VLAN network after create", "GetL2VlanNetwork", err))
 		return
 	}
 
@@ -239,9 +250,10 @@ func (r *l2VlanNetworkResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading L2 VLAN Network", "Could not read L2 VLAN network, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VLAN Network", "Could not read L2 VLAN network", "GetL2VlanNetwork", err))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
@@ -273,21 +285,21 @@ func (r *l2VlanNetworkResource) Update(ctx context.Context, req resource.UpdateR
 		}
 
 		if _, err := r.client.UpdateL2Network(uuid, updateParam); err != nil {
-			resp.Diagnostics.AddError("Error updating L2 VLAN Network", "Could not update L2 VLAN network, unexpected error: "+err.Error())
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating L2 VLAN Network", "Could not update L2 VLAN network", "UpdateL2Network", err))
 			return
 		}
 	}
 
 	// Reconcile cluster attachments
 	if err := r.reconcileClusterAttachments(uuid, state.AttachedClusterUuids, plan.AttachedClusterUuids); err != nil {
-		resp.Diagnostics.AddError("Error updating L2 VLAN Network Cluster Attachments", "Could not update L2 VLAN network cluster attachments, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating L2 VLAN Network Cluster Attachments", "Could not update L2 VLAN network cluster attachments", "", err))
 		return
 	}
 
 	// Read back the updated resource
 	refreshedState, err := r.readL2VlanNetwork(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading L2 VLAN Network", "Could not read L2 VLAN network after update: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VLAN Network", "Could not read L2 VLAN network after update", "GetL2VlanNetwork", err))
 		return
 	}
 
@@ -310,7 +322,7 @@ func (r *l2VlanNetworkResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	if err := r.client.DeleteL2Network(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting L2 VLAN Network", "Could not delete L2 VLAN network, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting L2 VLAN Network", "Could not delete L2 VLAN network", "DeleteL2Network", err))
 		return
 	}
 }
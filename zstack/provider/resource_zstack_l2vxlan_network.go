@@ -142,9 +142,18 @@ func (r *l2vxlanNetworkResource) Create(ctx context.Context, request resource.Cr
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	network, err := r.client.CreateL2VxlanNetwork(params)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating L2 VXLAN network", err.Error())
+		network, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryL2VxlanNetwork), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "L2 VXLAN Network", "CreateL2VxlanNetwork", err, &response.Diagnostics)
 		return
 	}
 
@@ -178,9 +187,10 @@ func (r *l2vxlanNetworkResource) Read(ctx context.Context, request resource.Read
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading L2 VXLAN network", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VXLAN network", "Could not read L2 VXLAN network", "QueryL2VxlanNetwork", err))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Name = types.StringValue(network.Name)
 	state.Description = stringValueOrNull(network.Description)
@@ -214,7 +224,7 @@ func (r *l2vxlanNetworkResource) Delete(ctx context.Context, request resource.De
 
 	err := r.client.DeleteVxlanL2Network(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting L2 VXLAN network", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting L2 VXLAN network", "Could not delete L2 VXLAN network", "DeleteVxlanL2Network", err))
 		return
 	}
 }
@@ -0,0 +1,545 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                   = &l2VxlanNetworkPoolResource{}
+	_ resource.ResourceWithConfigure      = &l2VxlanNetworkPoolResource{}
+	_ resource.ResourceWithImportState    = &l2VxlanNetworkPoolResource{}
+	_ resource.ResourceWithValidateConfig = &l2VxlanNetworkPoolResource{}
+)
+
+// maxVni is the largest VXLAN network identifier ZStack accepts.
+const maxVni = 16777214
+
+type l2VxlanNetworkPoolResource struct {
+	client *client.ZSClient
+}
+
+type l2VxlanNetworkPoolResourceModel struct {
+	Uuid              types.String `tfsdk:"uuid"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	ZoneUuid          types.String `tfsdk:"zone_uuid"`
+	PhysicalInterface types.String `tfsdk:"physical_interface"`
+	VniRanges         types.Set    `tfsdk:"vni_ranges"`
+}
+
+type vniRangeModel struct {
+	Name     types.String `tfsdk:"name"`
+	StartVni types.Int64  `tfsdk:"start_vni"`
+	EndVni   types.Int64  `tfsdk:"end_vni"`
+}
+
+var vniRangeAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"start_vni": types.Int64Type,
+	"end_vni":   types.Int64Type,
+}
+
+// vniRangeSpec is a VNI range of a VXLAN pool. Uuid is only known for ranges
+// read from the platform.
+type vniRangeSpec struct {
+	Uuid     string
+	Name     string
+	StartVni int64
+	EndVni   int64
+}
+
+func L2VxlanNetworkPoolResource() resource.Resource {
+	return &l2VxlanNetworkPoolResource{}
+}
+
+func (r *l2VxlanNetworkPoolResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *l2VxlanNetworkPoolResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_l2vxlan_network_pool"
+}
+
+func (r *l2VxlanNetworkPoolResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Manages an L2 VXLAN network pool in ZStack. A pool holds the VNI ranges that `zstack_l2vxlan_network` allocates from " +
+			"and is attached to clusters with `zstack_l2vxlan_network_pool_cluster_attachment`.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the VXLAN network pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the VXLAN network pool.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description for the VXLAN network pool.",
+			},
+			"zone_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"physical_interface": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The physical interface that carries the VXLAN traffic.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vni_ranges": schema.SetNestedAttribute{
+				Optional: true,
+				Description: "The VNI ranges of the pool. When set, the list is authoritative: ranges are created, renamed and deleted in place. " +
+					"When unset, the VNI ranges of the pool are not managed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the VNI range.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"start_vni": schema.Int64Attribute{
+							Required:    true,
+							Description: "The first VNI of the range.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, maxVni),
+							},
+						},
+						"end_vni": schema.Int64Attribute{
+							Required:    true,
+							Description: "The last VNI of the range.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, maxVni),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *l2VxlanNetworkPoolResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config l2VxlanNetworkPoolResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.VniRanges.IsNull() || config.VniRanges.IsUnknown() {
+		return
+	}
+
+	var models []vniRangeModel
+	response.Diagnostics.Append(config.VniRanges.ElementsAs(ctx, &models, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ranges := make([]vniRangeSpec, 0, len(models))
+	for _, m := range models {
+		if m.StartVni.IsUnknown() || m.EndVni.IsUnknown() {
+			return
+		}
+		ranges = append(ranges, vniRangeSpec{Name: m.Name.ValueString(), StartVni: m.StartVni.ValueInt64(), EndVni: m.EndVni.ValueInt64()})
+	}
+
+	if err := checkVniRanges(ranges); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("vni_ranges"), "Invalid VNI Ranges", err.Error())
+	}
+}
+
+func (r *l2VxlanNetworkPoolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan l2VxlanNetworkPoolResourceModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params := param.CreateL2VxlanNetworkPoolParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreateL2VxlanNetworkPoolParamDetail{
+			Name:              plan.Name.ValueString(),
+			Description:       stringPtrOrNil(plan.Description.ValueString()),
+			ZoneUuid:          plan.ZoneUuid.ValueString(),
+			PhysicalInterface: stringPtrOrNil(plan.PhysicalInterface.ValueString()),
+		},
+	}
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	pool, err := r.client.CreateL2VxlanNetworkPool(params)
+	if err != nil {
+		pool, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryL2VxlanNetworkPool), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "L2 VXLAN Network Pool", "CreateL2VxlanNetworkPool", err, &response.Diagnostics)
+		return
+	}
+
+	desired := plan.VniRanges
+	plan.Uuid = types.StringValue(pool.UUID)
+	plan.Name = types.StringValue(pool.Name)
+	plan.Description = stringValueOrNull(pool.Description)
+	plan.ZoneUuid = types.StringValue(pool.ZoneUuid)
+	plan.PhysicalInterface = stringValueOrNull(pool.PhysicalInterface)
+
+	if desired.IsNull() || desired.IsUnknown() {
+		diags = response.State.Set(ctx, &plan)
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Save partial state so the pool UUID is tracked even if creating the VNI ranges fails
+	plan.VniRanges = types.SetNull(types.ObjectType{AttrTypes: vniRangeAttrTypes})
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcileVniRanges(ctx, pool.UUID, vniRangesFromSet(ctx, desired, &response.Diagnostics), "Error creating L2 VXLAN Network Pool", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.VniRanges = r.readVniRanges(pool.UUID, "Error creating L2 VXLAN Network Pool", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *l2VxlanNetworkPoolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state l2VxlanNetworkPoolResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := findResourceByQuery(r.client.QueryL2VxlanNetworkPool, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading L2 VXLAN Network Pool", "Could not read L2 VXLAN network pool", "QueryL2VxlanNetworkPool", err))
+		return
+	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	state.Uuid = types.StringValue(pool.UUID)
+	state.Name = types.StringValue(pool.Name)
+	state.Description = stringValueOrNull(pool.Description)
+	state.ZoneUuid = types.StringValue(pool.ZoneUuid)
+	state.PhysicalInterface = stringValueOrNull(pool.PhysicalInterface)
+	if !state.VniRanges.IsNull() {
+		state.VniRanges = r.readVniRanges(pool.UUID, "Error reading L2 VXLAN Network Pool", &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *l2VxlanNetworkPoolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state l2VxlanNetworkPoolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := state.Uuid.ValueString()
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		updateParam := param.UpdateL2NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params: param.UpdateL2NetworkParamDetail{
+				Name:        plan.Name.ValueString(),
+				Description: stringPtrOrNil(plan.Description.ValueString()),
+			},
+		}
+		if _, err := r.client.UpdateL2Network(uuid, updateParam); err != nil {
+			response.Diagnostics.Append(zstackErrorDiagnostic("Error updating L2 VXLAN Network Pool", "Could not update L2 VXLAN network pool", "UpdateL2Network", err))
+			return
+		}
+	}
+
+	manageRanges := !plan.VniRanges.IsNull() && !plan.VniRanges.IsUnknown()
+	if manageRanges && !plan.VniRanges.Equal(state.VniRanges) {
+		desired := vniRangesFromSet(ctx, plan.VniRanges, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		r.reconcileVniRanges(ctx, uuid, desired, "Error updating L2 VXLAN Network Pool", &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	pool, err := findResourceByQuery(r.client.QueryL2VxlanNetworkPool, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error re-reading L2 VXLAN Network Pool after update", "Could not re-query L2 VXLAN network pool", "QueryL2VxlanNetworkPool", err))
+		return
+	}
+
+	plan.Uuid = types.StringValue(pool.UUID)
+	plan.Name = types.StringValue(pool.Name)
+	plan.Description = stringValueOrNull(pool.Description)
+	plan.ZoneUuid = types.StringValue(pool.ZoneUuid)
+	plan.PhysicalInterface = stringValueOrNull(pool.PhysicalInterface)
+	if manageRanges {
+		plan.VniRanges = r.readVniRanges(uuid, "Error re-reading L2 VXLAN Network Pool after update", &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags := response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *l2VxlanNetworkPoolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state l2VxlanNetworkPoolResourceModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteL2Network(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting L2 VXLAN Network Pool", "Could not delete L2 VXLAN network pool", "DeleteL2Network", err))
+		return
+	}
+}
+
+func (r *l2VxlanNetworkPoolResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), request, response)
+}
+
+// reconcileVniRanges deletes the VNI ranges of the pool that are not desired,
+// renames ranges whose bounds are unchanged and creates the missing ones.
+func (r *l2VxlanNetworkPoolResource) reconcileVniRanges(ctx context.Context, poolUuid string, desired []vniRangeSpec, summary string, diags *diag.Diagnostics) {
+	current, err := queryVniRanges(r.client, poolUuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not query VNI ranges of pool "+poolUuid, "QueryVniRange", err))
+		return
+	}
+
+	remove, rename, add := diffVniRanges(vniRangeSpecs(current), desired)
+	tflog.Debug(ctx, "Reconciling VNI ranges", map[string]any{
+		"pool_uuid": poolUuid,
+		"remove":    fmt.Sprint(remove),
+		"rename":    fmt.Sprint(rename),
+		"add":       fmt.Sprint(add),
+	})
+
+	for _, vniRange := range remove {
+		if err := r.client.DeleteVniRange(vniRange.Uuid, param.DeleteModePermissive); err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not delete VNI range %d-%d", vniRange.StartVni, vniRange.EndVni), "DeleteVniRange", err))
+			return
+		}
+	}
+
+	for _, vniRange := range rename {
+		_, err := r.client.UpdateVniRange(vniRange.Uuid, param.UpdateVniRangeParam{
+			BaseParam: param.BaseParam{},
+			Params: param.UpdateVniRangeParamDetail{
+				Name: vniRange.Name,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not rename VNI range %d-%d", vniRange.StartVni, vniRange.EndVni), "UpdateVniRange", err))
+			return
+		}
+	}
+
+	for _, vniRange := range add {
+		_, err := r.client.CreateVniRange(poolUuid, param.CreateVniRangeParam{
+			BaseParam: param.BaseParam{},
+			Params: param.CreateVniRangeParamDetail{
+				Name:     vniRange.Name,
+				StartVni: int(vniRange.StartVni),
+				EndVni:   int(vniRange.EndVni),
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, fmt.Sprintf("Could not create VNI range %d-%d", vniRange.StartVni, vniRange.EndVni), "CreateVniRange", err))
+			return
+		}
+	}
+}
+
+func (r *l2VxlanNetworkPoolResource) readVniRanges(poolUuid, summary string, diags *diag.Diagnostics) types.Set {
+	ranges, err := queryVniRanges(r.client, poolUuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not query VNI ranges of pool "+poolUuid, "QueryVniRange", err))
+		return types.SetNull(types.ObjectType{AttrTypes: vniRangeAttrTypes})
+	}
+	return vniRangesToSet(vniRangeSpecs(ranges), diags)
+}
+
+// queryVniRanges returns the VNI ranges of a VXLAN pool.
+func queryVniRanges(cli *client.ZSClient, poolUuid string) ([]view.VniRangeInventoryView, error) {
+	q := param.NewQueryParam()
+	q.AddQ("l2NetworkUuid=" + poolUuid)
+	return cli.QueryVniRange(&q)
+}
+
+func vniRangeSpecs(ranges []view.VniRangeInventoryView) []vniRangeSpec {
+	specs := make([]vniRangeSpec, 0, len(ranges))
+	for _, vniRange := range ranges {
+		specs = append(specs, vniRangeSpec{
+			Uuid:     vniRange.UUID,
+			Name:     vniRange.Name,
+			StartVni: int64(vniRange.StartVni),
+			EndVni:   int64(vniRange.EndVni),
+		})
+	}
+	sortVniRanges(specs)
+	return specs
+}
+
+func sortVniRanges(ranges []vniRangeSpec) {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].StartVni < ranges[j].StartVni
+	})
+}
+
+// diffVniRanges matches ranges by their bounds. Ranges only in current are
+// removed, ranges only in desired are added, and matched ranges whose name
+// differs are renamed; renamed ranges carry the UUID from current and the
+// name from desired.
+func diffVniRanges(current, desired []vniRangeSpec) (remove, rename, add []vniRangeSpec) {
+	type bounds struct{ start, end int64 }
+	currentByBounds := make(map[bounds]vniRangeSpec, len(current))
+	for _, c := range current {
+		currentByBounds[bounds{c.StartVni, c.EndVni}] = c
+	}
+	desiredBounds := make(map[bounds]bool, len(desired))
+	for _, d := range desired {
+		key := bounds{d.StartVni, d.EndVni}
+		desiredBounds[key] = true
+		c, ok := currentByBounds[key]
+		switch {
+		case !ok:
+			add = append(add, d)
+		case c.Name != d.Name:
+			c.Name = d.Name
+			rename = append(rename, c)
+		}
+	}
+	for _, c := range current {
+		if !desiredBounds[bounds{c.StartVni, c.EndVni}] {
+			remove = append(remove, c)
+		}
+	}
+	sortVniRanges(remove)
+	sortVniRanges(rename)
+	sortVniRanges(add)
+	return remove, rename, add
+}
+
+// checkVniRanges reports ranges whose start is past their end and ranges that
+// overlap each other.
+func checkVniRanges(ranges []vniRangeSpec) error {
+	sorted := append([]vniRangeSpec(nil), ranges...)
+	sortVniRanges(sorted)
+	for i, vniRange := range sorted {
+		if vniRange.StartVni > vniRange.EndVni {
+			return fmt.Errorf("VNI range %q starts at %d, after its end %d", vniRange.Name, vniRange.StartVni, vniRange.EndVni)
+		}
+		if i > 0 && vniRange.StartVni <= sorted[i-1].EndVni {
+			return fmt.Errorf("VNI ranges %q (%d-%d) and %q (%d-%d) overlap",
+				sorted[i-1].Name, sorted[i-1].StartVni, sorted[i-1].EndVni, vniRange.Name, vniRange.StartVni, vniRange.EndVni)
+		}
+	}
+	return nil
+}
+
+func vniRangesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []vniRangeSpec {
+	var models []vniRangeModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	ranges := make([]vniRangeSpec, 0, len(models))
+	for _, m := range models {
+		ranges = append(ranges, vniRangeSpec{Name: m.Name.ValueString(), StartVni: m.StartVni.ValueInt64(), EndVni: m.EndVni.ValueInt64()})
+	}
+	return ranges
+}
+
+func vniRangesToSet(ranges []vniRangeSpec, diags *diag.Diagnostics) types.Set {
+	elemType := types.ObjectType{AttrTypes: vniRangeAttrTypes}
+	elems := make([]attr.Value, 0, len(ranges))
+	for _, vniRange := range ranges {
+		obj, d := types.ObjectValue(vniRangeAttrTypes, map[string]attr.Value{
+			"name":      types.StringValue(vniRange.Name),
+			"start_vni": types.Int64Value(vniRange.StartVni),
+			"end_vni":   types.Int64Value(vniRange.EndVni),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	set, d := types.SetValue(elemType, elems)
+	diags.Append(d...)
+	return set
+}
@@ -0,0 +1,302 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                = &l2VxlanNetworkPoolClusterAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &l2VxlanNetworkPoolClusterAttachmentResource{}
+	_ resource.ResourceWithImportState = &l2VxlanNetworkPoolClusterAttachmentResource{}
+)
+
+type l2VxlanNetworkPoolClusterAttachmentResource struct {
+	client *client.ZSClient
+}
+
+type l2VxlanNetworkPoolClusterAttachmentModel struct {
+	ID          types.String `tfsdk:"id"`
+	PoolUuid    types.String `tfsdk:"pool_uuid"`
+	ClusterUuid types.String `tfsdk:"cluster_uuid"`
+	VtepCidr    types.String `tfsdk:"vtep_cidr"`
+}
+
+func L2VxlanNetworkPoolClusterAttachmentResource() resource.Resource {
+	return &l2VxlanNetworkPoolClusterAttachmentResource{}
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_l2vxlan_network_pool_cluster_attachment"
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach a ZStack L2 VXLAN network pool to a cluster. Each host of the cluster terminates VXLAN tunnels (VTEP) on its address in `vtep_cidr`. " +
+			"Destroying this resource detaches the pool from the cluster without deleting either resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `pool_uuid:cluster_uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pool_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the VXLAN network pool to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the cluster to attach the pool to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vtep_cidr": schema.StringAttribute{
+				Required:    true,
+				Description: "The CIDR holding the VTEP address of every host in the cluster, e.g. `192.168.100.0/24`. If the pool is already attached to the cluster, creation fails unless the existing attachment uses this CIDR.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan l2VxlanNetworkPoolClusterAttachmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	poolUuid := plan.PoolUuid.ValueString()
+	clusterUuid := plan.ClusterUuid.ValueString()
+	plan.ID = types.StringValue(l2NetworkClusterAttachmentID(poolUuid, clusterUuid))
+
+	pool, err := findResourceByQuery(r.client.QueryL2VxlanNetworkPool, poolUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating L2 VXLAN Network Pool Cluster Attachment",
+			"Could not query L2 VXLAN network pool "+poolUuid,
+			"QueryL2VxlanNetworkPool",
+			err,
+		))
+		return
+	}
+
+	if isL2VxlanNetworkPoolAttachedToCluster(pool, clusterUuid) {
+		// Attached outside Terraform or by an interrupted apply. The VTEP CIDR
+		// cannot be changed without detaching, so adopt the attachment only
+		// when it uses the planned CIDR.
+		if cidr := pool.AttachedCidrs[clusterUuid]; cidr != "" && !vtepCidrEqual(cidr, plan.VtepCidr.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("vtep_cidr"),
+				"Error creating L2 VXLAN Network Pool Cluster Attachment",
+				fmt.Sprintf("L2 VXLAN network pool %s is already attached to cluster %s with VTEP CIDR %s, not %s. "+
+					"Set vtep_cidr to %s, or detach the pool from the cluster first.",
+					poolUuid, clusterUuid, cidr, plan.VtepCidr.ValueString(), cidr),
+			)
+			return
+		}
+	} else {
+		_, err = r.client.AttachL2NetworkToCluster(poolUuid, clusterUuid, param.AttachL2NetworkToClusterParam{
+			BaseParam: param.BaseParam{
+				SystemTags: []string{vtepCidrSystemTag(poolUuid, clusterUuid, plan.VtepCidr.ValueString())},
+			},
+			Params: param.AttachL2NetworkToClusterParamDetail{},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating L2 VXLAN Network Pool Cluster Attachment",
+				fmt.Sprintf("Could not attach L2 VXLAN network pool %s to cluster %s", poolUuid, clusterUuid),
+				"AttachL2NetworkToCluster",
+				err,
+			))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "L2 VXLAN network pool cluster attachment created", map[string]any{
+		"id":           plan.ID.ValueString(),
+		"pool_uuid":    poolUuid,
+		"cluster_uuid": clusterUuid,
+		"vtep_cidr":    plan.VtepCidr.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state l2VxlanNetworkPoolClusterAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolUuid := state.PoolUuid.ValueString()
+	clusterUuid := state.ClusterUuid.ValueString()
+
+	pool, err := findResourceByQuery(r.client.QueryL2VxlanNetworkPool, poolUuid)
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading L2 VXLAN Network Pool Cluster Attachment",
+			"Could not query L2 VXLAN network pool "+poolUuid,
+			"QueryL2VxlanNetworkPool",
+			err,
+		))
+		return
+	}
+
+	if !isL2VxlanNetworkPoolAttachedToCluster(pool, clusterUuid) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(l2NetworkClusterAttachmentID(poolUuid, clusterUuid))
+	if cidr, ok := pool.AttachedCidrs[clusterUuid]; ok && cidr != "" {
+		state.VtepCidr = types.StringValue(cidr)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"This resource does not support updates. Any changes require replacement.",
+	)
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state l2VxlanNetworkPoolClusterAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	poolUuid := state.PoolUuid.ValueString()
+	clusterUuid := state.ClusterUuid.ValueString()
+
+	err := r.client.DetachL2NetworkFromCluster(poolUuid, clusterUuid, param.DeleteModePermissive)
+	if err != nil {
+		pool, queryErr := findResourceByQuery(r.client.QueryL2VxlanNetworkPool, poolUuid)
+		if queryErr != nil {
+			if errors.Is(queryErr, ErrResourceNotFound) {
+				return
+			}
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting L2 VXLAN Network Pool Cluster Attachment",
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"QueryL2VxlanNetworkPool",
+				queryErr,
+			))
+			return
+		}
+		if isL2VxlanNetworkPoolAttachedToCluster(pool, clusterUuid) {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting L2 VXLAN Network Pool Cluster Attachment",
+				fmt.Sprintf("Could not detach L2 VXLAN network pool %s from cluster %s", poolUuid, clusterUuid),
+				"DetachL2NetworkFromCluster",
+				err,
+			))
+			return
+		}
+	}
+}
+
+func (r *l2VxlanNetworkPoolClusterAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	poolUuid, clusterUuid, err := parseL2NetworkClusterAttachmentID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: pool_uuid:cluster_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), l2NetworkClusterAttachmentID(poolUuid, clusterUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool_uuid"), poolUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_uuid"), clusterUuid)...)
+}
+
+func isL2VxlanNetworkPoolAttachedToCluster(pool *view.L2VxlanNetworkPoolInventoryView, clusterUuid string) bool {
+	for _, attachedClusterUuid := range pool.AttachedClusterUuids {
+		if attachedClusterUuid == clusterUuid {
+			return true
+		}
+	}
+	return false
+}
+
+// vtepCidrEqual compares CIDRs by the network they denote, so
+// `192.168.100.1/24` matches `192.168.100.0/24`.
+func vtepCidrEqual(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return netA.String() == netB.String()
+}
+
+// vtepCidrSystemTag returns the system tag that tells ZStack which CIDR holds
+// the VTEP addresses of the hosts in the cluster.
+func vtepCidrSystemTag(poolUuid, clusterUuid, cidr string) string {
+	return fmt.Sprintf("l2NetworkUuid::%s::clusterUuid::%s::cidr::{%s}", poolUuid, clusterUuid, cidr)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestL2VxlanNetworkPoolClusterAttachmentResource_Schema(t *testing.T) {
+	var r l2VxlanNetworkPoolClusterAttachmentResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"pool_uuid", "cluster_uuid", "vtep_cidr"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	id, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Fatal("schema missing computed attribute \"id\"")
+	}
+	if !id.IsComputed() {
+		t.Error("attribute \"id\" should be computed")
+	}
+}
+
+func TestL2VxlanNetworkPoolClusterAttachmentResource_Metadata(t *testing.T) {
+	var r l2VxlanNetworkPoolClusterAttachmentResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_l2vxlan_network_pool_cluster_attachment" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestVtepCidrSystemTag(t *testing.T) {
+	got := vtepCidrSystemTag("pool-uuid", "cluster-uuid", "192.168.100.0/24")
+	want := "l2NetworkUuid::pool-uuid::clusterUuid::cluster-uuid::cidr::{192.168.100.0/24}"
+	if got != want {
+		t.Errorf("vtepCidrSystemTag() = %q, want %q", got, want)
+	}
+}
+
+func TestVtepCidrEqual(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"192.168.100.0/24", "192.168.100.0/24", true},
+		{"192.168.100.1/24", "192.168.100.0/24", true},
+		{"192.168.100.0/24", "192.168.101.0/24", false},
+		{"192.168.100.0/24", "192.168.100.0/16", false},
+	}
+	for _, tc := range cases {
+		if got := vtepCidrEqual(tc.a, tc.b); got != tc.want {
+			t.Errorf("vtepCidrEqual(%q, %q) = %t, want %t", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
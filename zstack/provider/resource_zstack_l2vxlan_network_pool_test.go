@@ -0,0 +1,200 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestL2VxlanNetworkPoolResource_Schema(t *testing.T) {
+	var r l2VxlanNetworkPoolResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"name", "zone_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"uuid", "physical_interface"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+
+	if a, ok := resp.Schema.Attributes["vni_ranges"]; !ok || !a.IsOptional() {
+		t.Error("attribute \"vni_ranges\" should be optional")
+	}
+}
+
+func TestL2VxlanNetworkPoolResource_Metadata(t *testing.T) {
+	var r l2VxlanNetworkPoolResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_l2vxlan_network_pool" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestDiffVniRanges(t *testing.T) {
+	current := []vniRangeSpec{
+		{Uuid: "r1", Name: "tenant-a", StartVni: 100, EndVni: 199},
+		{Uuid: "r2", Name: "tenant-b", StartVni: 200, EndVni: 299},
+		{Uuid: "r3", Name: "legacy", StartVni: 1000, EndVni: 1999},
+	}
+	desired := []vniRangeSpec{
+		{Name: "tenant-a", StartVni: 100, EndVni: 199},
+		{Name: "tenant-b-renamed", StartVni: 200, EndVni: 299},
+		{Name: "tenant-c", StartVni: 300, EndVni: 399},
+	}
+
+	remove, rename, add := diffVniRanges(current, desired)
+
+	if want := []vniRangeSpec{current[2]}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("remove = %v, want %v", remove, want)
+	}
+	if want := []vniRangeSpec{{Uuid: "r2", Name: "tenant-b-renamed", StartVni: 200, EndVni: 299}}; !reflect.DeepEqual(rename, want) {
+		t.Errorf("rename = %v, want %v", rename, want)
+	}
+	if want := []vniRangeSpec{desired[2]}; !reflect.DeepEqual(add, want) {
+		t.Errorf("add = %v, want %v", add, want)
+	}
+
+	remove, rename, add = diffVniRanges(current, current)
+	if len(remove)+len(rename)+len(add) != 0 {
+		t.Errorf("expected no changes, got remove=%v rename=%v add=%v", remove, rename, add)
+	}
+}
+
+func TestCheckVniRanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		ranges      []vniRangeSpec
+		expectError bool
+	}{
+		{
+			name: "disjoint",
+			ranges: []vniRangeSpec{
+				{Name: "b", StartVni: 200, EndVni: 299},
+				{Name: "a", StartVni: 100, EndVni: 199},
+			},
+		},
+		{
+			name:   "single vni",
+			ranges: []vniRangeSpec{{Name: "a", StartVni: 100, EndVni: 100}},
+		},
+		{
+			name:        "start after end",
+			ranges:      []vniRangeSpec{{Name: "a", StartVni: 200, EndVni: 100}},
+			expectError: true,
+		},
+		{
+			name: "overlap",
+			ranges: []vniRangeSpec{
+				{Name: "a", StartVni: 100, EndVni: 200},
+				{Name: "b", StartVni: 200, EndVni: 299},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVniRanges(tt.ranges)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("checkVniRanges() error = %v, expectError %v", err, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestAccL2VxlanNetworkPoolResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	clusterUuid, zoneUuid := testAccLiveCluster(t)
+	name := testAccName("vxlan-pool")
+
+	config := func(description, rangeName string) string {
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_l2vxlan_network_pool" "test" {
+  name        = %q
+  description = %q
+  zone_uuid   = %q
+
+  vni_ranges = [
+    {
+      name      = %q
+      start_vni = 15000
+      end_vni   = 15099
+    },
+  ]
+}
+
+resource "zstack_l2vxlan_network_pool_cluster_attachment" "test" {
+  pool_uuid    = zstack_l2vxlan_network_pool.test.uuid
+  cluster_uuid = %q
+  vtep_cidr    = "192.168.100.0/24"
+}
+`, name, description, zoneUuid, rangeName, clusterUuid)
+	}
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckL2VxlanNetworkPoolDestroy,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config("created by acceptance test", "acc-range"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool.test", tfjsonpath.New("name"), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool.test", tfjsonpath.New("vni_ranges"), knownvalue.SetSizeExact(1)),
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool_cluster_attachment.test", tfjsonpath.New("vtep_cidr"), knownvalue.StringExact("192.168.100.0/24")),
+				},
+			},
+			{
+				Config: config("updated by acceptance test", "acc-range-renamed"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool.test", tfjsonpath.New("description"), knownvalue.StringExact("updated by acceptance test")),
+					statecheck.ExpectKnownValue("zstack_l2vxlan_network_pool.test", tfjsonpath.New("vni_ranges"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":      knownvalue.StringExact("acc-range-renamed"),
+							"start_vni": knownvalue.Int64Exact(15000),
+							"end_vni":   knownvalue.Int64Exact(15099),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:                         "zstack_l2vxlan_network_pool.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_l2vxlan_network_pool.test"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"vni_ranges"},
+			},
+		},
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
 )
 
 var (
@@ -28,22 +31,30 @@ var (
 	_ resource.ResourceWithImportState = &l3networkResource{}
 )
 
+// l3NetworkIpVersions are the IP versions of an L3 network: IPv4, IPv6 and
+// dual-stack.
+var l3NetworkIpVersions = []int64{4, 6, 46}
+
 type l3networkResource struct {
 	client *client.ZSClient
 }
 
 type l3networkResourceModel struct {
-	Uuid          types.String `tfsdk:"uuid"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	L2NetworkUuid types.String `tfsdk:"l2_network_uuid"`
-	Type          types.String `tfsdk:"type"`
-	Category      types.String `tfsdk:"category"`
-	System        types.Bool   `tfsdk:"system"`
-	DnsDomain     types.String `tfsdk:"dns_domain"`
-	IpVersion     types.Int64  `tfsdk:"ip_version"`
-	State         types.String `tfsdk:"state"`
-	ZoneUuid      types.String `tfsdk:"zone_uuid"`
+	Uuid            types.String `tfsdk:"uuid"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	L2NetworkUuid   types.String `tfsdk:"l2_network_uuid"`
+	Type            types.String `tfsdk:"type"`
+	Category        types.String `tfsdk:"category"`
+	System          types.Bool   `tfsdk:"system"`
+	DnsDomain       types.String `tfsdk:"dns_domain"`
+	IpVersion       types.Int64  `tfsdk:"ip_version"`
+	State           types.String `tfsdk:"state"`
+	ZoneUuid        types.String `tfsdk:"zone_uuid"`
+	NetworkServices types.Set    `tfsdk:"network_services"`
+	DnsServers      types.List   `tfsdk:"dns_servers"`
+	HostRoutes      types.Set    `tfsdk:"host_routes"`
+	Mtu             types.Int64  `tfsdk:"mtu"`
 }
 
 func L3NetworkResource() resource.Resource {
@@ -141,9 +152,9 @@ func (r *l3networkResource) Schema(_ context.Context, request resource.SchemaReq
 			"ip_version": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "The IP version for the L3 network (4 for IPv4, 6 for IPv6).",
+				Description: "The IP version for the L3 network (4 for IPv4, 6 for IPv6, 46 for dual-stack). A dual-stack L3 network carries both an IPv4 and an IPv6 `zstack_subnet_ip_range`.",
 				Validators: []validator.Int64{
-					int64validator.OneOf(4, 6),
+					int64validator.OneOf(l3NetworkIpVersions...),
 				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
@@ -164,6 +175,74 @@ func (r *l3networkResource) Schema(_ context.Context, request resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"network_services": schema.SetNestedAttribute{
+				Optional: true,
+				Description: "The network services enabled on the L3 network, each with the type of its provider. " +
+					"When set, the list is authoritative: services not listed are detached and changes are applied in place. " +
+					"When unset, the services of the L3 network are not managed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The network service type, e.g. `DHCP`, `DNS`, `SNAT`, `Eip`, `PortForwarding`, `LoadBalancer` or `SecurityGroup`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(l3NetworkServiceTypes...),
+							},
+						},
+						"provider": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the network service provider, e.g. `Flat`, `vrouter`, `VirtualRouter` or `SecurityGroup`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The DNS servers handed to guests on the L3 network, in order of preference. " +
+					"When set, the list is authoritative and changes are applied in place. When unset, the DNS servers are not managed.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(ipAddressValidator{}),
+				},
+			},
+			"host_routes": schema.SetNestedAttribute{
+				Optional: true,
+				Description: "The static routes pushed to guests through DHCP option 121. " +
+					"When set, the list is authoritative and changes are applied in place. When unset, the host routes are not managed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Required:    true,
+							Description: "The destination CIDR of the route, e.g. `169.254.169.254/32`.",
+							Validators: []validator.String{
+								cidrValidator{},
+							},
+						},
+						"nexthop": schema.StringAttribute{
+							Required:    true,
+							Description: "The next hop IP address of the route.",
+							Validators: []validator.String{
+								ipAddressValidator{},
+							},
+						},
+					},
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The MTU of the L3 network. Defaults to the MTU of the underlying L2 network.",
+				Validators: []validator.Int64{
+					int64validator.Between(68, 9216),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -205,12 +284,18 @@ func (r *l3networkResource) Create(ctx context.Context, request resource.CreateR
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateL3Network(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating L3 Network",
-			"Could not create L3 network, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryL3Network), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "L3 Network", "CreateL3Network", err, &response.Diagnostics)
 		return
 	}
 
@@ -228,12 +313,45 @@ func (r *l3networkResource) Create(ctx context.Context, request resource.CreateR
 		plan.IpVersion = types.Int64Value(int64(result.IpVersion))
 	}
 
+	// Save partial state so the L3 network UUID is tracked even if configuring the network fails
+	desired := plan
+	plan.NetworkServices = types.SetNull(types.ObjectType{AttrTypes: l3NetworkServiceAttrTypes})
+	plan.DnsServers = types.ListNull(types.StringType)
+	plan.HostRoutes = types.SetNull(types.ObjectType{AttrTypes: l3NetworkHostRouteAttrTypes})
+	plan.Mtu = types.Int64Null()
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	r.applyNetworkConfig(ctx, result.UUID, desired, l3networkResourceModel{}, "Error configuring L3 Network", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Re-query so the state reflects the services, DNS servers and routes the platform reports.
+	item, err := findResourceByQuery(r.client.QueryL3Network, result.UUID)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error re-reading L3 Network after create",
+			"Could not re-query L3 network",
+			"QueryL3Network",
+			err,
+		))
+		return
+	}
+
+	plan.NetworkServices = desired.NetworkServices
+	plan.DnsServers = desired.DnsServers
+	plan.HostRoutes = desired.HostRoutes
+	r.readNetworkConfig(ctx, item, &plan, "Error reading L3 Network", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
 }
 
 func (r *l3networkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -250,12 +368,15 @@ func (r *l3networkResource) Read(ctx context.Context, request resource.ReadReque
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading L3 Network",
-			"Could not read L3 Network, unexpected error: "+err.Error(),
-		)
+			"Could not read L3 Network",
+			"QueryL3Network",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -270,6 +391,10 @@ func (r *l3networkResource) Read(ctx context.Context, request resource.ReadReque
 	if item.IpVersion > 0 {
 		state.IpVersion = types.Int64Value(int64(item.IpVersion))
 	}
+	r.readNetworkConfig(ctx, item, &state, "Error reading L3 Network", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	diags = response.State.Set(ctx, &state)
 	response.Diagnostics.Append(diags...)
@@ -317,20 +442,29 @@ func (r *l3networkResource) Update(ctx context.Context, request resource.UpdateR
 	}
 
 	if _, err := r.client.UpdateL3Network(state.Uuid.ValueString(), p); err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating L3 Network",
-			"Could not update L3 network, unexpected error: "+err.Error(),
-		)
+			"Could not update L3 network",
+			"UpdateL3Network",
+			err,
+		))
+		return
+	}
+
+	r.applyNetworkConfig(ctx, state.Uuid.ValueString(), plan, state, "Error updating L3 Network", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
 	// Re-query by UUID after Update to refresh state with the latest server-side values.
 	result, err := findResourceByQuery(r.client.QueryL3Network, state.Uuid.ValueString())
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error re-reading L3 Network after update",
-			"Could not re-query L3 network, unexpected error: "+err.Error(),
-		)
+			"Could not re-query L3 network",
+			"QueryL3Network",
+			err,
+		))
 		return
 	}
 
@@ -347,6 +481,10 @@ func (r *l3networkResource) Update(ctx context.Context, request resource.UpdateR
 	if result.IpVersion > 0 {
 		plan.IpVersion = types.Int64Value(int64(result.IpVersion))
 	}
+	r.readNetworkConfig(ctx, result, &plan, "Error re-reading L3 Network after update", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -364,11 +502,10 @@ func (r *l3networkResource) Delete(ctx context.Context, request resource.DeleteR
 		return
 	}
 
-
 	err := r.client.DeleteL3Network(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting L3 Network", "Could not delete L3 network, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting L3 Network", "Could not delete L3 network", "DeleteL3Network", err))
 		return
 	}
 
@@ -377,3 +514,86 @@ func (r *l3networkResource) Delete(ctx context.Context, request resource.DeleteR
 func (r *l3networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
+
+// applyNetworkConfig reconciles the network services, DNS servers, host routes
+// and MTU of the L3 network with the planned values. Attributes that are not
+// set, or that did not change from the prior state, are left alone.
+func (r *l3networkResource) applyNetworkConfig(ctx context.Context, uuid string, plan, prior l3networkResourceModel, summary string, diags *diag.Diagnostics) {
+	if !plan.NetworkServices.IsNull() && !plan.NetworkServices.IsUnknown() && !plan.NetworkServices.Equal(prior.NetworkServices) {
+		desired := l3NetworkServicesFromSet(ctx, plan.NetworkServices, diags)
+		if diags.HasError() {
+			return
+		}
+		reconcileL3NetworkServices(ctx, r.client, uuid, desired, summary, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	manageDns := !plan.DnsServers.IsNull() && !plan.DnsServers.IsUnknown() && !plan.DnsServers.Equal(prior.DnsServers)
+	manageRoutes := !plan.HostRoutes.IsNull() && !plan.HostRoutes.IsUnknown() && !plan.HostRoutes.Equal(prior.HostRoutes)
+	if manageDns || manageRoutes {
+		l3, err := findResourceByQuery(r.client.QueryL3Network, uuid)
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, "Could not read L3 network "+uuid, "QueryL3Network", err))
+			return
+		}
+
+		if manageDns {
+			var desired []string
+			diags.Append(plan.DnsServers.ElementsAs(ctx, &desired, false)...)
+			if diags.HasError() {
+				return
+			}
+			reconcileL3NetworkDns(ctx, r.client, l3, desired, summary, diags)
+			if diags.HasError() {
+				return
+			}
+		}
+
+		if manageRoutes {
+			desired := l3NetworkHostRoutesFromSet(ctx, plan.HostRoutes, diags)
+			if diags.HasError() {
+				return
+			}
+			reconcileL3NetworkHostRoutes(ctx, r.client, l3, desired, summary, diags)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	if !plan.Mtu.IsNull() && !plan.Mtu.IsUnknown() && !plan.Mtu.Equal(prior.Mtu) {
+		setL3NetworkMtu(r.client, uuid, plan.Mtu.ValueInt64(), summary, diags)
+	}
+}
+
+// readNetworkConfig copies the network services, DNS servers and host routes
+// of the L3 network into model when they are managed, and always refreshes the MTU.
+func (r *l3networkResource) readNetworkConfig(ctx context.Context, l3 *view.L3NetworkInventoryView, model *l3networkResourceModel, summary string, diags *diag.Diagnostics) {
+	if !model.NetworkServices.IsNull() {
+		_, providerTypes, err := queryNetworkServiceProviders(r.client)
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(summary, "Could not query network service providers", "QueryNetworkServiceProvider", err))
+			return
+		}
+		model.NetworkServices = l3NetworkServicesToSet(l3NetworkServiceSpecs(l3, providerTypes), diags)
+	}
+
+	if !model.DnsServers.IsNull() {
+		dnsServers, d := types.ListValueFrom(ctx, types.StringType, l3.Dns)
+		diags.Append(d...)
+		model.DnsServers = dnsServers
+	}
+
+	if !model.HostRoutes.IsNull() {
+		model.HostRoutes = l3NetworkHostRoutesToSet(l3NetworkHostRouteSpecs(l3), diags)
+	}
+
+	mtu, err := getL3NetworkMtu(r.client, l3.UUID)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not read the MTU of L3 network "+l3.UUID, "GetL3NetworkMtu", err))
+		return
+	}
+	model.Mtu = types.Int64Value(mtu)
+}
@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,7 +35,13 @@ func TestL3networkResource_Schema(t *testing.T) {
 		}
 	}
 
-	computed := []string{"uuid", "state", "zone_uuid"}
+	for _, attr := range []string{"network_services", "dns_servers", "host_routes", "mtu"} {
+		if a := resp.Schema.Attributes[attr]; a == nil || !a.IsOptional() {
+			t.Errorf("attribute %q should be optional", attr)
+		}
+	}
+
+	computed := []string{"uuid", "state", "zone_uuid", "mtu"}
 	for _, attr := range computed {
 		a, ok := resp.Schema.Attributes[attr]
 		if !ok {
@@ -46,6 +53,145 @@ func TestL3networkResource_Schema(t *testing.T) {
 	}
 }
 
+func TestDiffL3NetworkServices(t *testing.T) {
+	current := []l3NetworkServiceSpec{
+		{Type: "DHCP", Provider: "Flat"},
+		{Type: "Eip", Provider: "Flat"},
+		{Type: "SecurityGroup", Provider: "SecurityGroup"},
+	}
+	desired := []l3NetworkServiceSpec{
+		{Type: "DHCP", Provider: "Flat"},
+		{Type: "Eip", Provider: "vrouter"},
+		{Type: "SNAT", Provider: "vrouter"},
+	}
+
+	attach, detach := diffL3NetworkServices(current, desired)
+
+	wantAttach := []l3NetworkServiceSpec{
+		{Type: "Eip", Provider: "vrouter"},
+		{Type: "SNAT", Provider: "vrouter"},
+	}
+	wantDetach := []l3NetworkServiceSpec{
+		{Type: "Eip", Provider: "Flat"},
+		{Type: "SecurityGroup", Provider: "SecurityGroup"},
+	}
+	if !reflect.DeepEqual(attach, wantAttach) {
+		t.Errorf("attach = %v, want %v", attach, wantAttach)
+	}
+	if !reflect.DeepEqual(detach, wantDetach) {
+		t.Errorf("detach = %v, want %v", detach, wantDetach)
+	}
+
+	attach, detach = diffL3NetworkServices(desired, desired)
+	if len(attach) != 0 || len(detach) != 0 {
+		t.Errorf("expected no changes, got attach=%v detach=%v", attach, detach)
+	}
+}
+
+func TestGroupL3NetworkServices(t *testing.T) {
+	providers := map[string]string{"Flat": "flat-uuid", "SecurityGroup": "sg-uuid"}
+
+	got, err := groupL3NetworkServices([]l3NetworkServiceSpec{
+		{Type: "DHCP", Provider: "Flat"},
+		{Type: "Eip", Provider: "Flat"},
+		{Type: "SecurityGroup", Provider: "SecurityGroup"},
+	}, providers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"flat-uuid": []string{"DHCP", "Eip"},
+		"sg-uuid":   []string{"SecurityGroup"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := groupL3NetworkServices([]l3NetworkServiceSpec{{Type: "SNAT", Provider: "vrouter"}}, providers); err == nil {
+		t.Error("expected error for unknown provider")
+	}
+}
+
+func TestDiffDnsServers(t *testing.T) {
+	tests := []struct {
+		name       string
+		current    []string
+		desired    []string
+		wantRemove []string
+		wantAdd    []string
+	}{
+		{
+			name:    "add to empty",
+			desired: []string{"8.8.8.8", "1.1.1.1"},
+			wantAdd: []string{"8.8.8.8", "1.1.1.1"},
+		},
+		{
+			name:    "append",
+			current: []string{"8.8.8.8"},
+			desired: []string{"8.8.8.8", "1.1.1.1"},
+			wantAdd: []string{"1.1.1.1"},
+		},
+		{
+			name:       "reorder",
+			current:    []string{"8.8.8.8", "1.1.1.1"},
+			desired:    []string{"1.1.1.1", "8.8.8.8"},
+			wantRemove: []string{"8.8.8.8", "1.1.1.1"},
+			wantAdd:    []string{"1.1.1.1", "8.8.8.8"},
+		},
+		{
+			name:       "remove all",
+			current:    []string{"8.8.8.8"},
+			desired:    []string{},
+			wantRemove: []string{"8.8.8.8"},
+		},
+		{
+			name:    "unchanged",
+			current: []string{"8.8.8.8", "1.1.1.1"},
+			desired: []string{"8.8.8.8", "1.1.1.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remove, add := diffDnsServers(tt.current, tt.desired)
+			if len(remove) != len(tt.wantRemove) || (len(remove) > 0 && !reflect.DeepEqual(remove, tt.wantRemove)) {
+				t.Errorf("remove = %v, want %v", remove, tt.wantRemove)
+			}
+			if len(add) != len(tt.wantAdd) || (len(add) > 0 && !reflect.DeepEqual(add, tt.wantAdd)) {
+				t.Errorf("add = %v, want %v", add, tt.wantAdd)
+			}
+		})
+	}
+}
+
+func TestDiffL3NetworkHostRoutes(t *testing.T) {
+	current := []l3NetworkHostRouteSpec{
+		{Prefix: "169.254.169.254/32", Nexthop: "192.168.1.1"},
+		{Prefix: "10.0.0.0/8", Nexthop: "192.168.1.254"},
+	}
+	desired := []l3NetworkHostRouteSpec{
+		{Prefix: "169.254.169.254/32", Nexthop: "192.168.1.2"},
+		{Prefix: "172.16.0.0/12", Nexthop: "192.168.1.254"},
+	}
+
+	remove, add := diffL3NetworkHostRoutes(current, desired)
+
+	wantRemove := []l3NetworkHostRouteSpec{
+		{Prefix: "10.0.0.0/8", Nexthop: "192.168.1.254"},
+		{Prefix: "169.254.169.254/32", Nexthop: "192.168.1.1"},
+	}
+	wantAdd := []l3NetworkHostRouteSpec{
+		{Prefix: "169.254.169.254/32", Nexthop: "192.168.1.2"},
+		{Prefix: "172.16.0.0/12", Nexthop: "192.168.1.254"},
+	}
+	if !reflect.DeepEqual(remove, wantRemove) {
+		t.Errorf("remove = %v, want %v", remove, wantRemove)
+	}
+	if !reflect.DeepEqual(add, wantAdd) {
+		t.Errorf("add = %v, want %v", add, wantAdd)
+	}
+}
+
 func TestL3networkResource_Metadata(t *testing.T) {
 	var r l3networkResource
 	resp := &resource.MetadataResponse{}
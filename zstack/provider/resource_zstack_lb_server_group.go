@@ -128,12 +128,18 @@ func (r *lbServerGroupResource) Create(ctx context.Context, request resource.Cre
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	lbServerGroup, err := r.client.CreateLoadBalancerServerGroup(plan.LoadBalancerUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Load Balancer Server Group",
-			"Could not create load balancer server group, unexpected error: "+err.Error(),
-		)
+		lbServerGroup, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryLoadBalancerServerGroup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Load Balancer Server Group", "CreateLoadBalancerServerGroup", err, &response.Diagnostics)
 		return
 	}
 
@@ -164,12 +170,15 @@ func (r *lbServerGroupResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Load Balancer Server Group",
-			"Could not read Load Balancer Server Group, unexpected error: "+err.Error(),
-		)
+			"Could not read Load Balancer Server Group",
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(lbServerGroup.UUID)
 	state.Name = types.StringValue(lbServerGroup.Name)
@@ -205,10 +214,12 @@ func (r *lbServerGroupResource) Update(ctx context.Context, request resource.Upd
 
 	lbServerGroup, err := r.client.UpdateLoadBalancerServerGroup(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Load Balancer Server Group",
-			"Could not update load balancer server group, unexpected error: "+err.Error(),
-		)
+			"Could not update load balancer server group",
+			"UpdateLoadBalancerServerGroup",
+			err,
+		))
 		return
 	}
 
@@ -237,7 +248,7 @@ func (r *lbServerGroupResource) Delete(ctx context.Context, request resource.Del
 	err := r.client.DeleteLoadBalancerServerGroup(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Load Balancer Server Group", "Could not delete load balancer server group, unexpected error: "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Load Balancer Server Group", "Could not delete load balancer server group", "DeleteLoadBalancerServerGroup", err))
 		return
 	}
 }
@@ -0,0 +1,575 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &lbServerGroupBackendResource{}
+	_ resource.ResourceWithConfigure   = &lbServerGroupBackendResource{}
+	_ resource.ResourceWithImportState = &lbServerGroupBackendResource{}
+)
+
+type lbServerGroupBackendResource struct {
+	client *client.ZSClient
+}
+
+type lbServerGroupBackendModel struct {
+	ID              types.String `tfsdk:"id"`
+	ServerGroupUuid types.String `tfsdk:"server_group_uuid"`
+	VmNicUuid       types.String `tfsdk:"vm_nic_uuid"`
+	IpAddress       types.String `tfsdk:"ip_address"`
+	Weight          types.Int64  `tfsdk:"weight"`
+	Port            types.Int64  `tfsdk:"port"`
+	IpVersion       types.Int64  `tfsdk:"ip_version"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// lbServerGroupBackend is a VM NIC or server IP member of a load balancer
+// server group, as listed in the server group inventory.
+type lbServerGroupBackend struct {
+	VmNicUuid string
+	IpAddress string
+	Weight    int64
+	// Port is 0 when the backend uses the instance port of the listener.
+	Port      int64
+	IpVersion int64
+	Status    string
+}
+
+func LBServerGroupBackendResource() resource.Resource {
+	return &lbServerGroupBackendResource{}
+}
+
+func (r *lbServerGroupBackendResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *lbServerGroupBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lb_server_group_backend"
+}
+
+func (r *lbServerGroupBackendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Add a VM NIC or a server IP address as a backend of a ZStack load balancer server group. " +
+			"Weight and port are changed in place, removing `port` re-adds the backend so it uses the instance port of the listener again; " +
+			"destroying this resource removes the backend from the server group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `server_group_uuid:vm_nic_uuid` or `server_group_uuid:ip_address`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_group_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the load balancer server group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_nic_uuid": schema.StringAttribute{
+				Optional:    true,
+				Description: "The UUID of the VM NIC to add as a backend. Exactly one of `vm_nic_uuid` and `ip_address` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("vm_nic_uuid"), path.MatchRoot("ip_address")),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "The IP address of a server outside ZStack to add as a backend. Exactly one of `vm_nic_uuid` and `ip_address` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The weight of the backend for weighted balancing algorithms, from 1 to 100. Defaults to the ZStack default of 100.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Description: "The port the backend listens on. If not set, the instance port of the listener is used. " +
+					"Removing it from the configuration replaces the backend, since ZStack cannot clear the port of an existing backend.",
+				PlanModifiers: []planmodifier.Int64{
+					lbServerGroupBackendPortModifier{},
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"ip_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The IP version of the backend (4 or 6).",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the backend reported by ZStack, e.g. `Active` or `Inactive`.",
+			},
+		},
+	}
+}
+
+func (r *lbServerGroupBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lbServerGroupBackendModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	serverGroupUuid := plan.ServerGroupUuid.ValueString()
+	member := lbServerGroupBackendMember(plan)
+	plan.ID = types.StringValue(lbServerGroupBackendID(serverGroupUuid, member))
+
+	unlock, err := lockParents(ctx, serverGroupUuid)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Load Balancer Server Group Backend", "Could not lock server group "+serverGroupUuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	existing, err := r.findBackend(serverGroupUuid, plan.VmNicUuid.ValueString(), plan.IpAddress.ValueString())
+	if err != nil && !errors.Is(err, ErrResourceNotFound) {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating Load Balancer Server Group Backend",
+			"Could not query load balancer server group "+serverGroupUuid,
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
+		return
+	}
+
+	if existing != nil && plan.Port.IsUnknown() && existing.Port != 0 {
+		// The backend exists with a port that is not configured; only adding
+		// it again clears the port.
+		err = r.removeBackend(serverGroupUuid, plan)
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating Load Balancer Server Group Backend",
+				fmt.Sprintf("Could not remove backend %s from server group %s to clear its port", member, serverGroupUuid),
+				"RemoveBackendServerFromServerGroup",
+				err,
+			))
+			return
+		}
+		existing = nil
+	}
+
+	if existing == nil {
+		_, err = r.client.AddBackendServerToServerGroup(serverGroupUuid, param.AddBackendServerToServerGroupParam{
+			BaseParam: param.BaseParam{},
+			Params:    lbServerGroupBackendAddParams(plan),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating Load Balancer Server Group Backend",
+				fmt.Sprintf("Could not add backend %s to server group %s", member, serverGroupUuid),
+				"AddBackendServerToServerGroup",
+				err,
+			))
+			return
+		}
+	} else if (!plan.Weight.IsUnknown() && plan.Weight.ValueInt64() != existing.Weight) || (!plan.Port.IsUnknown() && plan.Port.ValueInt64() != existing.Port) {
+		// The backend was added outside Terraform or by an interrupted
+		// apply; bring it to the planned weight and port.
+		err = r.changeBackend(serverGroupUuid, plan)
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating Load Balancer Server Group Backend",
+				fmt.Sprintf("Could not change backend %s of server group %s", member, serverGroupUuid),
+				"ChangeLoadBalancerBackendServer",
+				err,
+			))
+			return
+		}
+	}
+
+	backend, err := r.findBackend(serverGroupUuid, plan.VmNicUuid.ValueString(), plan.IpAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating Load Balancer Server Group Backend",
+			fmt.Sprintf("Could not read backend %s of server group %s after adding it", member, serverGroupUuid),
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
+		return
+	}
+	applyLBServerGroupBackend(&plan, backend)
+
+	tflog.Info(ctx, "Load balancer server group backend created", map[string]any{
+		"id":                plan.ID.ValueString(),
+		"server_group_uuid": serverGroupUuid,
+		"backend":           member,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *lbServerGroupBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lbServerGroupBackendModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, err := r.findBackend(state.ServerGroupUuid.ValueString(), state.VmNicUuid.ValueString(), state.IpAddress.ValueString())
+	if err != nil {
+		if isZStackNotFoundError(err) || errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Load Balancer Server Group Backend",
+			"Could not query load balancer server group "+state.ServerGroupUuid.ValueString(),
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
+		return
+	}
+
+	state.ID = types.StringValue(lbServerGroupBackendID(state.ServerGroupUuid.ValueString(), lbServerGroupBackendMember(state)))
+	applyLBServerGroupBackend(&state, backend)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *lbServerGroupBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lbServerGroupBackendModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	serverGroupUuid := plan.ServerGroupUuid.ValueString()
+	member := lbServerGroupBackendMember(plan)
+
+	unlock, err := lockParents(ctx, serverGroupUuid)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Load Balancer Server Group Backend", "Could not lock server group "+serverGroupUuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.changeBackend(serverGroupUuid, plan)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating Load Balancer Server Group Backend",
+			fmt.Sprintf("Could not change backend %s of server group %s", member, serverGroupUuid),
+			"ChangeLoadBalancerBackendServer",
+			err,
+		))
+		return
+	}
+
+	backend, err := r.findBackend(serverGroupUuid, plan.VmNicUuid.ValueString(), plan.IpAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating Load Balancer Server Group Backend",
+			fmt.Sprintf("Could not read backend %s of server group %s after changing it", member, serverGroupUuid),
+			"QueryLoadBalancerServerGroup",
+			err,
+		))
+		return
+	}
+	applyLBServerGroupBackend(&plan, backend)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *lbServerGroupBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lbServerGroupBackendModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	serverGroupUuid := state.ServerGroupUuid.ValueString()
+	member := lbServerGroupBackendMember(state)
+
+	tflog.Debug(ctx, "Deleting load balancer server group backend", map[string]any{
+		"id":                state.ID.ValueString(),
+		"server_group_uuid": serverGroupUuid,
+		"backend":           member,
+	})
+
+	unlock, err := lockParents(ctx, serverGroupUuid)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting Load Balancer Server Group Backend", "Could not lock server group "+serverGroupUuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.removeBackend(serverGroupUuid, state)
+	if err != nil {
+		_, queryErr := r.findBackend(serverGroupUuid, state.VmNicUuid.ValueString(), state.IpAddress.ValueString())
+		if queryErr != nil {
+			if isZStackNotFoundError(queryErr) || errors.Is(queryErr, ErrResourceNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Load Balancer Server Group Backend",
+				fmt.Sprintf("Remove failed (%s) and could not verify backend status", err.Error()),
+				"QueryLoadBalancerServerGroup",
+				queryErr,
+			))
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Load Balancer Server Group Backend",
+			fmt.Sprintf("Could not remove backend %s from server group %s", member, serverGroupUuid),
+			"RemoveBackendServerFromServerGroup",
+			err,
+		))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *lbServerGroupBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverGroupUuid, member, err := parseLBServerGroupBackendID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: server_group_uuid:vm_nic_uuid or server_group_uuid:ip_address (e.g. abc123:def456 or abc123:192.168.1.10).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), lbServerGroupBackendID(serverGroupUuid, member))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_group_uuid"), serverGroupUuid)...)
+	if net.ParseIP(member) != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_address"), member)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_nic_uuid"), member)...)
+	}
+}
+
+// findBackend returns the backend of the server group with the given VM NIC
+// UUID, or with the given IP address when vmNicUuid is empty. It returns
+// ErrResourceNotFound when the server group or the backend does not exist.
+func (r *lbServerGroupBackendResource) findBackend(serverGroupUuid, vmNicUuid, ipAddress string) (*lbServerGroupBackend, error) {
+	backends, err := queryLBServerGroupBackends(r.client, serverGroupUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range backends {
+		if vmNicUuid != "" && backends[i].VmNicUuid == vmNicUuid {
+			return &backends[i], nil
+		}
+		if vmNicUuid == "" && backends[i].IpAddress != "" && lbServerGroupBackendIPEqual(backends[i].IpAddress, ipAddress) {
+			return &backends[i], nil
+		}
+	}
+	return nil, ErrResourceNotFound
+}
+
+func (r *lbServerGroupBackendResource) changeBackend(serverGroupUuid string, plan lbServerGroupBackendModel) error {
+	backend := lbServerGroupBackendAddParams(plan)
+	_, err := r.client.ChangeLoadBalancerBackendServer(serverGroupUuid, param.ChangeLoadBalancerBackendServerParam{
+		BaseParam: param.BaseParam{},
+		Params: param.ChangeLoadBalancerBackendServerParamDetail{
+			VmNics:  backend.VmNics,
+			Servers: backend.Servers,
+		},
+	})
+	return err
+}
+
+func (r *lbServerGroupBackendResource) removeBackend(serverGroupUuid string, model lbServerGroupBackendModel) error {
+	p := param.RemoveBackendServerFromServerGroupParam{
+		BaseParam: param.BaseParam{},
+		Params:    param.RemoveBackendServerFromServerGroupParamDetail{},
+	}
+	if vmNicUuid := model.VmNicUuid.ValueString(); vmNicUuid != "" {
+		p.Params.VmNicUuids = []string{vmNicUuid}
+	} else {
+		p.Params.ServerIps = []string{model.IpAddress.ValueString()}
+	}
+
+	_, err := r.client.RemoveBackendServerFromServerGroup(serverGroupUuid, p)
+	return err
+}
+
+// queryLBServerGroupBackends lists the VM NIC and server IP backends of a
+// server group.
+func queryLBServerGroupBackends(cli *client.ZSClient, serverGroupUuid string) ([]lbServerGroupBackend, error) {
+	serverGroup, err := findResourceByQuery(cli.QueryLoadBalancerServerGroup, serverGroupUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	backends := make([]lbServerGroupBackend, 0, len(serverGroup.VmNicRefs)+len(serverGroup.ServerIps))
+	for _, ref := range serverGroup.VmNicRefs {
+		backends = append(backends, lbServerGroupBackend{
+			VmNicUuid: ref.VmNicUuid,
+			Weight:    int64(ref.Weight),
+			Port:      int64(ref.Port),
+			IpVersion: int64(ref.IpVersion),
+			Status:    ref.Status,
+		})
+	}
+	for _, server := range serverGroup.ServerIps {
+		backends = append(backends, lbServerGroupBackend{
+			IpAddress: server.IpAddress,
+			Weight:    int64(server.Weight),
+			Port:      int64(server.Port),
+			IpVersion: int64(server.IpVersion),
+			Status:    server.Status,
+		})
+	}
+	return backends, nil
+}
+
+// lbServerGroupBackendAddParams builds the vmNics or servers entry of the
+// backend. ZStack takes backend attributes as string maps.
+func lbServerGroupBackendAddParams(plan lbServerGroupBackendModel) param.AddBackendServerToServerGroupParamDetail {
+	entry := map[string]string{}
+	if !plan.Weight.IsNull() && !plan.Weight.IsUnknown() {
+		entry["weight"] = strconv.FormatInt(plan.Weight.ValueInt64(), 10)
+	}
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		entry["port"] = strconv.FormatInt(plan.Port.ValueInt64(), 10)
+	}
+
+	var detail param.AddBackendServerToServerGroupParamDetail
+	if vmNicUuid := plan.VmNicUuid.ValueString(); vmNicUuid != "" {
+		entry["uuid"] = vmNicUuid
+		detail.VmNics = []map[string]string{entry}
+	} else {
+		entry["ipAddress"] = plan.IpAddress.ValueString()
+		detail.Servers = []map[string]string{entry}
+	}
+	return detail
+}
+
+func applyLBServerGroupBackend(model *lbServerGroupBackendModel, backend *lbServerGroupBackend) {
+	model.Weight = types.Int64Value(backend.Weight)
+	model.Port = int64ValueOrNull(backend.Port)
+	model.IpVersion = types.Int64Value(backend.IpVersion)
+	model.Status = stringValueOrNull(backend.Status)
+}
+
+// lbServerGroupBackendPortModifier plans port. A backend without a configured
+// port keeps no port, and one whose port is removed from the configuration is
+// replaced: ChangeLoadBalancerBackendServer can only set a port, so the
+// backend is added again without one to fall back to the listener's instance
+// port.
+type lbServerGroupBackendPortModifier struct{}
+
+func (m lbServerGroupBackendPortModifier) Description(_ context.Context) string {
+	return "Replaces the backend when port is removed from the configuration."
+}
+
+func (m lbServerGroupBackendPortModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m lbServerGroupBackendPortModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.PlanValue = types.Int64Null()
+	if !req.StateValue.IsNull() {
+		resp.RequiresReplace = true
+	}
+}
+
+func lbServerGroupBackendMember(model lbServerGroupBackendModel) string {
+	if vmNicUuid := model.VmNicUuid.ValueString(); vmNicUuid != "" {
+		return vmNicUuid
+	}
+	return model.IpAddress.ValueString()
+}
+
+// lbServerGroupBackendIPEqual compares IP addresses by value, so IPv6
+// addresses match regardless of how they are abbreviated.
+func lbServerGroupBackendIPEqual(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.Equal(ipB)
+}
+
+func lbServerGroupBackendID(serverGroupUuid, member string) string {
+	return fmt.Sprintf("%s:%s", serverGroupUuid, member)
+}
+
+// parseLBServerGroupBackendID splits on the first colon only, since the
+// member may be an IPv6 address.
+func parseLBServerGroupBackendID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected server_group_uuid:vm_nic_uuid or server_group_uuid:ip_address")
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLbServerGroupBackendResource_Schema(t *testing.T) {
+	var r lbServerGroupBackendResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	required := []string{"server_group_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	optional := []string{"vm_nic_uuid", "ip_address", "weight", "port"}
+	for _, attr := range optional {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+		if !a.IsOptional() {
+			t.Errorf("attribute %q should be optional", attr)
+		}
+	}
+
+	computed := []string{"id", "weight", "port", "ip_version", "status"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestLbServerGroupBackendResource_Metadata(t *testing.T) {
+	var r lbServerGroupBackendResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_lb_server_group_backend" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestParseLBServerGroupBackendID(t *testing.T) {
+	tests := []struct {
+		id          string
+		serverGroup string
+		member      string
+		wantErr     bool
+	}{
+		{id: "sg1:nic1", serverGroup: "sg1", member: "nic1"},
+		{id: "sg1:192.168.1.10", serverGroup: "sg1", member: "192.168.1.10"},
+		{id: "sg1:fd00::10", serverGroup: "sg1", member: "fd00::10"},
+		{id: "sg1", wantErr: true},
+		{id: ":nic1", wantErr: true},
+		{id: "sg1:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		serverGroup, member, err := parseLBServerGroupBackendID(tt.id)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseLBServerGroupBackendID(%q) should fail", tt.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseLBServerGroupBackendID(%q) failed: %v", tt.id, err)
+			continue
+		}
+		if serverGroup != tt.serverGroup || member != tt.member {
+			t.Errorf("parseLBServerGroupBackendID(%q) = %q, %q; want %q, %q", tt.id, serverGroup, member, tt.serverGroup, tt.member)
+		}
+	}
+}
+
+func TestLbServerGroupBackendIPEqual(t *testing.T) {
+	if !lbServerGroupBackendIPEqual("fd00:0:0:0:0:0:0:10", "fd00::10") {
+		t.Error("equivalent IPv6 addresses should match")
+	}
+	if lbServerGroupBackendIPEqual("192.168.1.10", "192.168.1.11") {
+		t.Error("different addresses should not match")
+	}
+}
+
+func TestLbServerGroupBackendPortModifier(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"port": tftypes.Number}}
+	existing := tftypes.NewValue(objectType, map[string]tftypes.Value{"port": tftypes.NewValue(tftypes.Number, nil)})
+	absent := tftypes.NewValue(objectType, nil)
+
+	cases := []struct {
+		name        string
+		state       tftypes.Value
+		config      types.Int64
+		stateValue  types.Int64
+		wantPlan    types.Int64
+		wantReplace bool
+	}{
+		{"create without port", absent, types.Int64Null(), types.Int64Null(), types.Int64Unknown(), false},
+		{"configured port", existing, types.Int64Value(8080), types.Int64Value(80), types.Int64Value(8080), false},
+		{"port never set", existing, types.Int64Null(), types.Int64Null(), types.Int64Null(), false},
+		{"port removed", existing, types.Int64Null(), types.Int64Value(8080), types.Int64Null(), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := planmodifier.Int64Request{
+				State:       tfsdk.State{Raw: tc.state},
+				Plan:        tfsdk.Plan{Raw: existing},
+				ConfigValue: tc.config,
+				StateValue:  tc.stateValue,
+				PlanValue:   types.Int64Unknown(),
+			}
+			if !tc.config.IsNull() {
+				req.PlanValue = tc.config
+			}
+			resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+			lbServerGroupBackendPortModifier{}.PlanModifyInt64(context.Background(), req, resp)
+
+			if !resp.PlanValue.Equal(tc.wantPlan) {
+				t.Errorf("plan = %s, want %s", resp.PlanValue, tc.wantPlan)
+			}
+			if resp.RequiresReplace != tc.wantReplace {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, tc.wantReplace)
+			}
+		})
+	}
+}
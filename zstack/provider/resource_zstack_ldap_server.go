@@ -148,9 +148,18 @@ func (r *ldapServerResource) Create(ctx context.Context, req resource.CreateRequ
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddLdapServer(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating LDAP Server", "Could not create LDAP server, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryLdapServer), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "LDAP Server", "AddLdapServer", err, &resp.Diagnostics)
 		return
 	}
 
@@ -181,12 +190,15 @@ func (r *ldapServerResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading LDAP Server",
-			"Could not read LDAP Server, unexpected error: "+err.Error(),
-		)
+			"Could not read LDAP Server",
+			"QueryLdapServer",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -228,7 +240,7 @@ func (r *ldapServerResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	item, err := r.client.UpdateLdapServer(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating LDAP Server", "Could not update LDAP server, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating LDAP Server", "Could not update LDAP server", "UpdateLdapServer", err))
 		return
 	}
 
@@ -255,7 +267,7 @@ func (r *ldapServerResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 
 	if err := r.client.DeleteLdapServer(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting LDAP Server", "Could not delete LDAP server, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting LDAP Server", "Could not delete LDAP server", "DeleteLdapServer", err))
 		return
 	}
 }
@@ -211,7 +211,7 @@ func (r *licenseResource) Create(ctx context.Context, request resource.CreateReq
 
 	license, err := r.client.UpdateLicense(plan.ManagementNodeUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating license", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error creating license", "Could not create license", "UpdateLicense", err))
 		return
 	}
 
@@ -238,7 +238,7 @@ func (r *licenseResource) Read(ctx context.Context, request resource.ReadRequest
 			return
 		}
 
-		response.Diagnostics.AddError("Unable to read license info", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Unable to read license info", "Could not read license info", "GetLicenseInfo", err))
 		return
 	}
 
@@ -266,7 +266,7 @@ func (r *licenseResource) Update(ctx context.Context, request resource.UpdateReq
 
 	license, err := r.client.UpdateLicense(plan.ManagementNodeUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError("Error updating license", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating license", "Could not update license", "UpdateLicense", err))
 		return
 	}
 
@@ -286,7 +286,7 @@ func (r *licenseResource) Delete(ctx context.Context, request resource.DeleteReq
 
 	err := r.client.DeleteLicense(state.ManagementNodeUuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting license", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting license", "Could not delete license", "DeleteLicense", err))
 		return
 	}
 }
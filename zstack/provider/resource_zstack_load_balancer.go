@@ -151,9 +151,18 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 		createParam.Params.Description = stringPtr(plan.Description.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	lb, err := r.client.CreateLoadBalancer(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Load Balancer", "Could not create load balancer, unexpected error: "+err.Error())
+		lb, err = adoptCreatedResource(ctx, r.client.GetLoadBalancer, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Load Balancer", "CreateLoadBalancer", err, &resp.Diagnostics)
 		return
 	}
 
@@ -178,9 +187,10 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Load Balancer", "Could not read load balancer, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Load Balancer", "Could not read load balancer", "GetLoadBalancer", err))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := loadBalancerModelFromView(lb)
 
@@ -213,7 +223,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 
 		if _, err := r.client.UpdateLoadBalancer(uuid, updateParam); err != nil {
-			resp.Diagnostics.AddError("Error updating Load Balancer", "Could not update load balancer, unexpected error: "+err.Error())
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Load Balancer", "Could not update load balancer", "UpdateLoadBalancer", err))
 			return
 		}
 	}
@@ -221,7 +231,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	// Read back the updated resource
 	lb, err := r.client.GetLoadBalancer(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Load Balancer", "Could not read load balancer after update: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Load Balancer", "Could not read load balancer after update", "GetLoadBalancer", err))
 		return
 	}
 
@@ -246,7 +256,7 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	if err := r.client.DeleteLoadBalancer(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Load Balancer", "Could not delete load balancer, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Load Balancer", "Could not delete load balancer", "DeleteLoadBalancer", err))
 		return
 	}
 }
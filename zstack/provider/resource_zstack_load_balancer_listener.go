@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,9 +28,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &loadBalancerListenerResource{}
-	_ resource.ResourceWithConfigure   = &loadBalancerListenerResource{}
-	_ resource.ResourceWithImportState = &loadBalancerListenerResource{}
+	_ resource.Resource                   = &loadBalancerListenerResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerListenerResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerListenerResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerListenerResource{}
 )
 
 type loadBalancerListenerResource struct {
@@ -33,15 +39,32 @@ type loadBalancerListenerResource struct {
 }
 
 type loadBalancerListenerResourceModel struct {
-	Uuid               types.String `tfsdk:"uuid"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	LoadBalancerUuid   types.String `tfsdk:"load_balancer_uuid"`
-	Protocol           types.String `tfsdk:"protocol"`
-	LoadBalancerPort   types.Int64  `tfsdk:"load_balancer_port"`
-	InstancePort       types.Int64  `tfsdk:"instance_port"`
-	SecurityPolicyType types.String `tfsdk:"security_policy_type"`
-	ServerGroupUuid    types.String `tfsdk:"server_group_uuid"`
+	Uuid                  types.String `tfsdk:"uuid"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	LoadBalancerUuid      types.String `tfsdk:"load_balancer_uuid"`
+	Protocol              types.String `tfsdk:"protocol"`
+	LoadBalancerPort      types.Int64  `tfsdk:"load_balancer_port"`
+	InstancePort          types.Int64  `tfsdk:"instance_port"`
+	SecurityPolicyType    types.String `tfsdk:"security_policy_type"`
+	ServerGroupUuid       types.String `tfsdk:"server_group_uuid"`
+	BalancerAlgorithm     types.String `tfsdk:"balancer_algorithm"`
+	ConnectionIdleTimeout types.Int64  `tfsdk:"connection_idle_timeout"`
+	MaxConnection         types.Int64  `tfsdk:"max_connection"`
+	HealthCheckProtocol   types.String `tfsdk:"health_check_protocol"`
+	HealthCheckMethod     types.String `tfsdk:"health_check_method"`
+	HealthCheckPath       types.String `tfsdk:"health_check_path"`
+	HealthCheckHttpCode   types.String `tfsdk:"health_check_http_code"`
+	HealthCheckInterval   types.Int64  `tfsdk:"health_check_interval"`
+	HealthCheckTimeout    types.Int64  `tfsdk:"health_check_timeout"`
+	HealthyThreshold      types.Int64  `tfsdk:"healthy_threshold"`
+	UnhealthyThreshold    types.Int64  `tfsdk:"unhealthy_threshold"`
+	SessionPersistence    types.String `tfsdk:"session_persistence"`
+	SessionIdleTimeout    types.Int64  `tfsdk:"session_idle_timeout"`
+	CookieName            types.String `tfsdk:"cookie_name"`
+	CertificateUuid       types.String `tfsdk:"certificate_uuid"`
+	AclUuids              types.Set    `tfsdk:"acl_uuids"`
+	AclType               types.String `tfsdk:"acl_type"`
 }
 
 func LoadBalancerListenerResource() resource.Resource {
@@ -145,10 +168,233 @@ func (r *loadBalancerListenerResource) Schema(_ context.Context, _ resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"balancer_algorithm": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The balancing algorithm: roundrobin, leastconn, source or weightroundrobin. Defaults to `roundrobin`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("roundrobin", "leastconn", "source", "weightroundrobin"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_idle_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The idle timeout of client connections, in seconds. Defaults to `60`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_connection": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum number of concurrent connections of the listener. Defaults to `2000000`.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_protocol": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The protocol of the backend health check: tcp, udp or http. Defaults to `udp` for udp listeners and `tcp` otherwise.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp", "http"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The HTTP method of http health checks: GET or HEAD.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "HEAD"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The URI path requested by http health checks, e.g. `/healthz`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_http_code": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The comma-separated HTTP status classes that pass an http health check, e.g. `http_2xx,http_3xx`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_interval": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The interval between health checks, in seconds. Defaults to `5`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"health_check_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The timeout of a health check, in seconds. Defaults to `2`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"healthy_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of consecutive successful health checks after which a backend is considered healthy. Defaults to `2`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"unhealthy_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of consecutive failed health checks after which a backend is considered unhealthy. Defaults to `2`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_persistence": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The session persistence mode: disable, iphash, insert (the load balancer inserts a cookie) or rewrite (the load balancer rewrites the cookie named by `cookie_name`). Defaults to `disable`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("disable", "iphash", "insert", "rewrite"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_idle_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The idle timeout of persistent sessions, in seconds. Defaults to `1800`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 86400),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cookie_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the backend cookie rewritten when `session_persistence` is `rewrite`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_uuid": schema.StringAttribute{
+				Optional:    true,
+				Description: "The UUID of the `zstack_certificate` served by an https listener. Required for https listeners.",
+			},
+			"acl_uuids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The UUIDs of the `zstack_access_control_list` resources applied to the listener.",
+			},
+			"acl_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "How `acl_uuids` are applied: `white` only admits the listed addresses, `black` rejects them. Required when `acl_uuids` is set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("white", "black"),
+					stringvalidator.AlsoRequires(path.MatchRoot("acl_uuids")),
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *loadBalancerListenerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config loadBalancerListenerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Protocol.IsUnknown() || config.Protocol.IsNull() {
+		return
+	}
+	protocol := config.Protocol.ValueString()
+
+	if protocol == "https" && config.CertificateUuid.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_uuid"),
+			"Missing Certificate",
+			`The "certificate_uuid" attribute is required when protocol is "https".`,
+		)
+	}
+	if protocol != "https" && !config.CertificateUuid.IsNull() && !config.CertificateUuid.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_uuid"),
+			"Certificate Not Supported",
+			`The "certificate_uuid" attribute can only be set when protocol is "https".`,
+		)
+	}
+
+	if !config.AclUuids.IsNull() && !config.AclUuids.IsUnknown() && config.AclType.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("acl_type"),
+			"Missing ACL Type",
+			`The "acl_type" attribute is required when "acl_uuids" is set.`,
+		)
+	}
+
+	if protocol != "http" && protocol != "https" {
+		for _, attr := range []struct {
+			name  string
+			value types.String
+		}{
+			{"cookie_name", config.CookieName},
+			{"health_check_method", config.HealthCheckMethod},
+			{"health_check_path", config.HealthCheckPath},
+			{"health_check_http_code", config.HealthCheckHttpCode},
+		} {
+			if !attr.value.IsNull() && !attr.value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Attribute Not Supported",
+					fmt.Sprintf("The %q attribute can only be set for http and https listeners.", attr.name),
+				)
+			}
+		}
+	}
+}
+
 // Create implements resource.Resource.
 func (r *loadBalancerListenerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan loadBalancerListenerResourceModel
@@ -170,6 +416,7 @@ func (r *loadBalancerListenerResource) Create(ctx context.Context, req resource.
 			LoadBalancerPort: int(plan.LoadBalancerPort.ValueInt64()),
 			Protocol:         stringPtr(plan.Protocol.ValueString()),
 			InstancePort:     intPtr(int(plan.InstancePort.ValueInt64())),
+			CertificateUuid:  stringPtrOrNil(plan.CertificateUuid.ValueString()),
 		},
 	}
 
@@ -180,15 +427,50 @@ func (r *loadBalancerListenerResource) Create(ctx context.Context, req resource.
 		createParam.Params.SecurityPolicyType = stringPtr(plan.SecurityPolicyType.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	listener, err := r.client.CreateLoadBalancerListener(plan.LoadBalancerUuid.ValueString(), createParam)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Load Balancer Listener", "Could not create load balancer listener, unexpected error: "+err.Error())
+		listener, err = adoptCreatedResource(ctx, r.client.GetLoadBalancerListener, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Load Balancer Listener", "CreateLoadBalancerListener", err, &resp.Diagnostics)
 		return
 	}
+	uuid := listener.UUID
 
+	// Save the listener before configuring it, so a failed setting leaves it
+	// tracked (and tainted) instead of orphaned.
 	state := loadBalancerListenerModelFromView(listener)
+	state.AclUuids = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateParam, ok := loadBalancerListenerSettingsParam(plan, nil); ok {
+		if _, err := r.client.UpdateLoadBalancerListener(uuid, updateParam); err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error creating Load Balancer Listener", "Could not configure load balancer listener", "UpdateLoadBalancerListener", err))
+			return
+		}
+	}
 
-	diags = resp.State.Set(ctx, &state)
+	r.reconcileAcls(ctx, uuid, nil, "", plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedState, diags := r.readListener(ctx, uuid, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -207,11 +489,16 @@ func (r *loadBalancerListenerResource) Read(ctx context.Context, req resource.Re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Load Balancer Listener", "Could not read load balancer listener, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Load Balancer Listener", "Could not read load balancer listener", "GetLoadBalancerListener", err))
 		return
 	}
 
 	refreshedState := loadBalancerListenerModelFromView(listener)
+	diags = r.applyListenerSettings(ctx, &refreshedState, listener, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
@@ -232,31 +519,74 @@ func (r *loadBalancerListenerResource) Update(ctx context.Context, req resource.
 
 	uuid := state.Uuid.ValueString()
 
-	// Update name/description if changed
-	if plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
-		updateParam := param.UpdateLoadBalancerListenerParam{
-			BaseParam: param.BaseParam{},
-			Params: param.UpdateLoadBalancerListenerParamDetail{
-				Name:        plan.Name.ValueString(),
-				Description: stringPtrOrNil(plan.Description.ValueString()),
-			},
+	// Update name, description and listener settings if changed
+	if updateParam, ok := loadBalancerListenerSettingsParam(plan, &state); ok {
+		if _, err := r.client.UpdateLoadBalancerListener(uuid, updateParam); err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Load Balancer Listener", "Could not update load balancer listener", "UpdateLoadBalancerListener", err))
+			return
 		}
+	}
 
-		if _, err := r.client.UpdateLoadBalancerListener(uuid, updateParam); err != nil {
-			resp.Diagnostics.AddError("Error updating Load Balancer Listener", "Could not update load balancer listener, unexpected error: "+err.Error())
+	// Add the new certificate before removing the old one, so an https
+	// listener is never left without a certificate.
+	oldCertificate := state.CertificateUuid.ValueString()
+	newCertificate := plan.CertificateUuid.ValueString()
+	if oldCertificate != newCertificate {
+		if newCertificate != "" {
+			_, err := r.client.AddCertificateToLoadBalancerListener(uuid, param.AddCertificateToLoadBalancerListenerParam{
+				BaseParam: param.BaseParam{},
+				Params: param.AddCertificateToLoadBalancerListenerParamDetail{
+					CertificateUuid: newCertificate,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
+					"Error updating Load Balancer Listener",
+					fmt.Sprintf("Could not add certificate %s to load balancer listener", newCertificate),
+					"AddCertificateToLoadBalancerListener",
+					err,
+				))
+				return
+			}
+		}
+		if oldCertificate != "" {
+			_, err := r.client.RemoveCertificateFromLoadBalancerListener(uuid, param.RemoveCertificateFromLoadBalancerListenerParam{
+				BaseParam: param.BaseParam{},
+				Params: param.RemoveCertificateFromLoadBalancerListenerParamDetail{
+					CertificateUuid: oldCertificate,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
+					"Error updating Load Balancer Listener",
+					fmt.Sprintf("Could not remove certificate %s from load balancer listener", oldCertificate),
+					"RemoveCertificateFromLoadBalancerListener",
+					err,
+				))
+				return
+			}
+		}
+	}
+
+	var currentAcls []string
+	if !state.AclUuids.IsNull() && !state.AclUuids.IsUnknown() {
+		resp.Diagnostics.Append(state.AclUuids.ElementsAs(ctx, &currentAcls, false)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
+	r.reconcileAcls(ctx, uuid, currentAcls, state.AclType.ValueString(), plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Read back the updated resource
-	listener, err := r.client.GetLoadBalancerListener(uuid)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading Load Balancer Listener", "Could not read load balancer listener after update: "+err.Error())
+	refreshedState, diags := r.readListener(ctx, uuid, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	refreshedState := loadBalancerListenerModelFromView(listener)
-
 	diags = resp.State.Set(ctx, &refreshedState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -276,7 +606,7 @@ func (r *loadBalancerListenerResource) Delete(ctx context.Context, req resource.
 	}
 
 	if err := r.client.DeleteLoadBalancerListener(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Load Balancer Listener", "Could not delete load balancer listener, unexpected error: "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Load Balancer Listener", "Could not delete load balancer listener", "DeleteLoadBalancerListener", err))
 		return
 	}
 }
@@ -286,6 +616,270 @@ func (r *loadBalancerListenerResource) ImportState(ctx context.Context, req reso
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
 
+// reconcileAcls attaches and detaches ACLs so the listener has exactly the
+// planned acl_uuids. Changing acl_type re-attaches every ACL, since ZStack
+// sets the type when an ACL is attached.
+func (r *loadBalancerListenerResource) reconcileAcls(ctx context.Context, uuid string, current []string, currentType string, plan loadBalancerListenerResourceModel, diags *diag.Diagnostics) {
+	var desired []string
+	if !plan.AclUuids.IsNull() && !plan.AclUuids.IsUnknown() {
+		diags.Append(plan.AclUuids.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	desiredType := plan.AclType.ValueString()
+
+	toRemove := stringSetDifference(current, desired)
+	toAdd := stringSetDifference(desired, current)
+	if currentType != desiredType {
+		toRemove, toAdd = current, desired
+	}
+
+	if len(toRemove) > 0 {
+		_, err := r.client.RemoveAccessControlListFromLoadBalancer(uuid, param.RemoveAccessControlListFromLoadBalancerParam{
+			BaseParam: param.BaseParam{},
+			Params: param.RemoveAccessControlListFromLoadBalancerParamDetail{
+				AclUuids: toRemove,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(
+				"Error updating Load Balancer Listener ACLs",
+				fmt.Sprintf("Could not remove access control lists %s from load balancer listener", strings.Join(toRemove, ", ")),
+				"RemoveAccessControlListFromLoadBalancer",
+				err,
+			))
+			return
+		}
+	}
+
+	if len(toAdd) > 0 {
+		_, err := r.client.AddAccessControlListToLoadBalancer(uuid, param.AddAccessControlListToLoadBalancerParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddAccessControlListToLoadBalancerParamDetail{
+				AclUuids: toAdd,
+				AclType:  desiredType,
+			},
+		})
+		if err != nil {
+			diags.Append(zstackErrorDiagnostic(
+				"Error updating Load Balancer Listener ACLs",
+				fmt.Sprintf("Could not add access control lists %s to load balancer listener", strings.Join(toAdd, ", ")),
+				"AddAccessControlListToLoadBalancer",
+				err,
+			))
+		}
+	}
+}
+
+// readListener reads the listener and its settings back after a change.
+func (r *loadBalancerListenerResource) readListener(ctx context.Context, uuid string, prior loadBalancerListenerResourceModel) (loadBalancerListenerResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listener, err := r.client.GetLoadBalancerListener(uuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error reading Load Balancer Listener", "Could not read load balancer listener after update", "GetLoadBalancerListener", err))
+		return loadBalancerListenerResourceModel{}, diags
+	}
+
+	model := loadBalancerListenerModelFromView(listener)
+	diags.Append(r.applyListenerSettings(ctx, &model, listener, prior)...)
+	return model, diags
+}
+
+// applyListenerSettings fills in the settings ZStack keeps outside the
+// listener inventory: certificate and ACL references, and the system tags
+// holding balancing, health check and session persistence settings. A setting
+// without a tag, which ZStack creates only once it differs from the default,
+// reads as ZStack's default (see loadBalancerListenerDefaults), so a setting
+// reset outside Terraform shows up as drift.
+func (r *loadBalancerListenerResource) applyListenerSettings(ctx context.Context, model *loadBalancerListenerResourceModel, listener *view.LoadBalancerListenerInventoryView, prior loadBalancerListenerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.CertificateUuid = types.StringNull()
+	if len(listener.CertificateRefs) > 0 {
+		model.CertificateUuid = types.StringValue(listener.CertificateRefs[0].CertificateUuid)
+	}
+
+	model.AclUuids = types.SetNull(types.StringType)
+	model.AclType = types.StringNull()
+	if len(listener.AclRefs) > 0 {
+		aclUuids := make([]string, 0, len(listener.AclRefs))
+		for _, ref := range listener.AclRefs {
+			aclUuids = append(aclUuids, ref.AclUuid)
+		}
+		aclSet, d := types.SetValueFrom(ctx, types.StringType, aclUuids)
+		diags.Append(d...)
+		model.AclUuids = aclSet
+		model.AclType = types.StringValue(listener.AclRefs[0].Type)
+	} else if !prior.AclUuids.IsNull() && !prior.AclUuids.IsUnknown() && len(prior.AclUuids.Elements()) == 0 {
+		// Keep an explicitly empty acl_uuids instead of turning it into null.
+		model.AclUuids = prior.AclUuids
+		model.AclType = prior.AclType
+	}
+
+	q := param.NewQueryParam()
+	q.AddQ("resourceUuid=" + listener.UUID)
+	tags, err := r.client.QuerySystemTag(&q)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error reading Load Balancer Listener", "Could not read load balancer listener settings", "QuerySystemTag", err))
+		return diags
+	}
+	tagValues := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagValues = append(tagValues, tag.Tag)
+	}
+	settings := parseLoadBalancerListenerTags(tagValues)
+	if _, ok := settings["healthCheckProtocol"]; !ok {
+		// ZStack health checks udp listeners over udp and all others over tcp.
+		settings["healthCheckProtocol"] = "tcp"
+		if listener.Protocol == "udp" {
+			settings["healthCheckProtocol"] = "udp"
+		}
+	}
+
+	model.BalancerAlgorithm = listenerStringSetting(settings, "balancerAlgorithm")
+	model.ConnectionIdleTimeout = listenerInt64Setting(settings, "connectionIdleTimeout")
+	model.MaxConnection = listenerInt64Setting(settings, "maxConnection")
+	model.HealthCheckProtocol = listenerStringSetting(settings, "healthCheckProtocol")
+	model.HealthCheckMethod = listenerStringSetting(settings, "healthCheckMethod")
+	model.HealthCheckPath = listenerStringSetting(settings, "healthCheckURI")
+	model.HealthCheckHttpCode = listenerStringSetting(settings, "healthCheckHttpCode")
+	model.HealthCheckInterval = listenerInt64Setting(settings, "healthCheckInterval")
+	model.HealthCheckTimeout = listenerInt64Setting(settings, "healthCheckTimeout")
+	model.HealthyThreshold = listenerInt64Setting(settings, "healthyThreshold")
+	model.UnhealthyThreshold = listenerInt64Setting(settings, "unhealthyThreshold")
+	model.SessionPersistence = listenerStringSetting(settings, "sessionPersistence")
+	model.SessionIdleTimeout = listenerInt64Setting(settings, "sessionIdleTimeout")
+	model.CookieName = listenerStringSetting(settings, "cookieName")
+
+	return diags
+}
+
+// loadBalancerListenerSettingsParam builds the update of name, description
+// and the listener settings that differ from state; with a nil state every
+// configured setting is sent. ok is false when nothing changed.
+func loadBalancerListenerSettingsParam(plan loadBalancerListenerResourceModel, state *loadBalancerListenerResourceModel) (param.UpdateLoadBalancerListenerParam, bool) {
+	detail := param.UpdateLoadBalancerListenerParamDetail{
+		Name:        plan.Name.ValueString(),
+		Description: stringPtrOrNil(plan.Description.ValueString()),
+	}
+	changed := state != nil && (plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString())
+
+	setString := func(target **string, planValue types.String, stateValue func(*loadBalancerListenerResourceModel) types.String) {
+		if planValue.IsNull() || planValue.IsUnknown() {
+			return
+		}
+		if state != nil && stateValue(state).Equal(planValue) {
+			return
+		}
+		*target = stringPtr(planValue.ValueString())
+		changed = true
+	}
+	setInt := func(target **int, planValue types.Int64, stateValue func(*loadBalancerListenerResourceModel) types.Int64) {
+		if planValue.IsNull() || planValue.IsUnknown() {
+			return
+		}
+		if state != nil && stateValue(state).Equal(planValue) {
+			return
+		}
+		*target = intPtr(int(planValue.ValueInt64()))
+		changed = true
+	}
+
+	setString(&detail.BalancerAlgorithm, plan.BalancerAlgorithm, func(m *loadBalancerListenerResourceModel) types.String { return m.BalancerAlgorithm })
+	setInt(&detail.ConnectionIdleTimeout, plan.ConnectionIdleTimeout, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.ConnectionIdleTimeout })
+	setInt(&detail.MaxConnection, plan.MaxConnection, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.MaxConnection })
+	setString(&detail.HealthCheckProtocol, plan.HealthCheckProtocol, func(m *loadBalancerListenerResourceModel) types.String { return m.HealthCheckProtocol })
+	setString(&detail.HealthCheckMethod, plan.HealthCheckMethod, func(m *loadBalancerListenerResourceModel) types.String { return m.HealthCheckMethod })
+	setString(&detail.HealthCheckURI, plan.HealthCheckPath, func(m *loadBalancerListenerResourceModel) types.String { return m.HealthCheckPath })
+	setString(&detail.HealthCheckHttpCode, plan.HealthCheckHttpCode, func(m *loadBalancerListenerResourceModel) types.String { return m.HealthCheckHttpCode })
+	setInt(&detail.HealthCheckInterval, plan.HealthCheckInterval, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.HealthCheckInterval })
+	setInt(&detail.HealthCheckTimeout, plan.HealthCheckTimeout, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.HealthCheckTimeout })
+	setInt(&detail.HealthyThreshold, plan.HealthyThreshold, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.HealthyThreshold })
+	setInt(&detail.UnhealthyThreshold, plan.UnhealthyThreshold, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.UnhealthyThreshold })
+	setString(&detail.SessionPersistence, plan.SessionPersistence, func(m *loadBalancerListenerResourceModel) types.String { return m.SessionPersistence })
+	setInt(&detail.SessionIdleTimeout, plan.SessionIdleTimeout, func(m *loadBalancerListenerResourceModel) types.Int64 { return m.SessionIdleTimeout })
+	setString(&detail.CookieName, plan.CookieName, func(m *loadBalancerListenerResourceModel) types.String { return m.CookieName })
+
+	return param.UpdateLoadBalancerListenerParam{BaseParam: param.BaseParam{}, Params: detail}, changed
+}
+
+// parseLoadBalancerListenerTags maps the listener system tags to setting
+// names. ZStack stores most settings as "name::value"; the health check
+// target is "protocol:port" and the http health check parameters are
+// "method:uri:codes".
+func parseLoadBalancerListenerTags(tags []string) map[string]string {
+	settings := make(map[string]string)
+	for _, tag := range tags {
+		name, value, ok := strings.Cut(tag, "::")
+		if !ok {
+			continue
+		}
+		switch name {
+		case "healthCheckTarget":
+			protocol, _, _ := strings.Cut(value, ":")
+			settings["healthCheckProtocol"] = protocol
+		case "healthCheckParameter":
+			method, rest, ok := strings.Cut(value, ":")
+			if !ok {
+				continue
+			}
+			settings["healthCheckMethod"] = method
+			if i := strings.LastIndex(rest, ":"); i >= 0 {
+				settings["healthCheckURI"] = rest[:i]
+				settings["healthCheckHttpCode"] = rest[i+1:]
+			} else {
+				settings["healthCheckURI"] = rest
+			}
+		default:
+			settings[name] = value
+		}
+	}
+	return settings
+}
+
+// loadBalancerListenerDefaults holds the values ZStack applies to a listener
+// setting that has no system tag. The http health check parameters and the
+// cookie name have no default and read as null without a tag.
+var loadBalancerListenerDefaults = map[string]string{
+	"balancerAlgorithm":     "roundrobin",
+	"connectionIdleTimeout": "60",
+	"maxConnection":         "2000000",
+	"healthCheckInterval":   "5",
+	"healthCheckTimeout":    "2",
+	"healthyThreshold":      "2",
+	"unhealthyThreshold":    "2",
+	"sessionPersistence":    "disable",
+	"sessionIdleTimeout":    "1800",
+}
+
+// listenerSetting returns the tagged value of a listener setting, or its
+// ZStack default when the tag is missing or empty.
+func listenerSetting(settings map[string]string, name string) (string, bool) {
+	if value := settings[name]; value != "" {
+		return value, true
+	}
+	value, ok := loadBalancerListenerDefaults[name]
+	return value, ok
+}
+
+func listenerStringSetting(settings map[string]string, name string) types.String {
+	if value, ok := listenerSetting(settings, name); ok {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+func listenerInt64Setting(settings map[string]string, name string) types.Int64 {
+	if value, ok := listenerSetting(settings, name); ok {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return types.Int64Value(n)
+		}
+	}
+	return types.Int64Null()
+}
+
 func loadBalancerListenerModelFromView(l *view.LoadBalancerListenerInventoryView) loadBalancerListenerResourceModel {
 	return loadBalancerListenerResourceModel{
 		Uuid:               types.StringValue(l.UUID),
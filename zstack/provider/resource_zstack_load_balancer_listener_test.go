@@ -44,11 +44,71 @@ func TestLoadBalancerListenerResource_Schema(t *testing.T) {
 		}
 	}
 
-	optional := []string{"description", "security_policy_type"}
+	optional := []string{
+		"description", "security_policy_type", "balancer_algorithm", "connection_idle_timeout", "max_connection",
+		"health_check_protocol", "health_check_method", "health_check_path", "health_check_http_code",
+		"health_check_interval", "health_check_timeout", "healthy_threshold", "unhealthy_threshold",
+		"session_persistence", "session_idle_timeout", "cookie_name", "certificate_uuid", "acl_uuids", "acl_type",
+	}
 	for _, attr := range optional {
-		if _, ok := resp.Schema.Attributes[attr]; !ok {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
 			t.Fatalf("schema missing optional attribute %q", attr)
 		}
+		if !a.IsOptional() {
+			t.Errorf("attribute %q should be optional", attr)
+		}
+	}
+}
+
+func TestParseLoadBalancerListenerTags(t *testing.T) {
+	settings := parseLoadBalancerListenerTags([]string{
+		"balancerAlgorithm::leastconn",
+		"healthCheckInterval::5",
+		"healthCheckTarget::http:default",
+		"healthCheckParameter::GET:/healthz:http_2xx,http_3xx",
+		"sessionPersistence::rewrite",
+		"cookieName::JSESSIONID",
+		"notASetting",
+	})
+
+	want := map[string]string{
+		"balancerAlgorithm":   "leastconn",
+		"healthCheckInterval": "5",
+		"healthCheckProtocol": "http",
+		"healthCheckMethod":   "GET",
+		"healthCheckURI":      "/healthz",
+		"healthCheckHttpCode": "http_2xx,http_3xx",
+		"sessionPersistence":  "rewrite",
+		"cookieName":          "JSESSIONID",
+	}
+	if len(settings) != len(want) {
+		t.Errorf("got %d settings, want %d: %v", len(settings), len(want), settings)
+	}
+	for name, value := range want {
+		if settings[name] != value {
+			t.Errorf("setting %s = %q, want %q", name, settings[name], value)
+		}
+	}
+}
+
+func TestListenerSettingDefaults(t *testing.T) {
+	settings := map[string]string{"balancerAlgorithm": "leastconn", "maxConnection": ""}
+
+	if got := listenerStringSetting(settings, "balancerAlgorithm"); got.ValueString() != "leastconn" {
+		t.Errorf("tagged balancerAlgorithm = %s, want leastconn", got)
+	}
+	if got := listenerStringSetting(settings, "sessionPersistence"); got.ValueString() != "disable" {
+		t.Errorf("untagged sessionPersistence = %s, want disable", got)
+	}
+	if got := listenerInt64Setting(settings, "maxConnection"); got.ValueInt64() != 2000000 {
+		t.Errorf("empty maxConnection = %s, want 2000000", got)
+	}
+	if got := listenerInt64Setting(settings, "connectionIdleTimeout"); got.ValueInt64() != 60 {
+		t.Errorf("untagged connectionIdleTimeout = %s, want 60", got)
+	}
+	if got := listenerStringSetting(settings, "cookieName"); !got.IsNull() {
+		t.Errorf("untagged cookieName = %s, want null", got)
 	}
 }
 
@@ -190,9 +190,18 @@ func (r *logServerResource) Create(ctx context.Context, req resource.CreateReque
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddLogServer(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Log Server", "Could not create log server, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryLogServer), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Log Server", "AddLogServer", err, &resp.Diagnostics)
 		return
 	}
 
@@ -222,12 +231,15 @@ func (r *logServerResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Log Server",
-			"Could not read log server, unexpected error: "+err.Error(),
-		)
+			"Could not read log server",
+			"QueryLogServer",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -264,7 +276,7 @@ func (r *logServerResource) Update(ctx context.Context, req resource.UpdateReque
 
 	item, err := r.client.UpdateLogServer(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Log Server", "Could not update log server UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Log Server", "Could not update log server UUID "+state.Uuid.ValueString(), "UpdateLogServer", err))
 		return
 	}
 
@@ -289,7 +301,7 @@ func (r *logServerResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	if err := r.client.DeleteLogServer(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Log Server", "Could not delete log server UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Log Server", "Could not delete log server UUID "+state.Uuid.ValueString(), "DeleteLogServer", err))
 		return
 	}
 }
@@ -123,9 +123,18 @@ func (r *monitorGroupResource) Create(ctx context.Context, req resource.CreateRe
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateMonitorGroup(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Monitor Group", "Could not create monitor group, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryMonitorGroup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Monitor Group", "CreateMonitorGroup", err, &resp.Diagnostics)
 		return
 	}
 
@@ -152,12 +161,15 @@ func (r *monitorGroupResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Monitor Group",
-			"Could not read Monitor Group, unexpected error: "+err.Error(),
-		)
+			"Could not read Monitor Group",
+			"QueryMonitorGroup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -190,7 +202,7 @@ func (r *monitorGroupResource) Update(ctx context.Context, req resource.UpdateRe
 
 	item, err := r.client.UpdateMonitorGroup(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Monitor Group", "Could not update monitor group UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Monitor Group", "Could not update monitor group UUID "+state.Uuid.ValueString(), "UpdateMonitorGroup", err))
 		return
 	}
 
@@ -213,7 +225,7 @@ func (r *monitorGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 
 
 	if err := r.client.DeleteMonitorGroup(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Monitor Group", "Could not delete monitor group UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Monitor Group", "Could not delete monitor group UUID "+state.Uuid.ValueString(), "DeleteMonitorGroup", err))
 		return
 	}
 }
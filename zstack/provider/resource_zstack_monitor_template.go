@@ -115,9 +115,18 @@ func (r *monitorTemplateResource) Create(ctx context.Context, req resource.Creat
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateMonitorTemplate(p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Monitor Template", "Could not create monitor template, unexpected error: "+err.Error())
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryMonitorTemplate), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Monitor Template", "CreateMonitorTemplate", err, &resp.Diagnostics)
 		return
 	}
 
@@ -143,12 +152,15 @@ func (r *monitorTemplateResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Monitor Template",
-			"Could not read Monitor Template, unexpected error: "+err.Error(),
-		)
+			"Could not read Monitor Template",
+			"QueryMonitorTemplate",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -180,7 +192,7 @@ func (r *monitorTemplateResource) Update(ctx context.Context, req resource.Updat
 
 	item, err := r.client.UpdateMonitorTemplate(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Monitor Template", "Could not update monitor template UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Monitor Template", "Could not update monitor template UUID "+state.Uuid.ValueString(), "UpdateMonitorTemplate", err))
 		return
 	}
 
@@ -202,7 +214,7 @@ func (r *monitorTemplateResource) Delete(ctx context.Context, req resource.Delet
 
 
 	if err := r.client.DeleteMonitorTemplate(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Monitor Template", "Could not delete monitor template UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Monitor Template", "Could not delete monitor template UUID "+state.Uuid.ValueString(), "DeleteMonitorTemplate", err))
 		return
 	}
 }
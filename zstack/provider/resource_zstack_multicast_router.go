@@ -126,9 +126,18 @@ func (r *multicastRouterResource) Create(ctx context.Context, request resource.C
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	multicastRouter, err := r.client.CreateMulticastRouter(p)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating Multicast Router", "Could not create multicast router, unexpected error: "+err.Error())
+		multicastRouter, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryMulticastRouter), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Multicast Router", "CreateMulticastRouter", err, &response.Diagnostics)
 		return
 	}
 
@@ -158,12 +167,15 @@ func (r *multicastRouterResource) Read(ctx context.Context, request resource.Rea
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Multicast Router",
-			"Could not read Multicast Router, unexpected error: "+err.Error(),
-		)
+			"Could not read Multicast Router",
+			"QueryMulticastRouter",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(multicastRouter.UUID)
 	state.Name = stringValueOrNull(multicastRouter.Name)
@@ -195,7 +207,7 @@ func (r *multicastRouterResource) Delete(ctx context.Context, request resource.D
 
 	err := r.client.DeleteMulticastRouter(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Multicast Router", "Could not delete multicast router UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Multicast Router", "Could not delete multicast router UUID "+state.Uuid.ValueString(), "DeleteMulticastRouter", err))
 		return
 	}
 }
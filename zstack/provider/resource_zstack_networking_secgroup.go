@@ -7,18 +7,25 @@ import (
 	"errors"
 	"fmt"
 
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
 )
 
 var (
@@ -38,6 +45,30 @@ type securityGroupModel struct {
 	VSwitchType       types.String `tfsdk:"vswitch_type"`
 	SdnControllerUuid types.String `tfsdk:"sdn_controller_uuid"`
 	IpVersion         types.Int32  `tfsdk:"ip_version"`
+	Ingress           types.List   `tfsdk:"ingress"`
+	Egress            types.List   `tfsdk:"egress"`
+}
+
+type securityGroupInlineRuleModel struct {
+	Action                  types.String `tfsdk:"action"`
+	Protocol                types.String `tfsdk:"protocol"`
+	State                   types.String `tfsdk:"state"`
+	IpVersion               types.Int32  `tfsdk:"ip_version"`
+	IpRanges                types.String `tfsdk:"ip_ranges"`
+	DestinationPortRanges   types.String `tfsdk:"destination_port_ranges"`
+	RemoteSecurityGroupUuid types.String `tfsdk:"remote_security_group_uuid"`
+	Description             types.String `tfsdk:"description"`
+}
+
+var securityGroupInlineRuleAttrTypes = map[string]attr.Type{
+	"action":                     types.StringType,
+	"protocol":                   types.StringType,
+	"state":                      types.StringType,
+	"ip_version":                 types.Int32Type,
+	"ip_ranges":                  types.StringType,
+	"destination_port_ranges":    types.StringType,
+	"remote_security_group_uuid": types.StringType,
+	"description":                types.StringType,
 }
 
 func SecurityGroupResource() resource.Resource {
@@ -67,7 +98,8 @@ func (r *securityGroupResource) Metadata(_ context.Context, request resource.Met
 
 func (r *securityGroupResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "This resource manages a security group in ZStack.",
+		Description: "This resource manages a security group in ZStack. " +
+			"Rules can be managed inline through `ingress` and `egress`, or separately with `zstack_networking_secgroup_rule`, but not both for the same group.",
 		Attributes: map[string]schema.Attribute{
 			"uuid": schema.StringAttribute{
 				Computed:    true,
@@ -119,6 +151,75 @@ func (r *securityGroupResource) Schema(_ context.Context, request resource.Schem
 					stringvalidator.OneOf("LinuxBridge", "OvnDpdk"),
 				},
 			},
+			"ingress": securityGroupInlineRulesAttribute("Ingress"),
+			"egress":  securityGroupInlineRulesAttribute("Egress"),
+		},
+	}
+}
+
+// securityGroupInlineRulesAttribute returns the schema of the authoritative
+// rule list of one direction.
+func securityGroupInlineRulesAttribute(direction string) schema.ListNestedAttribute {
+	ipRangesDescription := "The source IP ranges of the rule, e.g., '10.0.0.0/24' or '10.0.0.1-10.0.0.10'."
+	if direction == "Egress" {
+		ipRangesDescription = "The destination IP ranges of the rule, e.g., '10.0.0.0/24' or '10.0.0.1-10.0.0.10'."
+	}
+
+	return schema.ListNestedAttribute{
+		Optional: true,
+		Description: "The complete, ordered list of " + direction + " rules of the security group. " +
+			"When set, the list is authoritative: rules that are not listed are deleted, and the rule at index i gets priority i+1. " +
+			"ZStack's default rules are left alone. When unset, " + direction + " rules are not managed by this resource. " +
+			"Do not combine with `zstack_networking_secgroup_rule` resources for the same security group.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					Required:    true,
+					Description: "The action to take when the rule matches, either 'ACCEPT' or 'DROP'.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("ACCEPT", "DROP"),
+					},
+				},
+				"protocol": schema.StringAttribute{
+					Required:    true,
+					Description: "The protocol, either 'TCP', 'UDP', 'ICMP' or 'ALL'.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("TCP", "UDP", "ICMP", "ALL"),
+					},
+				},
+				"state": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("Enabled"),
+					Description: "The state of the rule, either 'Enabled' or 'Disabled'. Defaults to 'Enabled'.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("Enabled", "Disabled"),
+					},
+				},
+				"ip_version": schema.Int32Attribute{
+					Optional:    true,
+					Description: "The IP version of the rule, either 4 or 6. Defaults to the IP version of the security group, or 4 for a dual-stack group.",
+					Validators: []validator.Int32{
+						int32validator.OneOf(4, 6),
+					},
+				},
+				"ip_ranges": schema.StringAttribute{
+					Optional:    true,
+					Description: ipRangesDescription,
+				},
+				"destination_port_ranges": schema.StringAttribute{
+					Optional:    true,
+					Description: "The destination port ranges, e.g., '80,443' or '8080-9090'.",
+				},
+				"remote_security_group_uuid": schema.StringAttribute{
+					Optional:    true,
+					Description: "The UUID of the remote security group the rule applies to.",
+				},
+				"description": schema.StringAttribute{
+					Optional:    true,
+					Description: "A description for the rule.",
+				},
+			},
 		},
 	}
 }
@@ -167,12 +268,18 @@ func (r *securityGroupResource) Create(ctx context.Context, request resource.Cre
 		p.SystemTags = []string{"SdnControllerUuid::" + u}
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	secGroup, err := r.client.CreateSecurityGroup(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Security Group",
-			"Could not create security group, unexpected error: "+err.Error(),
-		)
+		secGroup, err = adoptCreatedResource(ctx, r.client.GetSecurityGroup, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Security Group", "CreateSecurityGroup", err, &response.Diagnostics)
 		return
 	}
 
@@ -193,6 +300,17 @@ func (r *securityGroupResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	if securityGroupPlan.Ingress.IsNull() && securityGroupPlan.Egress.IsNull() {
+		return
+	}
+
+	r.applyInlineRules(ctx, &securityGroupPlan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, securityGroupPlan)
+	response.Diagnostics.Append(diags...)
 }
 
 func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -209,9 +327,10 @@ func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading Security Group", "Could not read security group UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading Security Group", "Could not read security group UUID "+state.Uuid.ValueString(), "GetSecurityGroup", err))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	if secGroups == nil {
 		response.State.RemoveResource(ctx)
@@ -243,6 +362,11 @@ func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadR
 	state.VSwitchType = types.StringValue(vsType)
 	state.Name = types.StringValue(secGroups.Name)
 
+	setSecurityGroupInlineRules(ctx, &state, sg.Rules, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	// Update State
 	diags = response.State.Set(ctx, &state)
 	response.Diagnostics.Append(diags...)
@@ -251,11 +375,23 @@ func (r *securityGroupResource) Read(ctx context.Context, request resource.ReadR
 	}
 }
 
+// Update only reconciles the inline rules; every other attribute requires
+// replacement.
 func (r *securityGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	response.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support updates. Any changes will require replacement.",
-	)
+	var plan securityGroupModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyInlineRules(ctx, &plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
 }
 
 func (r *securityGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -273,10 +409,12 @@ func (r *securityGroupResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DeleteSecurityGroup(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Security Group",
-			"Could not delete security group UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not delete security group UUID "+state.Uuid.ValueString(),
+			"DeleteSecurityGroup",
+			err,
+		))
 		return
 	}
 
@@ -285,3 +423,229 @@ func (r *securityGroupResource) Delete(ctx context.Context, request resource.Del
 func (r *securityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
+
+// applyInlineRules makes the live rules of every direction that has an
+// inline rule list match it, then reads the rules back into model.
+func (r *securityGroupResource) applyInlineRules(ctx context.Context, model *securityGroupModel, diags *diag.Diagnostics) {
+	sgUuid := model.Uuid.ValueString()
+	defaultIpVersion := securityGroupDefaultRuleIpVersion(model.IpVersion.ValueInt32())
+
+	for _, d := range []struct {
+		direction string
+		rules     types.List
+	}{{"Ingress", model.Ingress}, {"Egress", model.Egress}} {
+		if d.rules.IsNull() || d.rules.IsUnknown() {
+			continue
+		}
+		r.reconcileInlineRules(ctx, sgUuid, d.direction, d.rules, defaultIpVersion, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	sg, err := r.client.GetSecurityGroup(sgUuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error reading Security Group", "Could not read rules of security group UUID "+sgUuid, "GetSecurityGroup", err))
+		return
+	}
+	setSecurityGroupInlineRules(ctx, model, sg.Rules, diags)
+}
+
+func (r *securityGroupResource) reconcileInlineRules(ctx context.Context, sgUuid, direction string, rules types.List, defaultIpVersion int, diags *diag.Diagnostics) {
+	var models []securityGroupInlineRuleModel
+	diags.Append(rules.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	desired := make([]secGroupRuleSpec, 0, len(models))
+	for _, m := range models {
+		spec := secGroupRuleSpec{
+			Action:                  m.Action.ValueString(),
+			State:                   m.State.ValueString(),
+			Protocol:                m.Protocol.ValueString(),
+			IpVersion:               defaultIpVersion,
+			IpRanges:                m.IpRanges.ValueString(),
+			DstPortRanges:           m.DestinationPortRanges.ValueString(),
+			RemoteSecurityGroupUuid: m.RemoteSecurityGroupUuid.ValueString(),
+			Description:             m.Description.ValueString(),
+		}
+		if !m.IpVersion.IsNull() && !m.IpVersion.IsUnknown() {
+			spec.IpVersion = int(m.IpVersion.ValueInt32())
+		}
+		desired = append(desired, spec)
+	}
+
+	sg, err := r.client.GetSecurityGroup(sgUuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error reading Security Group", "Could not read rules of security group UUID "+sgUuid, "GetSecurityGroup", err))
+		return
+	}
+
+	for _, op := range planSecGroupRuleChanges(securityGroupLiveRules(sg.Rules, direction), desired) {
+		switch op.Kind {
+		case secGroupRuleDelete:
+			tflog.Debug(ctx, "Deleting security group rule", map[string]interface{}{"uuid": op.Uuid})
+			if err := r.client.DeleteSecurityGroupRule(op.Uuid, param.DeleteModePermissive); err != nil {
+				diags.Append(zstackErrorDiagnostic("Error updating Security Group rules", "Could not delete security group rule UUID "+op.Uuid, "DeleteSecurityGroupRule", err))
+				return
+			}
+		case secGroupRuleAdd:
+			tflog.Debug(ctx, "Adding security group rule", map[string]interface{}{"direction": direction, "priority": op.Priority})
+			if _, err := r.client.AddSecurityGroupRule(sgUuid, securityGroupAddRuleParam(direction, op)); err != nil {
+				diags.Append(zstackErrorDiagnostic("Error updating Security Group rules", fmt.Sprintf("Could not add %s rule with priority %d to security group UUID %s", direction, op.Priority, sgUuid), "AddSecurityGroupRule", err))
+				return
+			}
+		case secGroupRuleChange:
+			tflog.Debug(ctx, "Changing security group rule", map[string]interface{}{"uuid": op.Uuid, "priority": op.Priority})
+			if _, err := r.client.ChangeSecurityGroupRule(op.Uuid, securityGroupChangeRuleParam(direction, op)); err != nil {
+				diags.Append(zstackErrorDiagnostic("Error updating Security Group rules", "Could not change security group rule UUID "+op.Uuid, "ChangeSecurityGroupRule", err))
+				return
+			}
+		}
+	}
+}
+
+// securityGroupDefaultRuleIpVersion is the IP version of an inline rule that
+// does not set one.
+func securityGroupDefaultRuleIpVersion(groupIpVersion int32) int {
+	if groupIpVersion == 6 {
+		return 6
+	}
+	return 4
+}
+
+// securityGroupLiveRules returns the user rules of one direction ordered by
+// priority. ZStack's default rules have priority 0 and are skipped.
+func securityGroupLiveRules(rules []view.SecurityGroupRuleInventoryView, direction string) []secGroupLiveRule {
+	var live []secGroupLiveRule
+	for _, rule := range rules {
+		if rule.Type != direction || rule.Priority <= 0 {
+			continue
+		}
+		ipRanges := rule.SrcIpRange
+		if direction == "Egress" {
+			ipRanges = rule.DstIpRange
+		}
+		live = append(live, secGroupLiveRule{
+			Uuid:     rule.UUID,
+			Priority: rule.Priority,
+			Spec: secGroupRuleSpec{
+				Action:                  rule.Action,
+				State:                   rule.State,
+				Protocol:                rule.Protocol,
+				IpVersion:               rule.IpVersion,
+				IpRanges:                ipRanges,
+				DstPortRanges:           rule.DstPortRange,
+				RemoteSecurityGroupUuid: rule.RemoteSecurityGroupUuid,
+				Description:             rule.Description,
+			},
+		})
+	}
+	sort.SliceStable(live, func(i, j int) bool { return live[i].Priority < live[j].Priority })
+	return live
+}
+
+func securityGroupAddRuleParam(direction string, op secGroupRuleOp) param.AddSecurityGroupRuleParam {
+	rule := param.AddSecurityGroupRule_SecurityGroupRuleAOParam{
+		Type:      direction,
+		State:     stringPtr(op.Spec.State),
+		Protocol:  stringPtr(op.Spec.Protocol),
+		Action:    stringPtr(op.Spec.Action),
+		IpVersion: intPtr(op.Spec.IpVersion),
+	}
+	if op.Spec.IpRanges != "" {
+		if direction == "Ingress" {
+			rule.SrcIpRange = stringPtr(op.Spec.IpRanges)
+		} else {
+			rule.DstIpRange = stringPtr(op.Spec.IpRanges)
+		}
+	}
+	rule.DstPortRange = stringPtrOrNil(op.Spec.DstPortRanges)
+	rule.RemoteSecurityGroupUuid = stringPtrOrNil(op.Spec.RemoteSecurityGroupUuid)
+	rule.Description = stringPtrOrNil(op.Spec.Description)
+
+	return param.AddSecurityGroupRuleParam{
+		BaseParam: param.BaseParam{},
+		Params: param.AddSecurityGroupRuleParamDetail{
+			Rules:    []param.AddSecurityGroupRule_SecurityGroupRuleAOParam{rule},
+			Priority: intPtr(op.Priority),
+		},
+	}
+}
+
+// securityGroupChangeRuleParam sends the new priority of a moved rule and
+// the fields that differ from the live rule.
+func securityGroupChangeRuleParam(direction string, op secGroupRuleOp) param.ChangeSecurityGroupRuleParam {
+	var change param.ChangeSecurityGroupRuleParamDetail
+	if op.Priority != 0 {
+		change.Priority = intPtr(op.Priority)
+	}
+	if op.Spec.Action != op.Current.Action {
+		change.Action = stringPtr(op.Spec.Action)
+	}
+	if op.Spec.State != op.Current.State {
+		change.State = stringPtr(op.Spec.State)
+	}
+	if op.Spec.Protocol != op.Current.Protocol {
+		change.Protocol = stringPtr(op.Spec.Protocol)
+	}
+	if op.Spec.Description != op.Current.Description {
+		change.Description = stringPtr(op.Spec.Description)
+	}
+	if op.Spec.IpRanges != op.Current.IpRanges {
+		if direction == "Ingress" {
+			change.SrcIpRange = stringPtr(op.Spec.IpRanges)
+		} else {
+			change.DstIpRange = stringPtr(op.Spec.IpRanges)
+		}
+	}
+	if op.Spec.DstPortRanges != op.Current.DstPortRanges {
+		change.DstPortRange = stringPtr(op.Spec.DstPortRanges)
+	}
+	if op.Spec.RemoteSecurityGroupUuid != op.Current.RemoteSecurityGroupUuid {
+		change.RemoteSecurityGroupUuid = stringPtr(op.Spec.RemoteSecurityGroupUuid)
+	}
+
+	return param.ChangeSecurityGroupRuleParam{
+		BaseParam: param.BaseParam{},
+		Params:    change,
+	}
+}
+
+// setSecurityGroupInlineRules refreshes the inline rule lists of model from
+// the live rules. Lists that are not set stay unset, so rules managed by
+// zstack_networking_secgroup_rule do not show up as drift.
+func setSecurityGroupInlineRules(ctx context.Context, model *securityGroupModel, rules []view.SecurityGroupRuleInventoryView, diags *diag.Diagnostics) {
+	defaultIpVersion := securityGroupDefaultRuleIpVersion(model.IpVersion.ValueInt32())
+
+	inlineRules := func(direction string) types.List {
+		models := []securityGroupInlineRuleModel{}
+		for _, rule := range securityGroupLiveRules(rules, direction) {
+			m := securityGroupInlineRuleModel{
+				Action:                  types.StringValue(rule.Spec.Action),
+				Protocol:                types.StringValue(rule.Spec.Protocol),
+				State:                   types.StringValue(rule.Spec.State),
+				IpVersion:               types.Int32Null(),
+				IpRanges:                stringValueOrNull(rule.Spec.IpRanges),
+				DestinationPortRanges:   stringValueOrNull(rule.Spec.DstPortRanges),
+				RemoteSecurityGroupUuid: stringValueOrNull(rule.Spec.RemoteSecurityGroupUuid),
+				Description:             stringValueOrNull(rule.Spec.Description),
+			}
+			if rule.Spec.IpVersion != defaultIpVersion {
+				m.IpVersion = types.Int32Value(int32(rule.Spec.IpVersion))
+			}
+			models = append(models, m)
+		}
+		list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: securityGroupInlineRuleAttrTypes}, models)
+		diags.Append(d...)
+		return list
+	}
+
+	if !model.Ingress.IsNull() {
+		model.Ingress = inlineRules("Ingress")
+	}
+	if !model.Egress.IsNull() {
+		model.Egress = inlineRules("Egress")
+	}
+}
@@ -60,7 +60,8 @@ func (r *securityGroupAttachmentResource) Metadata(_ context.Context, request re
 
 func (r *securityGroupAttachmentResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "Attach VM instance NICs to security groups in ZStack.",
+		Description: "Attach VM instance NICs to security groups in ZStack. " +
+			"Do not use it for a NIC whose security groups are set by `zstack_vm_nic_security_groups`.",
 		Attributes: map[string]schema.Attribute{
 			"secgroup_uuid": schema.StringAttribute{
 				Required:    true,
@@ -109,14 +110,26 @@ func (r *securityGroupAttachmentResource) Create(ctx context.Context, request re
 		"nic_uuid":      nicUUID,
 	})
 
-	attached, err := r.isNicAttached(secgroupUUID, nicUUID)
+	unlock, err := r.lockNicParents(ctx, secgroupUUID, nicUUID)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating Security Group Attachment",
-			"Could not query NIC membership: "+err.Error(),
+			"Could not lock the NIC's VM instance and security group: "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	attached, err := r.isNicAttached(secgroupUUID, nicUUID)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating Security Group Attachment",
+			"Could not query NIC membership",
+			"QueryVmNicInSecurityGroup",
+			err,
+		))
+		return
+	}
 
 	if !attached {
 		_, err = r.client.AddVmNicToSecurityGroup(secgroupUUID, param.AddVmNicToSecurityGroupParam{
@@ -126,10 +139,12 @@ func (r *securityGroupAttachmentResource) Create(ctx context.Context, request re
 			},
 		})
 		if err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating Security Group Attachment",
-				"Could not add VM NIC to security group: "+err.Error(),
-			)
+				"Could not add VM NIC to security group",
+				"AddVmNicToSecurityGroup",
+				err,
+			))
 			return
 		}
 	}
@@ -157,10 +172,12 @@ func (r *securityGroupAttachmentResource) Read(ctx context.Context, req resource
 
 	attached, err := r.isNicAttached(secgroupUUID, vmNicUUID)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Group Attachment",
-			"Could not query NIC membership: "+err.Error(),
-		)
+			"Could not query NIC membership",
+			"QueryVmNicInSecurityGroup",
+			err,
+		))
 		return
 	}
 
@@ -206,12 +223,22 @@ func (r *securityGroupAttachmentResource) Delete(ctx context.Context, request re
 		return
 	}
 
+	unlock, err := r.lockNicParents(ctx, secgroupUUID, nicUUID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error detaching VM NIC from Security Group",
+			"Could not lock the NIC's VM instance and security group: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
 	// The SDK's DeleteVmNicFromSecurityGroup is buggy — it sends
 	// DELETE /v1/security-groups/{nicUUID} which deletes the security group
 	// instead of detaching the NIC.  The correct endpoint is:
 	//   DELETE /v1/security-groups/{sgUUID}/vm-instances/nics?vmNicUuids={nicUUID}
 	// We call DeleteWithSpec directly to construct the correct URL.
-	err := r.client.DeleteWithSpec(
+	err = r.client.DeleteWithSpec(
 		"v1/security-groups",
 		secgroupUUID,
 		"vm-instances/nics",
@@ -223,17 +250,21 @@ func (r *securityGroupAttachmentResource) Delete(ctx context.Context, request re
 		// If it is no longer attached, the detach error is harmless and we can remove state.
 		attached, queryErr := r.isNicAttached(secgroupUUID, nicUUID)
 		if queryErr != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error detaching VM NIC from Security Group",
-				fmt.Sprintf("Detach failed (%s) and could not verify attachment status: %s", err.Error(), queryErr.Error()),
-			)
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"QueryVmNicInSecurityGroup",
+				queryErr,
+			))
 			return
 		}
 		if attached {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error detaching VM NIC from Security Group",
-				"Could not detach VM NIC from security group: "+err.Error(),
-			)
+				"Could not detach VM NIC from security group",
+				"DeleteWithSpec",
+				err,
+			))
 			return
 		}
 		// NIC is no longer attached — safe to remove state.
@@ -265,3 +296,14 @@ func (r *securityGroupAttachmentResource) isNicAttached(secgroupUUID, nicUUID st
 	}
 	return len(refs) > 0, nil
 }
+
+// lockNicParents takes the parent locks for a membership change: the VM the
+// NIC belongs to and the security group itself. A NIC that is not attached to
+// a VM (or cannot be looked up) only locks the security group.
+func (r *securityGroupAttachmentResource) lockNicParents(ctx context.Context, secgroupUUID, nicUUID string) (func(), error) {
+	vmUUID := ""
+	if nic, err := findResourceByQuery(r.client.QueryVmNic, nicUUID); err == nil {
+		vmUUID = nic.VmInstanceUuid
+	}
+	return lockParents(ctx, vmUUID, secgroupUUID)
+}
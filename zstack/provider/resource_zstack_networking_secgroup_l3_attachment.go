@@ -0,0 +1,295 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &securityGroupL3AttachmentResource{}
+	_ resource.ResourceWithConfigure   = &securityGroupL3AttachmentResource{}
+	_ resource.ResourceWithImportState = &securityGroupL3AttachmentResource{}
+)
+
+type securityGroupL3AttachmentResource struct {
+	client *client.ZSClient
+}
+
+type securityGroupL3AttachmentModel struct {
+	ID                types.String `tfsdk:"id"`
+	SecurityGroupUuid types.String `tfsdk:"secgroup_uuid"`
+	L3NetworkUuid     types.String `tfsdk:"l3_network_uuid"`
+}
+
+func SecurityGroupL3AttachmentResource() resource.Resource {
+	return &securityGroupL3AttachmentResource{}
+}
+
+func (r *securityGroupL3AttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *securityGroupL3AttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networking_secgroup_l3_attachment"
+}
+
+func (r *securityGroupL3AttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach a ZStack security group to an L3 network. The security group applies to every VM NIC on the L3 network. " +
+			"Destroying this resource detaches the security group from the L3 network without deleting either resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `secgroup_uuid:l3_network_uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secgroup_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the security group to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"l3_network_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the L3 network to attach the security group to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupL3AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupL3AttachmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	secgroupUuid := plan.SecurityGroupUuid.ValueString()
+	l3NetworkUuid := plan.L3NetworkUuid.ValueString()
+	plan.ID = types.StringValue(securityGroupL3AttachmentID(secgroupUuid, l3NetworkUuid))
+
+	unlock, err := lockParents(ctx, secgroupUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Security Group L3 Attachment",
+			"Could not lock the security group and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	attached, err := r.isSecurityGroupAttachedToL3Network(secgroupUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating Security Group L3 Attachment",
+			"Could not query security group L3 network attachment",
+			"GetSecurityGroup",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		_, err = r.client.AttachSecurityGroupToL3Network(secgroupUuid, l3NetworkUuid, param.AttachSecurityGroupToL3NetworkParam{
+			BaseParam: param.BaseParam{},
+			Params:    param.AttachSecurityGroupToL3NetworkParamDetail{},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating Security Group L3 Attachment",
+				fmt.Sprintf("Could not attach security group %s to L3 network %s", secgroupUuid, l3NetworkUuid),
+				"AttachSecurityGroupToL3Network",
+				err,
+			))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Security group L3 attachment created", map[string]any{
+		"id":              plan.ID.ValueString(),
+		"secgroup_uuid":   secgroupUuid,
+		"l3_network_uuid": l3NetworkUuid,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *securityGroupL3AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state securityGroupL3AttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attached, err := r.isSecurityGroupAttachedToL3Network(state.SecurityGroupUuid.ValueString(), state.L3NetworkUuid.ValueString())
+	if err != nil {
+		if isZStackNotFoundError(err) || errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Security Group L3 Attachment",
+			"Could not query security group L3 network attachment",
+			"GetSecurityGroup",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(securityGroupL3AttachmentID(state.SecurityGroupUuid.ValueString(), state.L3NetworkUuid.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupL3AttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"This resource does not support updates. Any changes require replacement.",
+	)
+}
+
+func (r *securityGroupL3AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state securityGroupL3AttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	secgroupUuid := state.SecurityGroupUuid.ValueString()
+	l3NetworkUuid := state.L3NetworkUuid.ValueString()
+
+	tflog.Debug(ctx, "Deleting security group L3 attachment", map[string]any{
+		"id":              state.ID.ValueString(),
+		"secgroup_uuid":   secgroupUuid,
+		"l3_network_uuid": l3NetworkUuid,
+	})
+
+	unlock, err := lockParents(ctx, secgroupUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Security Group L3 Attachment",
+			"Could not lock the security group and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DetachSecurityGroupFromL3Network(secgroupUuid, l3NetworkUuid, param.DeleteModePermissive)
+	if err != nil {
+		attached, queryErr := r.isSecurityGroupAttachedToL3Network(secgroupUuid, l3NetworkUuid)
+		if queryErr != nil {
+			if isZStackNotFoundError(queryErr) || errors.Is(queryErr, ErrResourceNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Security Group L3 Attachment",
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"GetSecurityGroup",
+				queryErr,
+			))
+			return
+		}
+		if attached {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Security Group L3 Attachment",
+				fmt.Sprintf("Could not detach security group %s from L3 network %s", secgroupUuid, l3NetworkUuid),
+				"DetachSecurityGroupFromL3Network",
+				err,
+			))
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *securityGroupL3AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	secgroupUuid, l3NetworkUuid, err := parseSecurityGroupL3AttachmentID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: secgroup_uuid:l3_network_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), securityGroupL3AttachmentID(secgroupUuid, l3NetworkUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secgroup_uuid"), secgroupUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("l3_network_uuid"), l3NetworkUuid)...)
+}
+
+func (r *securityGroupL3AttachmentResource) isSecurityGroupAttachedToL3Network(secgroupUuid, l3NetworkUuid string) (bool, error) {
+	secGroup, err := findResourceByGet(r.client.GetSecurityGroup, secgroupUuid)
+	if err != nil {
+		return false, err
+	}
+
+	for _, attachedL3NetworkUuid := range secGroup.AttachedL3NetworkUuids {
+		if attachedL3NetworkUuid == l3NetworkUuid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func securityGroupL3AttachmentID(secgroupUuid, l3NetworkUuid string) string {
+	return fmt.Sprintf("%s:%s", secgroupUuid, l3NetworkUuid)
+}
+
+func parseSecurityGroupL3AttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected secgroup_uuid:l3_network_uuid")
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+func TestSecurityGroupL3AttachmentResource_Schema(t *testing.T) {
+	var r securityGroupL3AttachmentResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"secgroup_uuid", "l3_network_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	id, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Fatal("schema missing computed attribute \"id\"")
+	}
+	if !id.IsComputed() {
+		t.Error("attribute \"id\" should be computed")
+	}
+}
+
+func TestSecurityGroupL3AttachmentResource_Metadata(t *testing.T) {
+	var r securityGroupL3AttachmentResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_networking_secgroup_l3_attachment" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestParseSecurityGroupL3AttachmentID(t *testing.T) {
+	secgroupUuid, l3NetworkUuid, err := parseSecurityGroupL3AttachmentID("sg-uuid:l3-uuid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secgroupUuid != "sg-uuid" || l3NetworkUuid != "l3-uuid" {
+		t.Fatalf("unexpected parsed id: %q %q", secgroupUuid, l3NetworkUuid)
+	}
+
+	invalidIDs := []string{"", "sg-uuid", ":l3-uuid", "sg-uuid:"}
+	for _, id := range invalidIDs {
+		if _, _, err := parseSecurityGroupL3AttachmentID(id); err == nil {
+			t.Fatalf("expected error for invalid id %q", id)
+		}
+	}
+}
+
+func TestAccSecurityGroupL3AttachmentResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	cli := testAccClientLoggedIn()
+	q := param.NewQueryParam()
+	q.AddQ("networkServiceType=SecurityGroup")
+	refs, err := cli.QueryNetworkServiceL3NetworkRef(&q)
+	if err != nil {
+		t.Fatalf("query SecurityGroup-enabled L3 refs: %v", err)
+	}
+	if len(refs) == 0 {
+		t.Skip("no L3 network with SecurityGroup service enabled")
+	}
+	l3NetworkUuid := refs[0].L3NetworkUuid
+	name := testAccName("sg-l3-attach")
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []tfresource.TestStep{
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "zstack_networking_secgroup" "test" {
+  name       = %q
+  ip_version = 4
+}
+
+resource "zstack_networking_secgroup_l3_attachment" "test" {
+  secgroup_uuid   = zstack_networking_secgroup.test.uuid
+  l3_network_uuid = %q
+}
+`, name, l3NetworkUuid),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_networking_secgroup_l3_attachment.test", tfjsonpath.New("l3_network_uuid"), knownvalue.StringExact(l3NetworkUuid)),
+					statecheck.ExpectKnownValue("zstack_networking_secgroup_l3_attachment.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+				},
+			},
+			{
+				ResourceName:                         "zstack_networking_secgroup_l3_attachment.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+			},
+		},
+	})
+}
@@ -74,7 +74,8 @@ func (r *securityGroupRuleResource) Metadata(_ context.Context, request resource
 
 func (r *securityGroupRuleResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "This resource manages a security group rule in ZStack.",
+		Description: "This resource manages a security group rule in ZStack. " +
+			"Do not use it for a security group whose rules are managed inline through the `ingress` or `egress` attributes of `zstack_networking_secgroup`.",
 		Attributes: map[string]schema.Attribute{
 			"uuid": schema.StringAttribute{
 				Computed:    true,
@@ -221,10 +222,12 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, request resource
 	securityGroupUuid := rulePlan.SecurityGroupUuid.ValueString()
 	respPtr, err := r.client.AddSecurityGroupRule(securityGroupUuid, params)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating Security Group Rule",
-			"Could not create security group rule, unexpected error: "+err.Error(),
-		)
+			"Could not create security group rule",
+			"AddSecurityGroupRule",
+			err,
+		))
 		return
 	}
 	resp := respPtr
@@ -350,7 +353,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading Security Group Rule", "Could not read security group rule UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading Security Group Rule", "Could not read security group rule UUID "+state.Uuid.ValueString(), "GetSecurityGroupRule", err))
 		return
 	}
 
@@ -464,13 +467,13 @@ func (r *securityGroupRuleResource) Update(ctx context.Context, request resource
 		Params:    change,
 	})
 	if err != nil {
-		response.Diagnostics.AddError("Error updating Security Group Rule", "Could not update security group rule UUID "+ruleUUID+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating Security Group Rule", "Could not update security group rule UUID "+ruleUUID, "ChangeSecurityGroupRule", err))
 		return
 	}
 
 	rule, err := r.client.GetSecurityGroupRule(ruleUUID)
 	if err != nil {
-		response.Diagnostics.AddError("Error reading Security Group Rule", "Could not read security group rule after update UUID "+ruleUUID+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading Security Group Rule", "Could not read security group rule after update UUID "+ruleUUID, "GetSecurityGroupRule", err))
 		return
 	}
 	_ = needUpdate
@@ -522,10 +525,12 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, request resource
 
 	err := r.client.DeleteSecurityGroupRule(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Security Group Rule",
-			"Could not delete security group rule UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not delete security group rule UUID "+state.Uuid.ValueString(),
+			"DeleteSecurityGroupRule",
+			err,
+		))
 		return
 	}
 
@@ -553,10 +558,12 @@ func (r *securityGroupRuleResource) ImportState(ctx context.Context, request res
 	// Call the API to get the security group rule details
 	rule, err := r.client.GetSecurityGroupRule(resourceID)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Failed to import security group rule",
-			fmt.Sprintf("Error retrieving security group rule with UUID %s: %s", resourceID, err.Error()),
-		)
+			fmt.Sprintf("Error retrieving security group rule with UUID %s", resourceID),
+			"GetSecurityGroupRule",
+			err,
+		))
 		return
 	}
 	if rule == nil || rule.UUID == "" {
@@ -4,6 +4,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -42,6 +44,17 @@ func TestNetworkingSecgroupResource_Schema(t *testing.T) {
 			t.Errorf("attribute %q should be computed", attr)
 		}
 	}
+
+	optional := []string{"description", "sdn_controller_uuid", "vswitch_type", "ingress", "egress"}
+	for _, attr := range optional {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+		if !a.IsOptional() {
+			t.Errorf("attribute %q should be optional", attr)
+		}
+	}
 }
 
 func TestNetworkingSecgroupResource_Metadata(t *testing.T) {
@@ -53,6 +66,110 @@ func TestNetworkingSecgroupResource_Metadata(t *testing.T) {
 	}
 }
 
+func TestPlanSecGroupRuleChanges(t *testing.T) {
+	ssh := secGroupRuleSpec{Action: "ACCEPT", State: "Enabled", Protocol: "TCP", IpVersion: 4, IpRanges: "0.0.0.0/0", DstPortRanges: "22"}
+	web := secGroupRuleSpec{Action: "ACCEPT", State: "Enabled", Protocol: "TCP", IpVersion: 4, IpRanges: "0.0.0.0/0", DstPortRanges: "80,443"}
+	icmp := secGroupRuleSpec{Action: "ACCEPT", State: "Enabled", Protocol: "ICMP", IpVersion: 4, IpRanges: "10.0.0.0/8"}
+	dropSsh := ssh
+	dropSsh.Action = "DROP"
+
+	live := func(specs ...secGroupRuleSpec) []secGroupLiveRule {
+		rules := make([]secGroupLiveRule, 0, len(specs))
+		for i, spec := range specs {
+			rules = append(rules, secGroupLiveRule{Uuid: fmt.Sprintf("rule-%d", i+1), Priority: i + 1, Spec: spec})
+		}
+		return rules
+	}
+
+	cases := []struct {
+		name    string
+		live    []secGroupLiveRule
+		desired []secGroupRuleSpec
+		want    []secGroupRuleOp
+	}{
+		{
+			name:    "create all",
+			desired: []secGroupRuleSpec{ssh, web},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleAdd, Priority: 1, Spec: ssh},
+				{Kind: secGroupRuleAdd, Priority: 2, Spec: web},
+			},
+		},
+		{
+			name:    "in sync",
+			live:    live(ssh, web),
+			desired: []secGroupRuleSpec{ssh, web},
+		},
+		{
+			name:    "delete all",
+			live:    live(ssh, web),
+			desired: []secGroupRuleSpec{},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleDelete, Uuid: "rule-1"},
+				{Kind: secGroupRuleDelete, Uuid: "rule-2"},
+			},
+		},
+		{
+			name:    "swap moves one rule",
+			live:    live(ssh, web),
+			desired: []secGroupRuleSpec{web, ssh},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleChange, Uuid: "rule-2", Priority: 1, Spec: web, Current: web},
+			},
+		},
+		{
+			name:    "delete from middle keeps the rest",
+			live:    live(ssh, web, icmp),
+			desired: []secGroupRuleSpec{ssh, icmp},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleDelete, Uuid: "rule-2"},
+			},
+		},
+		{
+			name:    "insert at front shifts the rest",
+			live:    live(ssh, web),
+			desired: []secGroupRuleSpec{icmp, ssh, web},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleAdd, Priority: 1, Spec: icmp},
+			},
+		},
+		{
+			name:    "action change is in place",
+			live:    live(ssh, web),
+			desired: []secGroupRuleSpec{dropSsh, web},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleChange, Uuid: "rule-1", Spec: dropSsh, Current: ssh},
+			},
+		},
+		{
+			name:    "exact match wins over partial match",
+			live:    live(dropSsh, ssh),
+			desired: []secGroupRuleSpec{ssh},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleDelete, Uuid: "rule-1"},
+			},
+		},
+		{
+			name:    "replace changed match key",
+			live:    live(ssh),
+			desired: []secGroupRuleSpec{web},
+			want: []secGroupRuleOp{
+				{Kind: secGroupRuleDelete, Uuid: "rule-1"},
+				{Kind: secGroupRuleAdd, Priority: 1, Spec: web},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := planSecGroupRuleChanges(tc.live, tc.desired)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("planSecGroupRuleChanges() =\n%+v\nwant\n%+v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestAccSecurityGroupResource_disappears(t *testing.T) {
 	_ = loadEnvData(t)
 
@@ -112,3 +229,73 @@ resource "zstack_networking_secgroup" "test" {
 		},
 	})
 }
+
+func TestAccSecurityGroupResource_inlineRules(t *testing.T) {
+	_ = loadEnvData(t)
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []tfresource.TestStep{
+			// Step 1: Create with one ingress rule
+			{
+				Config: providerConfig() + `
+resource "zstack_networking_secgroup" "test" {
+  name       = "acc-test-secgroup-inline"
+  ip_version = 4
+
+  ingress = [
+    {
+      action                  = "ACCEPT"
+      protocol                = "TCP"
+      ip_ranges               = "0.0.0.0/0"
+      destination_port_ranges = "22"
+    },
+  ]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_networking_secgroup.test", tfjsonpath.New("ingress"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("zstack_networking_secgroup.test", tfjsonpath.New("ingress").AtSliceIndex(0).AtMapKey("state"), knownvalue.StringExact("Enabled")),
+				},
+			},
+			// Step 2: Update — insert a rule in front and manage egress
+			{
+				Config: providerConfig() + `
+resource "zstack_networking_secgroup" "test" {
+  name       = "acc-test-secgroup-inline"
+  ip_version = 4
+
+  ingress = [
+    {
+      action                  = "ACCEPT"
+      protocol                = "TCP"
+      ip_ranges               = "0.0.0.0/0"
+      destination_port_ranges = "80,443"
+    },
+    {
+      action                  = "ACCEPT"
+      protocol                = "TCP"
+      ip_ranges               = "0.0.0.0/0"
+      destination_port_ranges = "22"
+    },
+  ]
+
+  egress = [
+    {
+      action    = "DROP"
+      protocol  = "ALL"
+      ip_ranges = "10.0.0.0/8"
+    },
+  ]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_networking_secgroup.test", tfjsonpath.New("ingress"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("zstack_networking_secgroup.test", tfjsonpath.New("ingress").AtSliceIndex(0).AtMapKey("destination_port_ranges"), knownvalue.StringExact("80,443")),
+					statecheck.ExpectKnownValue("zstack_networking_secgroup.test", tfjsonpath.New("egress"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
@@ -131,12 +131,18 @@ func (r *nvmeServerResource) Create(ctx context.Context, request resource.Create
 		p.Params.Port = intPtr(int(plan.Port.ValueInt64()))
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	server, err := r.client.AddNvmeServer(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating NVMe Server",
-			"Could not create NVMe server, unexpected error: "+err.Error(),
-		)
+		server, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryNvmeServer), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "NVMe Server", "AddNvmeServer", err, &response.Diagnostics)
 		return
 	}
 
@@ -165,12 +171,15 @@ func (r *nvmeServerResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading NVMe Server",
-			"Could not read NVMe Server, unexpected error: "+err.Error(),
-		)
+			"Could not read NVMe Server",
+			"QueryNvmeServer",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(server.UUID)
 	state.Name = stringValueOrNull(server.Name)
@@ -201,7 +210,7 @@ func (r *nvmeServerResource) Delete(ctx context.Context, request resource.Delete
 
 	err := r.client.DeleteNvmeServer(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting NVMe Server", "Could not delete NVMe server UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting NVMe Server", "Could not delete NVMe server UUID "+state.Uuid.ValueString(), "DeleteNvmeServer", err))
 		return
 	}
 }
@@ -153,12 +153,18 @@ func (r *pciDeviceOfferingResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	offering, err := r.client.CreatePciDeviceOffering(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating PCI Device Offering",
-			"Could not create PCI device offering, unexpected error: "+err.Error(),
-		)
+		offering, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPciDeviceOffering), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "PCI Device Offering", "CreatePciDeviceOffering", err, &response.Diagnostics)
 		return
 	}
 
@@ -190,12 +196,15 @@ func (r *pciDeviceOfferingResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading PCI Device Offering",
-			"Could not read PCI Device Offering, unexpected error: "+err.Error(),
-		)
+			"Could not read PCI Device Offering",
+			"QueryPciDeviceOffering",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(offering.UUID)
 	state.Name = stringValueOrNull(offering.Name)
@@ -229,7 +238,7 @@ func (r *pciDeviceOfferingResource) Delete(ctx context.Context, request resource
 
 	err := r.client.DeletePciDeviceOffering(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting PCI Device Offering", "Could not delete PCI device offering UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting PCI Device Offering", "Could not delete PCI device offering UUID "+state.Uuid.ValueString(), "DeletePciDeviceOffering", err))
 		return
 	}
 }
@@ -182,12 +182,18 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreatePolicy(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Policy",
-			"Could not create policy, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPolicy), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Policy", "CreatePolicy", err, &resp.Diagnostics)
 		return
 	}
 
@@ -265,12 +271,15 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Policy",
-			"Could not read policy UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read policy UUID "+state.Uuid.ValueString(),
+			"QueryPolicy",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Name = types.StringValue(policy.Name)
 	state.AccountUuid = types.StringValue(policy.AccountUuid)
@@ -322,10 +331,12 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeletePolicy(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Policy",
-			"Could not delete policy, unexpected error: "+err.Error(),
-		)
+			"Could not delete policy",
+			"DeletePolicy",
+			err,
+		))
 		return
 	}
 
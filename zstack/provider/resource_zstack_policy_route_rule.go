@@ -72,7 +72,7 @@ func (r *policyRouteRuleResource) Schema(_ context.Context, _ resource.SchemaReq
 			},
 			"table_uuid": schema.StringAttribute{
 				Required:    true,
-				Description: "The UUID of the routing table.",
+				Description: "The UUID of the policy route table that matching traffic is routed by, e.g. `zstack_policy_route_table.<name>.uuid`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -194,12 +194,18 @@ func (r *policyRouteRuleResource) Create(ctx context.Context, req resource.Creat
 		createParam.Params.Protocol = stringPtr(plan.Protocol.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreatePolicyRouteRule(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Policy Route Rule",
-			"Could not create policy route rule, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPolicyRouteRule), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Policy Route Rule", "CreatePolicyRouteRule", err, &resp.Diagnostics)
 		return
 	}
 
@@ -241,12 +247,15 @@ func (r *policyRouteRuleResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Policy Route Rule",
-			"Could not read policy route rule, unexpected error: "+err.Error(),
-		)
+			"Could not read policy route rule",
+			"QueryPolicyRouteRule",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(rule.UUID)
 	state.RuleSetUuid = types.StringValue(rule.RuleSetUuid)
@@ -285,10 +294,12 @@ func (r *policyRouteRuleResource) Delete(ctx context.Context, req resource.Delet
 
 	err := r.client.DeletePolicyRouteRule(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Policy Route Rule",
-			"Could not delete policy route rule UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not delete policy route rule UUID "+state.Uuid.ValueString(),
+			"DeletePolicyRouteRule",
+			err,
+		))
 		return
 	}
 
@@ -131,14 +131,30 @@ func (r *policyRouteRuleSetResource) Create(ctx context.Context, req resource.Cr
 		createParam.Params.Type = stringPtr(plan.Type.ValueString())
 	}
 
-	result, err := r.client.CreatePolicyRouteRuleSet(createParam)
+	unlock, err := lockParents(ctx, plan.VrouterUuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Policy Route Rule Set",
-			"Could not create policy route rule set, unexpected error: "+err.Error(),
+			"Could not lock virtual router "+plan.VrouterUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	result, err := r.client.CreatePolicyRouteRuleSet(createParam)
+	if err != nil {
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPolicyRouteRuleSet), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Policy Route Rule Set", "CreatePolicyRouteRuleSet", err, &resp.Diagnostics)
+		return
+	}
 
 	plan.Uuid = types.StringValue(result.UUID)
 	plan.Name = types.StringValue(result.Name)
@@ -172,12 +188,15 @@ func (r *policyRouteRuleSetResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Policy Route Rule Set",
-			"Could not read policy route rule set, unexpected error: "+err.Error(),
-		)
+			"Could not read policy route rule set",
+			"QueryPolicyRouteRuleSet",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(ruleSet.UUID)
 	state.Name = types.StringValue(ruleSet.Name)
@@ -210,10 +229,12 @@ func (r *policyRouteRuleSetResource) Update(ctx context.Context, req resource.Up
 
 	result, err := r.client.UpdatePolicyRouteRuleSet(plan.Uuid.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Policy Route Rule Set",
-			"Could not update policy route rule set UUID "+plan.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not update policy route rule set UUID "+plan.Uuid.ValueString(),
+			"UpdatePolicyRouteRuleSet",
+			err,
+		))
 		return
 	}
 
@@ -243,14 +264,26 @@ func (r *policyRouteRuleSetResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := r.client.DeletePolicyRouteRuleSet(state.Uuid.ValueString(), param.DeleteModePermissive)
+	unlock, err := lockParents(ctx, state.VrouterUuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Policy Route Rule Set",
-			"Could not delete policy route rule set UUID "+state.Uuid.ValueString()+": "+err.Error(),
+			"Could not lock virtual router "+state.VrouterUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	err = r.client.DeletePolicyRouteRuleSet(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Policy Route Rule Set",
+			"Could not delete policy route rule set UUID "+state.Uuid.ValueString(),
+			"DeletePolicyRouteRuleSet",
+			err,
+		))
+		return
+	}
 
 	tflog.Info(ctx, "Policy Route Rule Set deleted", map[string]interface{}{
 		"uuid": state.Uuid.ValueString(),
@@ -0,0 +1,278 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &policyRouteRuleSetAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &policyRouteRuleSetAttachmentResource{}
+	_ resource.ResourceWithImportState = &policyRouteRuleSetAttachmentResource{}
+)
+
+type policyRouteRuleSetAttachmentResource struct {
+	client *client.ZSClient
+}
+
+type policyRouteRuleSetAttachmentModel struct {
+	ID            types.String `tfsdk:"id"`
+	RuleSetUuid   types.String `tfsdk:"rule_set_uuid"`
+	L3NetworkUuid types.String `tfsdk:"l3_network_uuid"`
+}
+
+func PolicyRouteRuleSetAttachmentResource() resource.Resource {
+	return &policyRouteRuleSetAttachmentResource{}
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_route_rule_set_attachment"
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Binds a Policy Route Rule Set to the VPC router interface on an L3 network, so that the rules apply to traffic " +
+			"entering the router from that network. Destroying this resource unbinds the rule set without deleting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `rule_set_uuid:l3_network_uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_set_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the Policy Route Rule Set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"l3_network_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the L3 network of the router interface. The rule set's virtual router must have a NIC on it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyRouteRuleSetAttachmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleSetUuid := plan.RuleSetUuid.ValueString()
+	l3NetworkUuid := plan.L3NetworkUuid.ValueString()
+	plan.ID = types.StringValue(policyRouteRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid))
+
+	unlock, err := lockParents(ctx, ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Policy Route Rule Set Attachment",
+			"Could not lock the rule set and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	attached, err := r.isAttached(ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating Policy Route Rule Set Attachment",
+			"Could not query policy route rule set L3 network attachments",
+			"QueryPolicyRouteRuleSetL3Ref",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		_, err = r.client.AttachPolicyRouteRuleSetToL3(ruleSetUuid, l3NetworkUuid, param.AttachPolicyRouteRuleSetToL3Param{
+			BaseParam: param.BaseParam{},
+			Params:    param.AttachPolicyRouteRuleSetToL3ParamDetail{},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating Policy Route Rule Set Attachment",
+				fmt.Sprintf("Could not attach policy route rule set %s to L3 network %s", ruleSetUuid, l3NetworkUuid),
+				"AttachPolicyRouteRuleSetToL3",
+				err,
+			))
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Policy Route Rule Set attached", map[string]interface{}{
+		"rule_set_uuid":   ruleSetUuid,
+		"l3_network_uuid": l3NetworkUuid,
+	})
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyRouteRuleSetAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attached, err := r.isAttached(state.RuleSetUuid.ValueString(), state.L3NetworkUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Policy Route Rule Set Attachment",
+			"Could not query policy route rule set L3 network attachments",
+			"QueryPolicyRouteRuleSetL3Ref",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		tflog.Warn(ctx, "Policy Route Rule Set attachment not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(policyRouteRuleSetAttachmentID(state.RuleSetUuid.ValueString(), state.L3NetworkUuid.ValueString()))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Policy Route Rule Set Attachment does not support updates. All fields require replacement.",
+	)
+}
+
+func (r *policyRouteRuleSetAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyRouteRuleSetAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleSetUuid := state.RuleSetUuid.ValueString()
+	l3NetworkUuid := state.L3NetworkUuid.ValueString()
+
+	unlock, err := lockParents(ctx, ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Policy Route Rule Set Attachment",
+			"Could not lock the rule set and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DetachPolicyRouteRuleSetFromL3(ruleSetUuid, l3NetworkUuid, param.DeleteModePermissive)
+	if err != nil {
+		attached, queryErr := r.isAttached(ruleSetUuid, l3NetworkUuid)
+		if queryErr != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Policy Route Rule Set Attachment",
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"QueryPolicyRouteRuleSetL3Ref",
+				queryErr,
+			))
+			return
+		}
+		if attached {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting Policy Route Rule Set Attachment",
+				fmt.Sprintf("Could not detach policy route rule set %s from L3 network %s", ruleSetUuid, l3NetworkUuid),
+				"DetachPolicyRouteRuleSetFromL3",
+				err,
+			))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Policy Route Rule Set detached", map[string]interface{}{
+		"rule_set_uuid":   ruleSetUuid,
+		"l3_network_uuid": l3NetworkUuid,
+	})
+}
+
+func (r *policyRouteRuleSetAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ruleSetUuid, l3NetworkUuid, err := parsePolicyRouteRuleSetAttachmentID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: rule_set_uuid:l3_network_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyRouteRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_set_uuid"), ruleSetUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("l3_network_uuid"), l3NetworkUuid)...)
+}
+
+// isAttached reports whether the rule set is bound to the L3 network. A
+// deleted rule set or L3 network has no references, so it reads as detached.
+func (r *policyRouteRuleSetAttachmentResource) isAttached(ruleSetUuid, l3NetworkUuid string) (bool, error) {
+	q := param.NewQueryParam()
+	q.AddQ("ruleSetUuid=" + ruleSetUuid)
+	q.AddQ("l3NetworkUuid=" + l3NetworkUuid)
+	refs, err := r.client.QueryPolicyRouteRuleSetL3Ref(&q)
+	if err != nil {
+		return false, err
+	}
+	return len(refs) > 0, nil
+}
+
+func policyRouteRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid string) string {
+	return fmt.Sprintf("%s:%s", ruleSetUuid, l3NetworkUuid)
+}
+
+func parsePolicyRouteRuleSetAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected rule_set_uuid:l3_network_uuid")
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestPolicyRouteRuleSetAttachmentResource_Schema(t *testing.T) {
+	var r policyRouteRuleSetAttachmentResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	required := []string{"rule_set_uuid", "l3_network_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"id"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestPolicyRouteRuleSetAttachmentResource_Metadata(t *testing.T) {
+	var r policyRouteRuleSetAttachmentResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_policy_route_rule_set_attachment" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestParsePolicyRouteRuleSetAttachmentID(t *testing.T) {
+	ruleSetUuid, l3NetworkUuid, err := parsePolicyRouteRuleSetAttachmentID("rs-uuid:l3-uuid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ruleSetUuid != "rs-uuid" || l3NetworkUuid != "l3-uuid" {
+		t.Fatalf("unexpected parsed id: %q %q", ruleSetUuid, l3NetworkUuid)
+	}
+
+	invalidIDs := []string{"", "rs-uuid", ":l3-uuid", "rs-uuid:"}
+	for _, id := range invalidIDs {
+		if _, _, err := parsePolicyRouteRuleSetAttachmentID(id); err == nil {
+			t.Fatalf("expected error for invalid id %q", id)
+		}
+	}
+}
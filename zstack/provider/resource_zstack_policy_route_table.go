@@ -0,0 +1,245 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &policyRouteTableResource{}
+	_ resource.ResourceWithConfigure   = &policyRouteTableResource{}
+	_ resource.ResourceWithImportState = &policyRouteTableResource{}
+)
+
+type policyRouteTableResource struct {
+	client *client.ZSClient
+}
+
+type policyRouteTableModel struct {
+	Uuid        types.String `tfsdk:"uuid"`
+	VrouterUuid types.String `tfsdk:"vrouter_uuid"`
+	Number      types.Int64  `tfsdk:"number"`
+	Description types.String `tfsdk:"description"`
+}
+
+func PolicyRouteTableResource() resource.Resource {
+	return &policyRouteTableResource{}
+}
+
+func (r *policyRouteTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_route_table"
+}
+
+func (r *policyRouteTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages Policy Route Table resources in ZStack. A policy route table holds the routes " +
+			"used for traffic matched by a `zstack_policy_route_rule` that references it; add routes with `zstack_policy_route_table_entry`.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the Policy Route Table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vrouter_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the virtual router the table is created on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The routing table number on the virtual router, unique per router.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the Policy Route Table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *policyRouteTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *policyRouteTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyRouteTableModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createParam := param.CreatePolicyRouteTableParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreatePolicyRouteTableParamDetail{
+			VRouterUuid: plan.VrouterUuid.ValueString(),
+			Number:      int(plan.Number.ValueInt64()),
+			Description: stringPtrOrNil(plan.Description.ValueString()),
+		},
+	}
+
+	unlock, err := lockParents(ctx, plan.VrouterUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Policy Route Table",
+			"Could not lock virtual router "+plan.VrouterUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	result, err := r.client.CreatePolicyRouteTable(createParam)
+	if err != nil {
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPolicyRouteTable), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Policy Route Table", "CreatePolicyRouteTable", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Uuid = types.StringValue(result.UUID)
+	plan.VrouterUuid = types.StringValue(result.VRouterUuid)
+	plan.Number = types.Int64Value(int64(result.Number))
+	plan.Description = stringValueOrNull(result.Description)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Policy Route Table created", map[string]interface{}{
+		"uuid":   result.UUID,
+		"number": result.Number,
+	})
+}
+
+func (r *policyRouteTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyRouteTableModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := findResourceByQuery(r.client.QueryPolicyRouteTable, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			tflog.Warn(ctx, "Policy Route Table not found, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Policy Route Table",
+			"Could not read policy route table",
+			"QueryPolicyRouteTable",
+			err,
+		))
+		return
+	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
+
+	state.Uuid = types.StringValue(table.UUID)
+	state.VrouterUuid = types.StringValue(table.VRouterUuid)
+	state.Number = types.Int64Value(int64(table.Number))
+	state.Description = stringValueOrNull(table.Description)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *policyRouteTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Policy Route Table does not support updates. All fields require replacement.",
+	)
+}
+
+func (r *policyRouteTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyRouteTableModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockParents(ctx, state.VrouterUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Policy Route Table",
+			"Could not lock virtual router "+state.VrouterUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DeletePolicyRouteTable(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		if isZStackNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Policy Route Table",
+			"Could not delete policy route table UUID "+state.Uuid.ValueString(),
+			"DeletePolicyRouteTable",
+			err,
+		))
+		return
+	}
+
+	tflog.Info(ctx, "Policy Route Table deleted", map[string]interface{}{
+		"uuid": state.Uuid.ValueString(),
+	})
+}
+
+func (r *policyRouteTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                   = &policyRouteTableEntryResource{}
+	_ resource.ResourceWithConfigure      = &policyRouteTableEntryResource{}
+	_ resource.ResourceWithImportState    = &policyRouteTableEntryResource{}
+	_ resource.ResourceWithValidateConfig = &policyRouteTableEntryResource{}
+)
+
+type policyRouteTableEntryResource struct {
+	client *client.ZSClient
+}
+
+type policyRouteTableEntryModel struct {
+	Uuid            types.String `tfsdk:"uuid"`
+	TableUuid       types.String `tfsdk:"table_uuid"`
+	DestinationCidr types.String `tfsdk:"destination_cidr"`
+	NextHopIp       types.String `tfsdk:"next_hop_ip"`
+	Distance        types.Int64  `tfsdk:"distance"`
+}
+
+func PolicyRouteTableEntryResource() resource.Resource {
+	return &policyRouteTableEntryResource{}
+}
+
+func (r *policyRouteTableEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_route_table_entry"
+}
+
+func (r *policyRouteTableEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages route entries of a Policy Route Table in ZStack.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the route entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"table_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the policy route table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_cidr": schema.StringAttribute{
+				Required:    true,
+				Description: "The destination CIDR of the route, e.g. `0.0.0.0/0` for a default route.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_hop_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The next hop IP address, in the same IP version as `destination_cidr`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"distance": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The distance (priority) of the route. Lower values are preferred.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *policyRouteTableEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *policyRouteTableEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config policyRouteTableEntryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DestinationCidr.IsUnknown() || config.DestinationCidr.IsNull() {
+		return
+	}
+	_, destination, err := net.ParseCIDR(config.DestinationCidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination_cidr"),
+			"Invalid Route Destination",
+			fmt.Sprintf("%q is not a valid CIDR block.", config.DestinationCidr.ValueString()),
+		)
+		return
+	}
+
+	if config.NextHopIp.IsUnknown() || config.NextHopIp.IsNull() {
+		return
+	}
+	if err := validateRouteNextHop(destination, config.NextHopIp.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("next_hop_ip"), "Invalid Route Next Hop", err.Error())
+	}
+}
+
+func (r *policyRouteTableEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyRouteTableEntryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createParam := param.CreatePolicyRouteTableRouteEntryParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreatePolicyRouteTableRouteEntryParamDetail{
+			TableUuid:       plan.TableUuid.ValueString(),
+			DestinationCidr: plan.DestinationCidr.ValueString(),
+			NextHopIp:       plan.NextHopIp.ValueString(),
+			Distance:        intPtrFromInt64OrNil(plan.Distance),
+		},
+	}
+
+	unlock, err := lockParents(ctx, plan.TableUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Policy Route Table Entry",
+			"Could not lock policy route table "+plan.TableUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	result, err := r.client.CreatePolicyRouteTableRouteEntry(createParam)
+	if err != nil {
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPolicyRouteTableRouteEntry), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Policy Route Table Entry", "CreatePolicyRouteTableRouteEntry", err, &resp.Diagnostics)
+		return
+	}
+
+	plan.Uuid = types.StringValue(result.UUID)
+	plan.TableUuid = types.StringValue(result.TableUuid)
+	plan.DestinationCidr = types.StringValue(result.DestinationCidr)
+	plan.NextHopIp = types.StringValue(result.NextHopIp)
+	plan.Distance = types.Int64Value(int64(result.Distance))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Policy Route Table Entry created", map[string]interface{}{
+		"uuid":             result.UUID,
+		"destination_cidr": result.DestinationCidr,
+	})
+}
+
+func (r *policyRouteTableEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyRouteTableEntryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := findResourceByQuery(r.client.QueryPolicyRouteTableRouteEntry, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			tflog.Warn(ctx, "Policy Route Table Entry not found, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Policy Route Table Entry",
+			"Could not read policy route table entry",
+			"QueryPolicyRouteTableRouteEntry",
+			err,
+		))
+		return
+	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
+
+	state.Uuid = types.StringValue(entry.UUID)
+	state.TableUuid = types.StringValue(entry.TableUuid)
+	state.DestinationCidr = types.StringValue(entry.DestinationCidr)
+	state.NextHopIp = types.StringValue(entry.NextHopIp)
+	state.Distance = types.Int64Value(int64(entry.Distance))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *policyRouteTableEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"Policy Route Table Entry does not support updates. All fields require replacement.",
+	)
+}
+
+func (r *policyRouteTableEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyRouteTableEntryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, err := lockParents(ctx, state.TableUuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Policy Route Table Entry",
+			"Could not lock policy route table "+state.TableUuid.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DeletePolicyRouteTableRouteEntry(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		if isZStackNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Policy Route Table Entry",
+			"Could not delete policy route table entry UUID "+state.Uuid.ValueString(),
+			"DeletePolicyRouteTableRouteEntry",
+			err,
+		))
+		return
+	}
+
+	tflog.Info(ctx, "Policy Route Table Entry deleted", map[string]interface{}{
+		"uuid": state.Uuid.ValueString(),
+	})
+}
+
+func (r *policyRouteTableEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestPolicyRouteTableResource_Schema(t *testing.T) {
+	var r policyRouteTableResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if len(resp.Schema.Attributes) == 0 {
+		t.Fatal("schema should not be empty")
+	}
+
+	required := []string{"vrouter_uuid", "number"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	computed := []string{"uuid"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestPolicyRouteTableResource_Metadata(t *testing.T) {
+	var r policyRouteTableResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_policy_route_table" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccPolicyRouteTableResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	// The rule set is attached to a router NIC on a network other than the
+	// management network, and the table routes through that NIC's gateway.
+	env := loadEnvData(t)
+	var vrouterUuid, l3NetworkUuid, nextHop string
+	for _, vr := range env.VirtualRouters {
+		nics, _ := vr["vm_nics"].([]interface{})
+		for _, n := range nics {
+			nic, _ := n.(map[string]interface{})
+			if l3 := envStr(nic, "l3_network_uuid"); l3 != "" && l3 != envStr(vr, "management_network_uuid") && envStr(nic, "gateway") != "" {
+				vrouterUuid, l3NetworkUuid, nextHop = envStr(vr, "uuid"), l3, envStr(nic, "gateway")
+				break
+			}
+		}
+		if vrouterUuid != "" {
+			break
+		}
+	}
+	if vrouterUuid == "" {
+		t.Skip("no virtual router with a non-management NIC in env.json, skipping policy route table acceptance test")
+	}
+
+	name := testAccName("policy-route")
+	config := providerConfig() + fmt.Sprintf(`
+resource "zstack_policy_route_table" "test" {
+  vrouter_uuid = %[1]q
+  number       = 100
+  description  = "backup uplink"
+}
+
+resource "zstack_policy_route_table_entry" "default" {
+  table_uuid       = zstack_policy_route_table.test.uuid
+  destination_cidr = "0.0.0.0/0"
+  next_hop_ip      = %[2]q
+}
+
+resource "zstack_policy_route_table_entry" "backup" {
+  table_uuid       = zstack_policy_route_table.test.uuid
+  destination_cidr = "198.51.100.0/24"
+  next_hop_ip      = %[2]q
+  distance         = 10
+}
+
+resource "zstack_policy_route_rule_set" "test" {
+  name         = %[3]q
+  vrouter_uuid = %[1]q
+}
+
+resource "zstack_policy_route_rule" "test" {
+  rule_set_uuid = zstack_policy_route_rule_set.test.uuid
+  table_uuid    = zstack_policy_route_table.test.uuid
+  rule_number   = 10
+  source_ip     = "10.1.0.0/16"
+}
+
+resource "zstack_policy_route_rule_set_attachment" "test" {
+  rule_set_uuid   = zstack_policy_route_rule_set.test.uuid
+  l3_network_uuid = %[4]q
+}
+`, vrouterUuid, nextHop, name, l3NetworkUuid)
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_policy_route_table.test", tfjsonpath.New("number"), knownvalue.Int64Exact(100)),
+					statecheck.ExpectKnownValue("zstack_policy_route_table.test", tfjsonpath.New("description"), knownvalue.StringExact("backup uplink")),
+					statecheck.ExpectKnownValue("zstack_policy_route_table_entry.default", tfjsonpath.New("next_hop_ip"), knownvalue.StringExact(nextHop)),
+					statecheck.ExpectKnownValue("zstack_policy_route_table_entry.backup", tfjsonpath.New("distance"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue("zstack_policy_route_rule_set_attachment.test", tfjsonpath.New("l3_network_uuid"), knownvalue.StringExact(l3NetworkUuid)),
+				},
+			},
+			{
+				ResourceName:                         "zstack_policy_route_table.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_policy_route_table.test"),
+			},
+			{
+				ResourceName:                         "zstack_policy_route_table_entry.backup",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateIdFunc:                    importStateIdFromUUID("zstack_policy_route_table_entry.backup"),
+			},
+			{
+				ResourceName:      "zstack_policy_route_rule_set_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -239,9 +239,18 @@ func (r *portForwardingRuleResource) Create(ctx context.Context, req resource.Cr
 		createParam.Params.AllowedCidr = stringPtr(plan.AllowedCidr.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	rule, err := r.client.CreatePortForwardingRule(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Port Forwarding Rule", "Could not create port forwarding rule, unexpected error: "+err.Error())
+		rule, err = adoptCreatedResource(ctx, r.client.GetPortForwardingRule, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Port Forwarding Rule", "CreatePortForwardingRule", err, &resp.Diagnostics)
 		return
 	}
 
@@ -266,9 +275,10 @@ func (r *portForwardingRuleResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Port Forwarding Rule", "Could not read port forwarding rule UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Port Forwarding Rule", "Could not read port forwarding rule UUID "+state.Uuid.ValueString(), "GetPortForwardingRule", err))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := portForwardingRuleModelFromView(rule)
 
@@ -302,7 +312,7 @@ func (r *portForwardingRuleResource) Update(ctx context.Context, req resource.Up
 		}
 
 		if _, err := r.client.UpdatePortForwardingRule(uuid, updateParam); err != nil {
-			resp.Diagnostics.AddError("Error updating Port Forwarding Rule", "Could not update port forwarding rule UUID "+uuid+": "+err.Error())
+			resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Port Forwarding Rule", "Could not update port forwarding rule UUID "+uuid, "UpdatePortForwardingRule", err))
 			return
 		}
 	}
@@ -315,7 +325,7 @@ func (r *portForwardingRuleResource) Update(ctx context.Context, req resource.Up
 		// Detach from current NIC if attached
 		if currentNic != "" {
 			if err := r.client.DetachPortForwardingRule(uuid, param.DeleteModePermissive); err != nil {
-				resp.Diagnostics.AddError("Error detaching Port Forwarding Rule from VM NIC", "Could not detach port forwarding rule from VM NIC, unexpected error: "+err.Error())
+				resp.Diagnostics.Append(zstackErrorDiagnostic("Error detaching Port Forwarding Rule from VM NIC", "Could not detach port forwarding rule from VM NIC", "DetachPortForwardingRule", err))
 				return
 			}
 		}
@@ -323,7 +333,7 @@ func (r *portForwardingRuleResource) Update(ctx context.Context, req resource.Up
 		// Attach to new NIC if specified
 		if desiredNic != "" {
 			if err := r.attachToVmNic(uuid, desiredNic); err != nil {
-				resp.Diagnostics.AddError("Error attaching Port Forwarding Rule to VM NIC", "Could not attach port forwarding rule to VM NIC, unexpected error: "+err.Error())
+				resp.Diagnostics.Append(zstackErrorDiagnostic("Error attaching Port Forwarding Rule to VM NIC", "Could not attach port forwarding rule to VM NIC", "AttachPortForwardingRule", err))
 				return
 			}
 		}
@@ -332,7 +342,7 @@ func (r *portForwardingRuleResource) Update(ctx context.Context, req resource.Up
 	// Read back the updated resource
 	rule, err := r.client.GetPortForwardingRule(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Port Forwarding Rule", "Could not read port forwarding rule after update UUID "+uuid+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Port Forwarding Rule", "Could not read port forwarding rule after update UUID "+uuid, "GetPortForwardingRule", err))
 		return
 	}
 
@@ -357,7 +367,7 @@ func (r *portForwardingRuleResource) Delete(ctx context.Context, req resource.De
 	}
 
 	if err := r.client.DeletePortForwardingRule(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Port Forwarding Rule", "Could not delete port forwarding rule UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Port Forwarding Rule", "Could not delete port forwarding rule UUID "+state.Uuid.ValueString(), "DeletePortForwardingRule", err))
 		return
 	}
 }
@@ -127,9 +127,18 @@ func (r *portMirrorResource) Create(ctx context.Context, request resource.Create
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	portMirror, err := r.client.CreatePortMirror(p)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating Port Mirror", "Could not create port mirror, unexpected error: "+err.Error())
+		portMirror, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPortMirror), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Port Mirror", "CreatePortMirror", err, &response.Diagnostics)
 		return
 	}
 
@@ -160,12 +169,15 @@ func (r *portMirrorResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Port Mirror",
-			"Could not read Port Mirror, unexpected error: "+err.Error(),
-		)
+			"Could not read Port Mirror",
+			"QueryPortMirror",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(portMirror.UUID)
 	state.Name = stringValueOrNull(portMirror.Name)
@@ -201,7 +213,7 @@ func (r *portMirrorResource) Update(ctx context.Context, request resource.Update
 
 	portMirror, err := r.client.UpdatePortMirror(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError("Error updating Port Mirror", "Could not update port mirror UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating Port Mirror", "Could not update port mirror UUID "+state.Uuid.ValueString(), "UpdatePortMirror", err))
 		return
 	}
 
@@ -229,7 +241,7 @@ func (r *portMirrorResource) Delete(ctx context.Context, request resource.Delete
 
 	err := r.client.DeletePortMirror(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Port Mirror", "Could not delete port mirror UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Port Mirror", "Could not delete port mirror UUID "+state.Uuid.ValueString(), "DeletePortMirror", err))
 		return
 	}
 }
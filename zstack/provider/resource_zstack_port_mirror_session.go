@@ -161,9 +161,18 @@ func (r *portMirrorSessionResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	portMirrorSession, err := r.client.CreatePortMirrorSession(p)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating Port Mirror Session", "Could not create port mirror session, unexpected error: "+err.Error())
+		portMirrorSession, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPortMirrorSession), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Port Mirror Session", "CreatePortMirrorSession", err, &response.Diagnostics)
 		return
 	}
 
@@ -198,12 +207,15 @@ func (r *portMirrorSessionResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Port Mirror Session",
-			"Could not read Port Mirror Session, unexpected error: "+err.Error(),
-		)
+			"Could not read Port Mirror Session",
+			"QueryPortMirrorSession",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(portMirrorSession.UUID)
 	state.Name = types.StringValue(portMirrorSession.Name)
@@ -240,7 +252,7 @@ func (r *portMirrorSessionResource) Delete(ctx context.Context, request resource
 
 	err := r.client.DeletePortMirrorSession(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Port Mirror Session", "Could not delete port mirror session UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Port Mirror Session", "Could not delete port mirror session UUID "+state.Uuid.ValueString(), "DeletePortMirrorSession", err))
 		return
 	}
 }
@@ -184,12 +184,18 @@ func (r *preconfigurationTemplateResource) Create(ctx context.Context, request r
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	preconfigurationTemplate, err := r.client.AddPreconfigurationTemplate(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Preconfiguration Template",
-			"Could not create preconfiguration template, unexpected error: "+err.Error(),
-		)
+		preconfigurationTemplate, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPreconfigurationTemplate), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Preconfiguration Template", "AddPreconfigurationTemplate", err, &response.Diagnostics)
 		return
 	}
 
@@ -225,12 +231,15 @@ func (r *preconfigurationTemplateResource) Read(ctx context.Context, request res
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Preconfiguration Template",
-			"Could not read Preconfiguration Template, unexpected error: "+err.Error(),
-		)
+			"Could not read Preconfiguration Template",
+			"QueryPreconfigurationTemplate",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(preconfigurationTemplate.UUID)
 	state.Name = types.StringValue(preconfigurationTemplate.Name)
@@ -274,10 +283,12 @@ func (r *preconfigurationTemplateResource) Update(ctx context.Context, request r
 
 	preconfigurationTemplate, err := r.client.UpdatePreconfigurationTemplate(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Preconfiguration Template",
-			"Could not update preconfiguration template UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not update preconfiguration template UUID "+state.Uuid.ValueString(),
+			"UpdatePreconfigurationTemplate",
+			err,
+		))
 		return
 	}
 
@@ -308,7 +319,7 @@ func (r *preconfigurationTemplateResource) Delete(ctx context.Context, request r
 
 	err := r.client.DeletePreconfigurationTemplate(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Preconfiguration Template", "Could not delete preconfiguration template UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Preconfiguration Template", "Could not delete preconfiguration template UUID "+state.Uuid.ValueString(), "DeletePreconfigurationTemplate", err))
 		return
 	}
 }
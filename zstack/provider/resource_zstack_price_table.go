@@ -183,12 +183,18 @@ func (r *priceTableResource) Create(ctx context.Context, request resource.Create
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	priceTable, err := r.client.CreatePriceTable(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Price Table",
-			"Could not create price table, unexpected error: "+err.Error(),
-		)
+		priceTable, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryPriceTable), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Price Table", "CreatePriceTable", err, &response.Diagnostics)
 		return
 	}
 
@@ -218,12 +224,15 @@ func (r *priceTableResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Price Table",
-			"Could not read Price Table, unexpected error: "+err.Error(),
-		)
+			"Could not read Price Table",
+			"QueryPriceTable",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(priceTable.UUID)
 	state.Name = types.StringValue(priceTable.Name)
@@ -259,10 +268,12 @@ func (r *priceTableResource) Update(ctx context.Context, request resource.Update
 
 	priceTable, err := r.client.UpdatePriceTable(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Price Table",
-			"Could not update price table UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not update price table UUID "+state.Uuid.ValueString(),
+			"UpdatePriceTable",
+			err,
+		))
 		return
 	}
 
@@ -287,7 +298,7 @@ func (r *priceTableResource) Delete(ctx context.Context, request resource.Delete
 
 	err := r.client.DeletePriceTable(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting Price Table", "Could not delete price table UUID "+state.Uuid.ValueString()+": "+err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Price Table", "Could not delete price table UUID "+state.Uuid.ValueString(), "DeletePriceTable", err))
 		return
 	}
 }
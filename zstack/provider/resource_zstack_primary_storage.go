@@ -199,7 +199,13 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Info(ctx, "Creating primary storage", map[string]any{"name": plan.Name.ValueString(), "type": plan.Type.ValueString()})
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var ps *view.PrimaryStorageInventoryView
+	var apiName string
 	var err error
 
 	switch plan.Type.ValueString() {
@@ -215,8 +221,9 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 		if !plan.Description.IsNull() && plan.Description.ValueString() != "" {
 			addLocalParam.Params.Description = stringPtr(plan.Description.ValueString())
 		}
+		addLocalParam.Params.ResourceUuid = stringPtr(resourceUuid)
 		// SDK bug: AddLocalPrimaryStorage() takes no parameters, use Post directly
-		ps = &view.PrimaryStorageInventoryView{}
+		ps, apiName = &view.PrimaryStorageInventoryView{}, "AddLocalPrimaryStorage"
 		err = r.client.Post("v1/primary-storage/local-storage", addLocalParam, ps)
 
 	case "NFS":
@@ -231,8 +238,9 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 		if !plan.Description.IsNull() && plan.Description.ValueString() != "" {
 			addNfsParam.Params.Description = stringPtr(plan.Description.ValueString())
 		}
+		addNfsParam.Params.ResourceUuid = stringPtr(resourceUuid)
 		// SDK bug: AddNfsPrimaryStorage() takes no parameters, use Post directly
-		ps = &view.PrimaryStorageInventoryView{}
+		ps, apiName = &view.PrimaryStorageInventoryView{}, "AddNfsPrimaryStorage"
 		err = r.client.Post("v1/primary-storage/nfs", addNfsParam, ps)
 
 	case "Ceph":
@@ -260,6 +268,8 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 		if !plan.ImageCachePoolName.IsNull() && plan.ImageCachePoolName.ValueString() != "" {
 			addCephParam.Params.ImageCachePoolName = stringPtr(plan.ImageCachePoolName.ValueString())
 		}
+		addCephParam.Params.ResourceUuid = stringPtr(resourceUuid)
+		apiName = "AddCephPrimaryStorage"
 		ps, err = r.client.AddCephPrimaryStorage(addCephParam)
 
 	case "SharedBlock":
@@ -278,6 +288,8 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 		if !plan.Url.IsNull() && plan.Url.ValueString() != "" {
 			addSharedBlockParam.Params.Url = stringPtr(plan.Url.ValueString())
 		}
+		addSharedBlockParam.Params.ResourceUuid = stringPtr(resourceUuid)
+		apiName = "AddSharedBlockGroupPrimaryStorage"
 		ps, err = r.client.AddSharedBlockGroupPrimaryStorage(addSharedBlockParam)
 
 	default:
@@ -286,7 +298,10 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Primary Storage", "Could not create primary storage, unexpected error: "+err.Error())
+		ps, err = adoptCreatedResource(ctx, r.client.GetPrimaryStorage, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Primary Storage", apiName, err, &resp.Diagnostics)
 		return
 	}
 
@@ -302,8 +317,12 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 	desiredClusters := listToStringSlice(plan.AttachedClusterUuids)
 	for _, clusterUuid := range desiredClusters {
 		if _, err := r.client.AttachPrimaryStorageToCluster(clusterUuid, ps.UUID, param.AttachPrimaryStorageToClusterParam{BaseParam: param.BaseParam{}}); err != nil {
-			resp.Diagnostics.AddError("Error attaching Primary Storage to Cluster",
-				fmt.Sprintf("Could not attach primary storage to cluster UUID %s: %s", clusterUuid, err.Error()))
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error attaching Primary Storage to Cluster",
+				fmt.Sprintf("Could not attach primary storage to cluster UUID %s", clusterUuid),
+				"AttachPrimaryStorageToCluster",
+				err,
+			))
 			return
 		}
 	}
@@ -311,7 +330,7 @@ func (r *primaryStorageResource) Create(ctx context.Context, req resource.Create
 	// Read back the final state
 	psRead, err := r.client.GetPrimaryStorage(ps.UUID)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Primary Storage", "Could not read primary storage after create UUID "+ps.UUID+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Primary Storage", "Could not read primary storage after create UUID "+ps.UUID, "GetPrimaryStorage", err))
 		return
 	}
 
@@ -336,9 +355,10 @@ func (r *primaryStorageResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Primary Storage", "Could not read primary storage UUID "+state.Uuid.ValueString()+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Primary Storage", "Could not read primary storage UUID "+state.Uuid.ValueString(), "GetPrimaryStorage", err))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := primaryStorageModelFromView(ps, &state)
 
@@ -374,7 +394,7 @@ func (r *primaryStorageResource) Update(ctx context.Context, req resource.Update
 	}
 
 	if _, err := r.client.UpdatePrimaryStorage(uuid, updateParam); err != nil {
-		resp.Diagnostics.AddError("Error updating Primary Storage", "Could not update primary storage UUID "+uuid+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error updating Primary Storage", "Could not update primary storage UUID "+uuid, "UpdatePrimaryStorage", err))
 		return
 	}
 
@@ -395,8 +415,12 @@ func (r *primaryStorageResource) Update(ctx context.Context, req resource.Update
 	for _, clusterUuid := range desiredClusters {
 		if !currentSet[clusterUuid] {
 			if _, err := r.client.AttachPrimaryStorageToCluster(clusterUuid, uuid, param.AttachPrimaryStorageToClusterParam{BaseParam: param.BaseParam{}}); err != nil {
-				resp.Diagnostics.AddError("Error attaching Primary Storage to Cluster",
-					fmt.Sprintf("Could not attach primary storage to cluster UUID %s: %s", clusterUuid, err.Error()))
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
+					"Error attaching Primary Storage to Cluster",
+					fmt.Sprintf("Could not attach primary storage to cluster UUID %s", clusterUuid),
+					"AttachPrimaryStorageToCluster",
+					err,
+				))
 				return
 			}
 		}
@@ -406,8 +430,12 @@ func (r *primaryStorageResource) Update(ctx context.Context, req resource.Update
 	for _, clusterUuid := range currentClusters {
 		if !desiredSet[clusterUuid] {
 			if err := r.client.DetachPrimaryStorageFromCluster(clusterUuid, uuid, param.DeleteModePermissive); err != nil {
-				resp.Diagnostics.AddError("Error detaching Primary Storage from Cluster",
-					fmt.Sprintf("Could not detach primary storage from cluster UUID %s: %s", clusterUuid, err.Error()))
+				resp.Diagnostics.Append(zstackErrorDiagnostic(
+					"Error detaching Primary Storage from Cluster",
+					fmt.Sprintf("Could not detach primary storage from cluster UUID %s", clusterUuid),
+					"DetachPrimaryStorageFromCluster",
+					err,
+				))
 				return
 			}
 		}
@@ -416,7 +444,7 @@ func (r *primaryStorageResource) Update(ctx context.Context, req resource.Update
 	// Read back the updated resource
 	ps, err := r.client.GetPrimaryStorage(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Primary Storage", "Could not read primary storage after update UUID "+uuid+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading Primary Storage", "Could not read primary storage after update UUID "+uuid, "GetPrimaryStorage", err))
 		return
 	}
 
@@ -446,15 +474,19 @@ func (r *primaryStorageResource) Delete(ctx context.Context, req resource.Delete
 	attachedClusters := listToStringSlice(state.AttachedClusterUuids)
 	for _, clusterUuid := range attachedClusters {
 		if err := r.client.DetachPrimaryStorageFromCluster(clusterUuid, uuid, param.DeleteModePermissive); err != nil {
-			resp.Diagnostics.AddError("Error detaching Primary Storage from Cluster",
-				fmt.Sprintf("Could not detach primary storage from cluster UUID %s: %s", clusterUuid, err.Error()))
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error detaching Primary Storage from Cluster",
+				fmt.Sprintf("Could not detach primary storage from cluster UUID %s", clusterUuid),
+				"DetachPrimaryStorageFromCluster",
+				err,
+			))
 			return
 		}
 	}
 
 	// Then delete the primary storage
 	if err := r.client.DeletePrimaryStorage(uuid, param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError("Error deleting Primary Storage", "Could not delete primary storage UUID "+uuid+": "+err.Error())
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error deleting Primary Storage", "Could not delete primary storage UUID "+uuid, "DeletePrimaryStorage", err))
 		return
 	}
 }
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
@@ -84,17 +86,23 @@ func (r *reservedIpResource) Schema(_ context.Context, request resource.SchemaRe
 			},
 			"start_ip": schema.StringAttribute{
 				Required:    true,
-				Description: "The start IP address of the reserved range.",
+				Description: "The start IPv4 or IPv6 address of the reserved range.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
 			},
 			"end_ip": schema.StringAttribute{
 				Required:    true,
-				Description: "The end IP address of the reserved range.",
+				Description: "The end IPv4 or IPv6 address of the reserved range.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
 			},
 			"ip_version": schema.Int64Attribute{
 				Computed:    true,
@@ -128,14 +136,32 @@ func (r *reservedIpResource) Create(ctx context.Context, request resource.Create
 		},
 	}
 
-	ipRange, err := r.client.AddReservedIpRange(reservedIpPlan.L3NetworkUuid.ValueString(), p)
+	unlock, err := lockParents(ctx, reservedIpPlan.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating Reserved IP Range",
-			"Could not create reserved ip range in L3 network "+reservedIpPlan.L3NetworkUuid.ValueString()+", unexpected error: "+err.Error(),
+			"Could not lock L3 network "+reservedIpPlan.L3NetworkUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	ipRange, err := r.client.AddReservedIpRange(reservedIpPlan.L3NetworkUuid.ValueString(), p)
+	if err != nil {
+		ipRange, err = adoptCreatedResource(ctx, func(uuid string) (*view.ReservedIpRangeInventoryView, error) {
+			return r.queryReservedIpRange(ctx, uuid)
+		}, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Reserved IP Range", "AddReservedIpRange", err, &response.Diagnostics)
+		return
+	}
 
 	reservedIpPlan.Uuid = types.StringValue(ipRange.UUID)
 	reservedIpPlan.IpVersion = types.Int64Value(int64(ipRange.IpVersion))
@@ -155,39 +181,21 @@ func (r *reservedIpResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
-	// ZQL responses wrap the rows in {"results": [{"inventories": [...]}]} —
-	// passing only "inventories" as the unmarshal key drills into a top-level
-	// field that does not exist and the SDK raises "key not found". Decode the
-	// full envelope instead.
-	var zqlResponse struct {
-		Results []struct {
-			Inventories []view.ReservedIpRangeInventoryView `json:"inventories"`
-		} `json:"results"`
-	}
-	_, err := r.client.Zql(ctx, fmt.Sprintf("query reservedIpRange where uuid='%s'", state.Uuid.ValueString()), &zqlResponse)
+	ipRange, err := r.queryReservedIpRange(ctx, state.Uuid.ValueString())
 	if err != nil {
-		if isZStackNotFoundError(err) {
+		if errors.Is(err, ErrResourceNotFound) || isZStackNotFoundError(err) {
 			response.State.RemoveResource(ctx)
 			return
 		}
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
-	var reservedIpRanges []view.ReservedIpRangeInventoryView
-	for _, result := range zqlResponse.Results {
-		reservedIpRanges = append(reservedIpRanges, result.Inventories...)
-	}
-
-	if len(reservedIpRanges) == 0 {
-		response.State.RemoveResource(ctx)
-		return
-	}
-
-	state.Uuid = types.StringValue(reservedIpRanges[0].UUID)
-	state.StartIp = types.StringValue(reservedIpRanges[0].StartIp)
-	state.EndIp = types.StringValue(reservedIpRanges[0].EndIp)
-	state.IpVersion = types.Int64Value(int64(reservedIpRanges[0].IpVersion))
-	state.L3NetworkUuid = types.StringValue(reservedIpRanges[0].L3NetworkUuid)
+	state.Uuid = types.StringValue(ipRange.UUID)
+	state.StartIp = ipStringValue(ipRange.StartIp, state.StartIp)
+	state.EndIp = ipStringValue(ipRange.EndIp, state.EndIp)
+	state.IpVersion = types.Int64Value(int64(ipRange.IpVersion))
+	state.L3NetworkUuid = types.StringValue(ipRange.L3NetworkUuid)
 
 	// 更新 State
 	diags = response.State.Set(ctx, &state)
@@ -197,6 +205,30 @@ func (r *reservedIpResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 }
 
+// queryReservedIpRange looks up a reserved IP range by UUID over ZQL, as the
+// SDK has no query method for it. ErrResourceNotFound is returned when there
+// is no such range.
+func (r *reservedIpResource) queryReservedIpRange(ctx context.Context, uuid string) (*view.ReservedIpRangeInventoryView, error) {
+	// ZQL responses wrap the rows in {"results": [{"inventories": [...]}]} —
+	// passing only "inventories" as the unmarshal key drills into a top-level
+	// field that does not exist and the SDK raises "key not found". Decode the
+	// full envelope instead.
+	var zqlResponse struct {
+		Results []struct {
+			Inventories []view.ReservedIpRangeInventoryView `json:"inventories"`
+		} `json:"results"`
+	}
+	if _, err := r.client.Zql(ctx, fmt.Sprintf("query reservedIpRange where uuid='%s'", uuid), &zqlResponse); err != nil {
+		return nil, err
+	}
+	for _, result := range zqlResponse.Results {
+		if len(result.Inventories) > 0 {
+			return &result.Inventories[0], nil
+		}
+	}
+	return nil, ErrResourceNotFound
+}
+
 func (r *reservedIpResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	response.Diagnostics.AddError(
 		"Update not supported",
@@ -212,16 +244,26 @@ func (r *reservedIpResource) Delete(ctx context.Context, request resource.Delete
 		return
 	}
 
-
-	err := r.client.DeleteReservedIpRange(state.Uuid.ValueString(), param.DeleteModePermissive)
-
+	unlock, err := lockParents(ctx, state.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error deleting Reserved IP Range",
-			"Could not delete reserved ip range, unexpected error: "+err.Error(),
+			"Could not lock L3 network "+state.L3NetworkUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	err = r.client.DeleteReservedIpRange(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Reserved IP Range",
+			"Could not delete reserved ip range",
+			"DeleteReservedIpRange",
+			err,
+		))
+		return
+	}
 
 }
 
@@ -217,12 +217,18 @@ func (r *resourceStackResource) Create(ctx context.Context, request resource.Cre
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	resourceStack, err := r.client.CreateResourceStack(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Resource Stack",
-			"Could not create resource stack, unexpected error: "+err.Error(),
-		)
+		resourceStack, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryResourceStack), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Resource Stack", "CreateResourceStack", err, &response.Diagnostics)
 		return
 	}
 
@@ -259,12 +265,15 @@ func (r *resourceStackResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Resource Stack",
-			"Could not read Resource Stack, unexpected error: "+err.Error(),
-		)
+			"Could not read Resource Stack",
+			"QueryResourceStack",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(resourceStack.UUID)
 	state.Name = types.StringValue(resourceStack.Name)
@@ -313,10 +322,12 @@ func (r *resourceStackResource) Update(ctx context.Context, request resource.Upd
 
 	resourceStack, err := r.client.UpdateResourceStack(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Resource Stack",
-			"Could not update resource stack, unexpected error: "+err.Error(),
-		)
+			"Could not update resource stack",
+			"UpdateResourceStack",
+			err,
+		))
 		return
 	}
 
@@ -349,10 +360,12 @@ func (r *resourceStackResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DeleteResourceStack(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Resource Stack",
-			"Could not delete resource stack, unexpected error: "+err.Error(),
-		)
+			"Could not delete resource stack",
+			"DeleteResourceStack",
+			err,
+		))
 		return
 	}
 }
@@ -134,23 +134,18 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateRole(createParam)
 	if err != nil {
-		if !plan.Identity.IsNull() && !plan.Identity.IsUnknown() && plan.Identity.ValueString() != "" {
-			resp.Diagnostics.AddError(
-				"Error creating Role",
-				fmt.Sprintf(
-					"Could not create role because ZStack rejected identity %q. The identity field is a ZStack role identity/type marker and valid values depend on the ZStack version. Omit identity or use a value accepted by your target ZStack version. Original error: %s",
-					plan.Identity.ValueString(),
-					err.Error(),
-				),
-			)
-			return
-		}
-		resp.Diagnostics.AddError(
-			"Error creating Role",
-			"Could not create role, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryRole), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Role", "CreateRole", err, &resp.Diagnostics)
 		return
 	}
 
@@ -184,12 +179,15 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Role",
-			"Could not read role UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read role UUID "+state.Uuid.ValueString(),
+			"QueryRole",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Name = types.StringValue(role.Name)
 	state.Description = stringValueOrNull(role.Description)
@@ -226,10 +224,12 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	result, err := r.client.UpdateRole(state.Uuid.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Role",
-			"Could not update role, unexpected error: "+err.Error(),
-		)
+			"Could not update role",
+			"UpdateRole",
+			err,
+		))
 		return
 	}
 
@@ -256,10 +256,12 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	err := r.client.DeleteRole(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Role",
-			"Could not delete role, unexpected error: "+err.Error(),
-		)
+			"Could not delete role",
+			"DeleteRole",
+			err,
+		))
 		return
 	}
 
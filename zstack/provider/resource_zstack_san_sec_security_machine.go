@@ -174,12 +174,18 @@ func (r *sanSecSecurityMachineResource) Create(ctx context.Context, req resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddSanSecSecurityMachine(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating SAN Security Machine",
-			"Could not create san security machine, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySecurityMachine), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "SAN Security Machine", "AddSanSecSecurityMachine", err, &resp.Diagnostics)
 		return
 	}
 
@@ -203,12 +209,15 @@ func (r *sanSecSecurityMachineResource) Read(ctx context.Context, req resource.R
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Security Machine",
-			"Could not read Security Machine, unexpected error: "+err.Error(),
-		)
+			"Could not read Security Machine",
+			"QuerySecurityMachine",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = sanSecSecurityMachineModelFromView(item, state)
 
@@ -242,10 +251,12 @@ func (r *sanSecSecurityMachineResource) Update(ctx context.Context, req resource
 
 	item, err := r.client.UpdateSanSecSecurityMachine(state.Uuid.ValueString(), p)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SAN Security Machine",
-			"Could not update san security machine, unexpected error: "+err.Error(),
-		)
+			"Could not update san security machine",
+			"UpdateSanSecSecurityMachine",
+			err,
+		))
 		return
 	}
 
@@ -265,10 +276,12 @@ func (r *sanSecSecurityMachineResource) Delete(ctx context.Context, req resource
 
 
 	if err := r.client.DeleteSecurityMachine(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting SAN Security Machine",
-			"Could not delete san security machine, unexpected error: "+err.Error(),
-		)
+			"Could not delete san security machine",
+			"DeleteSecurityMachine",
+			err,
+		))
 		return
 	}
 }
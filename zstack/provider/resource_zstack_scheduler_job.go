@@ -136,9 +136,18 @@ func (r *schedulerJobResource) Create(ctx context.Context, request resource.Crea
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	job, err := r.client.CreateSchedulerJob(params)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating scheduler job", err.Error())
+		job, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySchedulerJob), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Scheduler Job", "CreateSchedulerJob", err, &response.Diagnostics)
 		return
 	}
 
@@ -172,9 +181,10 @@ func (r *schedulerJobResource) Read(ctx context.Context, request resource.ReadRe
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading scheduler job", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading scheduler job", "Could not read scheduler job", "QuerySchedulerJob", err))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 	state.Name = types.StringValue(job.Name)
 	state.Description = stringValueOrNull(job.Description)
 	state.TargetResourceUuid = types.StringValue(job.TargetResourceUuid)
@@ -214,7 +224,7 @@ func (r *schedulerJobResource) Update(ctx context.Context, request resource.Upda
 
 	job, err := r.client.UpdateSchedulerJob(state.Uuid.ValueString(), params)
 	if err != nil {
-		response.Diagnostics.AddError("Error updating scheduler job", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating scheduler job", "Could not update scheduler job", "UpdateSchedulerJob", err))
 		return
 	}
 
@@ -243,7 +253,7 @@ func (r *schedulerJobResource) Delete(ctx context.Context, request resource.Dele
 
 	err := r.client.DeleteSchedulerJob(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting scheduler job", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting scheduler job", "Could not delete scheduler job", "DeleteSchedulerJob", err))
 		return
 	}
 }
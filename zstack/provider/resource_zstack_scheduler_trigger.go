@@ -203,9 +203,18 @@ func (r *schedulerTriggerResource) Create(ctx context.Context, request resource.
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	trigger, err := r.client.CreateSchedulerTrigger(params)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating scheduler trigger", err.Error())
+		trigger, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySchedulerTrigger), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Scheduler Trigger", "CreateSchedulerTrigger", err, &response.Diagnostics)
 		return
 	}
 
@@ -248,9 +257,10 @@ func (r *schedulerTriggerResource) Read(ctx context.Context, request resource.Re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading scheduler trigger", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading scheduler trigger", "Could not read scheduler trigger", "QuerySchedulerTrigger", err))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 	state.Name = types.StringValue(trigger.Name)
 	state.Description = stringValueOrNull(trigger.Description)
 	state.SchedulerType = types.StringValue(trigger.SchedulerType)
@@ -303,7 +313,7 @@ func (r *schedulerTriggerResource) Update(ctx context.Context, request resource.
 
 	trigger, err := r.client.UpdateSchedulerTrigger(state.Uuid.ValueString(), params)
 	if err != nil {
-		response.Diagnostics.AddError("Error updating scheduler trigger", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating scheduler trigger", "Could not update scheduler trigger", "UpdateSchedulerTrigger", err))
 		return
 	}
 
@@ -342,7 +352,7 @@ func (r *schedulerTriggerResource) Delete(ctx context.Context, request resource.
 
 	err := r.client.DeleteSchedulerTrigger(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting scheduler trigger", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting scheduler trigger", "Could not delete scheduler trigger", "DeleteSchedulerTrigger", err))
 		return
 	}
 }
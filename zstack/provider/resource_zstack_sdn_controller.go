@@ -172,12 +172,18 @@ func (r *sdnControllerResource) Create(ctx context.Context, request resource.Cre
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.AddSdnController(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating SDN Controller",
-			"Could not create sdn controller, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySdnController), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "SDN Controller", "AddSdnController", err, &response.Diagnostics)
 		return
 	}
 
@@ -219,12 +225,15 @@ func (r *sdnControllerResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SDN Controller",
-			"Could not read SDN Controller, unexpected error: "+err.Error(),
-		)
+			"Could not read SDN Controller",
+			"QuerySdnController",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -273,10 +282,12 @@ func (r *sdnControllerResource) Update(ctx context.Context, request resource.Upd
 
 	result, err := r.client.UpdateSdnController(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SDN Controller",
-			"Could not update sdn controller, unexpected error: "+err.Error(),
-		)
+			"Could not update sdn controller",
+			"UpdateSdnController",
+			err,
+		))
 		return
 	}
 
@@ -315,10 +326,12 @@ func (r *sdnControllerResource) Delete(ctx context.Context, request resource.Del
 	err := r.client.RemoveSdnController(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting SDN Controller",
-			"Could not delete sdn controller, unexpected error: "+err.Error(),
-		)
+			"Could not delete sdn controller",
+			"RemoveSdnController",
+			err,
+		))
 		return
 	}
 
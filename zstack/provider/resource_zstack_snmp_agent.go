@@ -165,12 +165,18 @@ func (r *snmpAgentResource) Create(ctx context.Context, request resource.CreateR
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	snmpAgent, err := r.client.CreateSnmpAgent(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating SNMP Agent",
-			"Could not create snmp agent, unexpected error: "+err.Error(),
-		)
+		snmpAgent, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySnmpAgent), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "SNMP Agent", "CreateSnmpAgent", err, &response.Diagnostics)
 		return
 	}
 
@@ -209,12 +215,15 @@ func (r *snmpAgentResource) Read(ctx context.Context, request resource.ReadReque
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SNMP Agent",
-			"Could not read SNMP Agent, unexpected error: "+err.Error(),
-		)
+			"Could not read SNMP Agent",
+			"QuerySnmpAgent",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(snmpAgent.UUID)
 	state.Name = types.StringValue(snmpAgent.Name)
@@ -265,10 +274,12 @@ func (r *snmpAgentResource) Update(ctx context.Context, request resource.UpdateR
 
 	snmpAgent, err := r.client.UpdateSnmpAgent(p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SNMP Agent",
-			"Could not update snmp agent, unexpected error: "+err.Error(),
-		)
+			"Could not update snmp agent",
+			"UpdateSnmpAgent",
+			err,
+		))
 		return
 	}
 
@@ -144,12 +144,18 @@ func (r *snsEmailEndpointResource) Create(ctx context.Context, request resource.
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateSNSEmailEndpoint(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating SNS Email Endpoint",
-			"Could not create sns email endpoint, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySNSEmailEndpoint), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "SNS Email Endpoint", "CreateSNSEmailEndpoint", err, &response.Diagnostics)
 		return
 	}
 
@@ -182,12 +188,15 @@ func (r *snsEmailEndpointResource) Read(ctx context.Context, request resource.Re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SNS Email Endpoint",
-			"Could not read SNS Email Endpoint, unexpected error: "+err.Error(),
-		)
+			"Could not read SNS Email Endpoint",
+			"QuerySNSEmailEndpoint",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
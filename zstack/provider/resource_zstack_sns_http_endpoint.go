@@ -150,12 +150,18 @@ func (r *snsHttpEndpointResource) Create(ctx context.Context, request resource.C
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateSNSHttpEndpoint(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating SNS HTTP Endpoint",
-			"Could not create sns http endpoint, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySNSHttpEndpoint), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "SNS HTTP Endpoint", "CreateSNSHttpEndpoint", err, &response.Diagnostics)
 		return
 	}
 
@@ -189,12 +195,15 @@ func (r *snsHttpEndpointResource) Read(ctx context.Context, request resource.Rea
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SNS HTTP Endpoint",
-			"Could not read SNS HTTP endpoint UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read SNS HTTP endpoint UUID "+state.Uuid.ValueString(),
+			"QuerySNSHttpEndpoint",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -242,10 +251,12 @@ func (r *snsHttpEndpointResource) Update(ctx context.Context, request resource.U
 
 	result, err := r.client.UpdateSNSHttpEndpoint(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SNS HTTP Endpoint",
-			"Could not update sns http endpoint, unexpected error: "+err.Error(),
-		)
+			"Could not update sns http endpoint",
+			"UpdateSNSHttpEndpoint",
+			err,
+		))
 		return
 	}
 
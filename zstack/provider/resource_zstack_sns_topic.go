@@ -114,12 +114,18 @@ func (r *snsTopicResource) Create(ctx context.Context, request resource.CreateRe
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateSNSTopic(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating SNS Topic",
-			"Could not create sns topic, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QuerySNSTopic), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "SNS Topic", "CreateSNSTopic", err, &response.Diagnostics)
 		return
 	}
 
@@ -149,12 +155,15 @@ func (r *snsTopicResource) Read(ctx context.Context, request resource.ReadReques
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SNS Topic",
-			"Could not read SNS Topic, unexpected error: "+err.Error(),
-		)
+			"Could not read SNS Topic",
+			"QuerySNSTopic",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(snsTopic.UUID)
 	state.Name = types.StringValue(snsTopic.Name)
@@ -195,10 +204,12 @@ func (r *snsTopicResource) Update(ctx context.Context, request resource.UpdateRe
 
 	result, err := r.client.UpdateSNSTopic(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SNS Topic",
-			"Could not update sns topic, unexpected error: "+err.Error(),
-		)
+			"Could not update sns topic",
+			"UpdateSNSTopic",
+			err,
+		))
 		return
 	}
 
@@ -226,10 +237,12 @@ func (r *snsTopicResource) Delete(ctx context.Context, request resource.DeleteRe
 	err := r.client.DeleteSNSTopic(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting SNS Topic",
-			"Could not delete sns topic, unexpected error: "+err.Error(),
-		)
+			"Could not delete sns topic",
+			"DeleteSNSTopic",
+			err,
+		))
 		return
 	}
 }
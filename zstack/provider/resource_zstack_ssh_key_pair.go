@@ -124,12 +124,18 @@ func (r *sshKeyPairResource) Create(ctx context.Context, req resource.CreateRequ
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	sshKeyPair, err := r.client.CreateSshKeyPair(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating SSH Key Pair",
-			"Could not create ssh key pair, unexpected error: "+err.Error(),
-		)
+		sshKeyPair, err = adoptCreatedResource(ctx, r.client.GetSshKeyPair, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "SSH Key Pair", "CreateSshKeyPair", err, &resp.Diagnostics)
 		return
 	}
 
@@ -157,12 +163,15 @@ func (r *sshKeyPairResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SSH Key Pair",
-			"Could not read ssh key pair UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read ssh key pair UUID "+state.Uuid.ValueString(),
+			"GetSshKeyPair",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(sshKeyPair.UUID)
 	state.Name = types.StringValue(sshKeyPair.Name)
@@ -198,20 +207,24 @@ func (r *sshKeyPairResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if _, err := r.client.UpdateSshKeyPair(state.Uuid.ValueString(), updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating SSH Key Pair",
-			"Could not update ssh key pair, unexpected error: "+err.Error(),
-		)
+			"Could not update ssh key pair",
+			"UpdateSshKeyPair",
+			err,
+		))
 		return
 	}
 
 	// Read back the updated resource to get the full state
 	sshKeyPair, err := r.client.GetSshKeyPair(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading SSH Key Pair",
-			"Could not read ssh key pair after update, unexpected error: "+err.Error(),
-		)
+			"Could not read ssh key pair after update",
+			"GetSshKeyPair",
+			err,
+		))
 		return
 	}
 
@@ -236,10 +249,12 @@ func (r *sshKeyPairResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	err := r.client.DeleteSshKeyPair(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting SSH Key Pair",
-			"Could not delete ssh key pair, unexpected error: "+err.Error(),
-		)
+			"Could not delete ssh key pair",
+			"DeleteSshKeyPair",
+			err,
+		))
 		return
 	}
 }
@@ -173,12 +173,18 @@ func (r *stackTemplateResource) Create(ctx context.Context, request resource.Cre
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	stackTemplate, err := r.client.AddStackTemplate(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Stack Template",
-			"Could not create stack template, unexpected error: "+err.Error(),
-		)
+		stackTemplate, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryStackTemplate), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Stack Template", "AddStackTemplate", err, &response.Diagnostics)
 		return
 	}
 
@@ -212,12 +218,15 @@ func (r *stackTemplateResource) Read(ctx context.Context, request resource.ReadR
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Stack Template",
-			"Could not read Stack Template, unexpected error: "+err.Error(),
-		)
+			"Could not read Stack Template",
+			"QueryStackTemplate",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(stackTemplate.UUID)
 	state.Name = types.StringValue(stackTemplate.Name)
@@ -267,10 +276,12 @@ func (r *stackTemplateResource) Update(ctx context.Context, request resource.Upd
 
 	stackTemplate, err := r.client.UpdateStackTemplate(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Stack Template",
-			"Could not update stack template, unexpected error: "+err.Error(),
-		)
+			"Could not update stack template",
+			"UpdateStackTemplate",
+			err,
+		))
 		return
 	}
 
@@ -300,10 +311,12 @@ func (r *stackTemplateResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DeleteStackTemplate(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Stack Template",
-			"Could not delete stack template, unexpected error: "+err.Error(),
-		)
+			"Could not delete stack template",
+			"DeleteStackTemplate",
+			err,
+		))
 		return
 	}
 }
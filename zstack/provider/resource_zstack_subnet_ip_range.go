@@ -6,25 +6,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
 )
 
 var (
-	_ resource.Resource                = &subnetResource{}
-	_ resource.ResourceWithConfigure   = &subnetResource{}
-	_ resource.ResourceWithImportState = &subnetResource{}
+	_ resource.Resource                   = &subnetResource{}
+	_ resource.ResourceWithConfigure      = &subnetResource{}
+	_ resource.ResourceWithImportState    = &subnetResource{}
+	_ resource.ResourceWithValidateConfig = &subnetResource{}
 )
 
+// ipv6AddressModes are the ways guests obtain addresses in an IPv6 range.
+var ipv6AddressModes = []string{"SLAAC", "Stateful-DHCP", "Stateless-DHCP"}
+
 type subnetResource struct {
 	client *client.ZSClient
 }
@@ -38,6 +47,10 @@ type subnetModel struct {
 	Gateway       types.String `tfsdk:"gateway"`
 	IpRangeType   types.String `tfsdk:"ip_range_type"`
 	L3NetworkUuid types.String `tfsdk:"l3_network_uuid"`
+	NetworkCidr   types.String `tfsdk:"network_cidr"`
+	PrefixLen     types.Int64  `tfsdk:"prefix_len"`
+	AddressMode   types.String `tfsdk:"address_mode"`
+	IpVersion     types.Int64  `tfsdk:"ip_version"`
 }
 
 func SubnetResource() resource.Resource {
@@ -96,32 +109,90 @@ func (r *subnetResource) Schema(_ context.Context, request resource.SchemaReques
 				},
 			},
 			"start_ip": schema.StringAttribute{
-				Required:    true,
-				Description: "The starting IP address of the subnet range.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The starting IPv4 or IPv6 address of the subnet range. Required unless `network_cidr` is set.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
 			},
 			"end_ip": schema.StringAttribute{
-				Required:    true,
-				Description: "The ending IP address of the subnet range.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The ending IPv4 or IPv6 address of the subnet range. Required unless `network_cidr` is set.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
 			},
 			"netmask": schema.StringAttribute{
-				Required:    true,
-				Description: "The subnet mask, used to define the network portion of an IP address.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The subnet mask, used to define the network portion of an IP address. Required for IPv4 ranges defined by `start_ip` and `end_ip`.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"gateway": schema.StringAttribute{
-				Required:    true,
-				Description: "The default gateway for the subnet.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The default gateway for the subnet. Required for ranges defined by `start_ip` and `end_ip`; defaults to the first address of `network_cidr` otherwise.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"network_cidr": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The network CIDR of the subnet, e.g. `192.168.10.0/24` or `2001:db8:10::/64`. When set, the whole CIDR is added as the range and `start_ip`, `end_ip`, `netmask` and `prefix_len` must not be set.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
+			},
+			"prefix_len": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The prefix length of an IPv6 range defined by `start_ip` and `end_ip`. `SLAAC` and `Stateless-DHCP` ranges require 64; `Stateful-DHCP` ranges accept 64 to 126.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 128),
+				},
+			},
+			"address_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "How guests obtain addresses in an IPv6 range. Possible values are 'SLAAC', 'Stateful-DHCP' or 'Stateless-DHCP'. Required for IPv6 ranges and not allowed for IPv4 ranges.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ipv6AddressModes...),
+				},
+			},
+			"ip_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The IP version of the subnet range (4 or 6).",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"ip_range_type": schema.StringAttribute{
 				Optional:    true,
@@ -150,24 +221,80 @@ func (r *subnetResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
-	p := subnetAddIpRangeParam(plan)
-
-	subnet, err := r.client.AddIpRange(plan.L3NetworkUuid.ValueString(), p)
-
+	unlock, err := lockParents(ctx, plan.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating Subnet IP Range",
-			"Could not create subnet ip range in L3 network "+plan.L3NetworkUuid.ValueString()+", unexpected error: "+err.Error(),
+			"Could not lock L3 network "+plan.L3NetworkUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
 
-	plan.Uuid = types.StringValue(subnet.UUID)
-	plan.Name = types.StringValue(subnet.Name)
-	plan.StartIp = types.StringValue(subnet.StartIp)
-	plan.EndIp = types.StringValue(subnet.EndIp)
-	plan.Netmask = types.StringValue(subnet.Netmask)
-	plan.Gateway = types.StringValue(subnet.Gateway)
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	l3Uuid := plan.L3NetworkUuid.ValueString()
+	var subnet *view.IpRangeInventoryView
+	var apiName string
+	switch ipv6, byCidr := subnetIpRangeIsIpv6(plan), isKnownString(plan.NetworkCidr); {
+	case byCidr && ipv6:
+		apiName = "AddIpv6RangeByNetworkCidr"
+		subnet, err = r.client.AddIpv6RangeByNetworkCidr(l3Uuid, param.AddIpv6RangeByNetworkCidrParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddIpv6RangeByNetworkCidrParamDetail{
+				Name:         plan.Name.ValueString(),
+				NetworkCidr:  plan.NetworkCidr.ValueString(),
+				AddressMode:  plan.AddressMode.ValueString(),
+				IpRangeType:  stringPtrOrNil(plan.IpRangeType.ValueString()),
+				ResourceUuid: stringPtr(resourceUuid),
+			},
+		})
+	case byCidr:
+		apiName = "AddIpRangeByNetworkCidr"
+		subnet, err = r.client.AddIpRangeByNetworkCidr(l3Uuid, param.AddIpRangeByNetworkCidrParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddIpRangeByNetworkCidrParamDetail{
+				Name:         plan.Name.ValueString(),
+				NetworkCidr:  plan.NetworkCidr.ValueString(),
+				Gateway:      stringPtrOrNil(plan.Gateway.ValueString()),
+				IpRangeType:  stringPtrOrNil(plan.IpRangeType.ValueString()),
+				ResourceUuid: stringPtr(resourceUuid),
+			},
+		})
+	case ipv6:
+		apiName = "AddIpv6Range"
+		subnet, err = r.client.AddIpv6Range(l3Uuid, param.AddIpv6RangeParam{
+			BaseParam: param.BaseParam{},
+			Params: param.AddIpv6RangeParamDetail{
+				Name:         plan.Name.ValueString(),
+				StartIp:      plan.StartIp.ValueString(),
+				EndIp:        plan.EndIp.ValueString(),
+				Gateway:      plan.Gateway.ValueString(),
+				PrefixLen:    int(plan.PrefixLen.ValueInt64()),
+				AddressMode:  plan.AddressMode.ValueString(),
+				IpRangeType:  stringPtrOrNil(plan.IpRangeType.ValueString()),
+				ResourceUuid: stringPtr(resourceUuid),
+			},
+		})
+	default:
+		apiName = "AddIpRange"
+		p := subnetAddIpRangeParam(plan)
+		p.Params.ResourceUuid = stringPtr(resourceUuid)
+		subnet, err = r.client.AddIpRange(l3Uuid, p)
+	}
+
+	if err != nil {
+		subnet, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryIpRange), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Subnet IP Range", apiName, err, &response.Diagnostics)
+		return
+	}
+
+	plan = subnetModelFromIpRange(subnet, plan)
 
 	diags = response.State.Set(ctx, plan)
 	response.Diagnostics.Append(diags...)
@@ -210,36 +337,201 @@ func (r *subnetResource) Read(ctx context.Context, request resource.ReadRequest,
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Subnet IP Range",
-			"Could not read subnet IP range, unexpected error: "+err.Error(),
-		)
+			"Could not read subnet IP range",
+			"QueryIpRange",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	state = subnetModelFromIpRange(subnet, state)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+}
 
-	ipRangeType := state.IpRangeType
+// subnetModelFromIpRange returns the state of an IP range. The backend may
+// omit ipRangeType and addressMode, so the values from prior are kept then.
+func subnetModelFromIpRange(subnet *view.IpRangeInventoryView, prior subnetModel) subnetModel {
+	ipRangeType := prior.IpRangeType
 	if subnet.IpRangeType != "" {
 		ipRangeType = types.StringValue(subnet.IpRangeType)
 	} else if ipRangeType.IsUnknown() || ipRangeType.ValueString() == "" {
 		ipRangeType = types.StringNull()
 	}
 
-	state = subnetModel{
+	addressMode := prior.AddressMode
+	if subnet.AddressMode != "" {
+		addressMode = types.StringValue(subnet.AddressMode)
+	} else if addressMode.IsUnknown() || addressMode.ValueString() == "" {
+		addressMode = types.StringNull()
+	}
+
+	model := subnetModel{
 		Uuid:          types.StringValue(subnet.UUID),
 		Name:          types.StringValue(subnet.Name),
-		StartIp:       types.StringValue(subnet.StartIp),
-		EndIp:         types.StringValue(subnet.EndIp),
-		Netmask:       types.StringValue(subnet.Netmask),
-		Gateway:       types.StringValue(subnet.Gateway),
+		StartIp:       ipStringValue(subnet.StartIp, prior.StartIp),
+		EndIp:         ipStringValue(subnet.EndIp, prior.EndIp),
+		Netmask:       stringValueOrNull(subnet.Netmask),
+		Gateway:       ipStringValue(subnet.Gateway, prior.Gateway),
 		L3NetworkUuid: types.StringValue(subnet.L3NetworkUuid),
 		IpRangeType:   ipRangeType,
+		NetworkCidr:   stringValueOrNull(subnet.NetworkCidr),
+		PrefixLen:     types.Int64Value(int64(subnet.PrefixLen)),
+		AddressMode:   addressMode,
+		IpVersion:     types.Int64Value(int64(subnet.IpVersion)),
+	}
+	if model.IpVersion.ValueInt64() == 0 {
+		model.IpVersion = types.Int64Value(4)
+		if subnetIpRangeIsIpv6(model) {
+			model.IpVersion = types.Int64Value(6)
+		}
 	}
+	return model
+}
 
-	diags = response.State.Set(ctx, &state)
-	response.Diagnostics.Append(diags...)
+func isKnownString(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown() && v.ValueString() != ""
+}
+
+// subnetIpRangeIsIpv6 reports whether the range is an IPv6 range, judging
+// from network_cidr or start_ip.
+func subnetIpRangeIsIpv6(config subnetModel) bool {
+	if isKnownString(config.NetworkCidr) {
+		ip, _, err := net.ParseCIDR(config.NetworkCidr.ValueString())
+		return err == nil && ip.To4() == nil
+	}
+	if isKnownString(config.StartIp) {
+		ip := net.ParseIP(config.StartIp.ValueString())
+		return ip != nil && ip.To4() == nil
+	}
+	return false
+}
+
+// validateIpv6RangePrefix checks the prefix length of an IPv6 range against
+// its address mode. SLAAC needs a /64 to build addresses from the interface
+// identifier.
+func validateIpv6RangePrefix(addressMode string, prefixLen int64) error {
+	switch addressMode {
+	case "SLAAC", "Stateless-DHCP":
+		if prefixLen != 64 {
+			return fmt.Errorf("%s ranges require a prefix length of 64, got %d", addressMode, prefixLen)
+		}
+	default:
+		if prefixLen < 64 || prefixLen > 126 {
+			return fmt.Errorf("IPv6 ranges require a prefix length between 64 and 126, got %d", prefixLen)
+		}
+	}
+	return nil
+}
+
+// subnetIpRangeConfigIssue is a configuration error on one attribute.
+type subnetIpRangeConfigIssue struct {
+	Attribute string
+	Detail    string
+}
+
+// checkSubnetIpRangeConfig checks that the configuration names exactly one
+// way of adding the range: by network_cidr, or by start_ip and end_ip with
+// netmask (IPv4) or prefix_len and address_mode (IPv6).
+func checkSubnetIpRangeConfig(config subnetModel) []subnetIpRangeConfigIssue {
+	var issues []subnetIpRangeConfigIssue
+	isSet := func(v attr.Value) bool { return !v.IsNull() && !v.IsUnknown() }
+	conflict := func(attribute, reason string) {
+		issues = append(issues, subnetIpRangeConfigIssue{attribute, fmt.Sprintf("%q must not be set %s.", attribute, reason)})
+	}
+	missing := func(attribute, reason string) {
+		issues = append(issues, subnetIpRangeConfigIssue{attribute, fmt.Sprintf("%q is required %s.", attribute, reason)})
+	}
+
+	if config.NetworkCidr.IsUnknown() || config.StartIp.IsUnknown() {
+		return nil
+	}
+	ipv6 := subnetIpRangeIsIpv6(config)
+
+	if isKnownString(config.NetworkCidr) {
+		for name, v := range map[string]attr.Value{"start_ip": config.StartIp, "end_ip": config.EndIp, "netmask": config.Netmask, "prefix_len": config.PrefixLen} {
+			if isSet(v) {
+				conflict(name, "together with network_cidr")
+			}
+		}
+		if !ipv6 {
+			if isSet(config.AddressMode) {
+				conflict("address_mode", "for IPv4 ranges")
+			}
+			return issues
+		}
+		if config.AddressMode.IsNull() {
+			missing("address_mode", "for IPv6 ranges")
+		} else if isSet(config.AddressMode) {
+			_, network, _ := net.ParseCIDR(config.NetworkCidr.ValueString())
+			ones, _ := network.Mask.Size()
+			if err := validateIpv6RangePrefix(config.AddressMode.ValueString(), int64(ones)); err != nil {
+				issues = append(issues, subnetIpRangeConfigIssue{"network_cidr", err.Error() + "."})
+			}
+		}
+		if isSet(config.Gateway) {
+			conflict("gateway", "for IPv6 ranges added by network_cidr")
+		}
+		return issues
+	}
+
+	if config.StartIp.IsNull() {
+		missing("start_ip", "unless network_cidr is set")
+	}
+	if config.EndIp.IsNull() {
+		missing("end_ip", "unless network_cidr is set")
+	}
+	if config.Gateway.IsNull() {
+		missing("gateway", "unless network_cidr is set")
+	}
+
+	if !ipv6 {
+		if config.Netmask.IsNull() {
+			missing("netmask", "for IPv4 ranges")
+		}
+		if isSet(config.PrefixLen) {
+			conflict("prefix_len", "for IPv4 ranges")
+		}
+		if isSet(config.AddressMode) {
+			conflict("address_mode", "for IPv4 ranges")
+		}
+		return issues
+	}
+
+	if isSet(config.Netmask) {
+		conflict("netmask", "for IPv6 ranges")
+	}
+	if config.PrefixLen.IsNull() {
+		missing("prefix_len", "for IPv6 ranges")
+	}
+	if config.AddressMode.IsNull() {
+		missing("address_mode", "for IPv6 ranges")
+	}
+	if isSet(config.PrefixLen) && isSet(config.AddressMode) {
+		if err := validateIpv6RangePrefix(config.AddressMode.ValueString(), config.PrefixLen.ValueInt64()); err != nil {
+			issues = append(issues, subnetIpRangeConfigIssue{"prefix_len", err.Error() + "."})
+		}
+	}
+	return issues
+}
+
+func (r *subnetResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config subnetModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
+
+	for _, issue := range checkSubnetIpRangeConfig(config) {
+		response.Diagnostics.AddAttributeError(path.Root(issue.Attribute), "Invalid Subnet IP Range Configuration", issue.Detail)
+	}
 }
 
 func (r *subnetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -257,15 +549,27 @@ func (r *subnetResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
-	err := r.client.DeleteIpRange(state.Uuid.ValueString(), param.DeleteModePermissive)
-
+	unlock, err := lockParents(ctx, state.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error deleting Subnet IP Range",
-			"Could not delete subnet ip range, unexpected error: "+err.Error(),
+			"Could not lock L3 network "+state.L3NetworkUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	err = r.client.DeleteIpRange(state.Uuid.ValueString(), param.DeleteModePermissive)
+
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Subnet IP Range",
+			"Could not delete subnet ip range",
+			"DeleteIpRange",
+			err,
+		))
+		return
+	}
 
 }
 
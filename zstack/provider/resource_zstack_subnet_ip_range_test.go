@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
@@ -27,7 +28,7 @@ func TestSubnetIpRangeResource_Schema(t *testing.T) {
 		t.Fatal("schema should not be empty")
 	}
 
-	required := []string{"l3_network_uuid", "name", "start_ip", "end_ip", "netmask"}
+	required := []string{"l3_network_uuid", "name"}
 	for _, attr := range required {
 		a, ok := resp.Schema.Attributes[attr]
 		if !ok {
@@ -42,7 +43,7 @@ func TestSubnetIpRangeResource_Schema(t *testing.T) {
 		t.Fatal("schema missing computed attribute uuid")
 	}
 
-	optional := []string{"gateway", "ip_range_type"}
+	optional := []string{"start_ip", "end_ip", "netmask", "gateway", "ip_range_type", "network_cidr", "prefix_len", "address_mode"}
 	for _, attr := range optional {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Fatalf("schema missing optional attribute %q", attr)
@@ -131,6 +132,136 @@ func TestSubnetAddIpRangeParamIncludesConfiguredIpRangeType(t *testing.T) {
 	}
 }
 
+func TestCheckSubnetIpRangeConfig(t *testing.T) {
+	ipv4Range := subnetModel{
+		StartIp: types.StringValue("192.168.50.2"),
+		EndIp:   types.StringValue("192.168.50.254"),
+		Netmask: types.StringValue("255.255.255.0"),
+		Gateway: types.StringValue("192.168.50.1"),
+	}
+	ipv6Range := subnetModel{
+		StartIp:     types.StringValue("2001:db8::10"),
+		EndIp:       types.StringValue("2001:db8::ff"),
+		Gateway:     types.StringValue("2001:db8::1"),
+		PrefixLen:   types.Int64Value(64),
+		AddressMode: types.StringValue("Stateful-DHCP"),
+	}
+
+	tests := []struct {
+		name       string
+		config     subnetModel
+		wantIssues []string
+	}{
+		{name: "ipv4 range", config: ipv4Range},
+		{name: "ipv6 range", config: ipv6Range},
+		{
+			name:   "ipv4 cidr",
+			config: subnetModel{NetworkCidr: types.StringValue("192.168.10.0/24")},
+		},
+		{
+			name:   "ipv6 cidr",
+			config: subnetModel{NetworkCidr: types.StringValue("2001:db8:10::/64"), AddressMode: types.StringValue("SLAAC")},
+		},
+		{
+			name:       "ipv6 cidr without address mode",
+			config:     subnetModel{NetworkCidr: types.StringValue("2001:db8:10::/64")},
+			wantIssues: []string{"address_mode"},
+		},
+		{
+			name:       "slaac cidr longer than /64",
+			config:     subnetModel{NetworkCidr: types.StringValue("2001:db8:10::/80"), AddressMode: types.StringValue("SLAAC")},
+			wantIssues: []string{"network_cidr"},
+		},
+		{
+			name: "cidr with explicit range",
+			config: subnetModel{
+				NetworkCidr: types.StringValue("192.168.10.0/24"),
+				StartIp:     types.StringValue("192.168.10.2"),
+			},
+			wantIssues: []string{"start_ip"},
+		},
+		{
+			name: "ipv4 range with address mode",
+			config: func() subnetModel {
+				c := ipv4Range
+				c.AddressMode = types.StringValue("SLAAC")
+				return c
+			}(),
+			wantIssues: []string{"address_mode"},
+		},
+		{
+			name: "ipv6 range with netmask and no prefix",
+			config: func() subnetModel {
+				c := ipv6Range
+				c.Netmask = types.StringValue("255.255.255.0")
+				c.PrefixLen = types.Int64Null()
+				return c
+			}(),
+			wantIssues: []string{"netmask", "prefix_len"},
+		},
+		{
+			name:       "no range",
+			config:     subnetModel{},
+			wantIssues: []string{"start_ip", "end_ip", "gateway", "netmask"},
+		},
+		{
+			name:   "unknown start ip",
+			config: subnetModel{StartIp: types.StringUnknown()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range checkSubnetIpRangeConfig(tt.config) {
+				got = append(got, issue.Attribute)
+			}
+			sort.Strings(got)
+			want := append([]string(nil), tt.wantIssues...)
+			sort.Strings(want)
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("issues on %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateIpv6RangePrefix(t *testing.T) {
+	tests := []struct {
+		addressMode string
+		prefixLen   int64
+		expectError bool
+	}{
+		{addressMode: "SLAAC", prefixLen: 64},
+		{addressMode: "SLAAC", prefixLen: 80, expectError: true},
+		{addressMode: "Stateless-DHCP", prefixLen: 48, expectError: true},
+		{addressMode: "Stateful-DHCP", prefixLen: 64},
+		{addressMode: "Stateful-DHCP", prefixLen: 120},
+		{addressMode: "Stateful-DHCP", prefixLen: 127, expectError: true},
+		{addressMode: "Stateful-DHCP", prefixLen: 56, expectError: true},
+	}
+
+	for _, tt := range tests {
+		err := validateIpv6RangePrefix(tt.addressMode, tt.prefixLen)
+		if (err != nil) != tt.expectError {
+			t.Errorf("validateIpv6RangePrefix(%q, %d) error = %v, expectError %v", tt.addressMode, tt.prefixLen, err, tt.expectError)
+		}
+	}
+}
+
+func TestIpStringValueKeepsEquivalentPriorNotation(t *testing.T) {
+	prior := types.StringValue("2001:DB8:0:0:0:0:0:10")
+	if got := ipStringValue("2001:db8::10", prior); !got.Equal(prior) {
+		t.Errorf("expected prior notation to be kept, got %s", got)
+	}
+	if got := ipStringValue("2001:db8::11", prior); got.ValueString() != "2001:db8::11" {
+		t.Errorf("expected observed address, got %s", got)
+	}
+	if got := ipStringValue("", prior); !got.IsNull() {
+		t.Errorf("expected null for empty address, got %s", got)
+	}
+}
+
 func TestSubnetIpRangeResource_Metadata(t *testing.T) {
 	var r subnetResource
 	resp := &resource.MetadataResponse{}
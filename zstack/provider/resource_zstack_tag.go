@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -128,12 +129,18 @@ func (r *tagResource) Create(ctx context.Context, request resource.CreateRequest
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	tag, err := r.client.CreateTag(params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Tag",
-			"Could not create tag, unexpected error: "+err.Error(),
-		)
+		tag, err = adoptCreatedResource(ctx, r.client.GetTag, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Tag", "CreateTag", err, &response.Diagnostics)
 		return
 	}
 
@@ -164,12 +171,15 @@ func (r *tagResource) Read(ctx context.Context, request resource.ReadRequest, re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Tag",
-			"Could not read tag UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read tag UUID "+state.Uuid.ValueString(),
+			"GetTag",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Name = types.StringValue(tag.Name)
 	state.Value = stringValueOrNull(tag.Value)
@@ -243,10 +253,12 @@ func (r *tagResource) Update(ctx context.Context, request resource.UpdateRequest
 
 	tag, err := r.client.UpdateTag(state.Uuid.ValueString(), params)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Tag",
-			"Could not update tag, unexpected error: "+err.Error(),
-		)
+			"Could not update tag",
+			"UpdateTag",
+			err,
+		))
 		return
 	}
 
@@ -277,10 +289,12 @@ func (r *tagResource) Delete(ctx context.Context, request resource.DeleteRequest
 
 	err := r.client.DeleteTag(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Tag",
-			"Could not delete tag, unexpected error: "+err.Error(),
-		)
+			"Could not delete tag",
+			"DeleteTag",
+			err,
+		))
 		return
 	}
 }
@@ -295,10 +309,12 @@ func (r *tagResource) ImportState(ctx context.Context, request resource.ImportSt
 
 	tag, err := r.client.GetTag(tagUUID)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Tag Not Found",
-			fmt.Sprintf("Could not find tag with UUID '%s': '%v'", tagUUID, err),
-		)
+			fmt.Sprintf("Could not find tag with UUID '%s'", tagUUID),
+			"GetTag",
+			err,
+		))
 		return
 	}
 
@@ -151,7 +151,7 @@ func (r *tagAttachmentResource) Create(ctx context.Context, request resource.Cre
 
 	_, err := r.client.AttachTagToResources(plan.TagUuid.ValueString(), attachParams)
 	if err != nil {
-		response.Diagnostics.AddError("Error attaching tag", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error attaching tag", "Could not attach tag", "AttachTagToResources", err))
 		return
 	}
 
@@ -188,10 +188,12 @@ func (r *tagAttachmentResource) Read(ctx context.Context, request resource.ReadR
 	q.AddQ("tagPatternUuid=" + tagUuid)
 	results, err := r.client.QueryUserTag(&q)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Unable to Read Tag Binding",
-			fmt.Sprintf("Error retrieving tag binding for tag UUID %s: %s", tagUuid, err),
-		)
+			fmt.Sprintf("Error retrieving tag binding for tag UUID %s", tagUuid),
+			"QueryUserTag",
+			err,
+		))
 		return
 	}
 
@@ -256,7 +258,7 @@ func (r *tagAttachmentResource) Delete(ctx context.Context, request resource.Del
 
 	err := r.client.DetachTagFromResources(state.TagUuid.ValueString(), param.DeleteModePermissive, resourceUuids)
 	if err != nil {
-		response.Diagnostics.AddError("Error detaching tag", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error detaching tag", "Could not detach tag", "DetachTagFromResources", err))
 		return
 	}
 
@@ -122,12 +122,18 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateUser(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating User",
-			"Could not create user, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryUser), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "User", "CreateUser", err, &resp.Diagnostics)
 		return
 	}
 
@@ -160,12 +166,15 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading User",
-			"Could not read user UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read user UUID "+state.Uuid.ValueString(),
+			"QueryUser",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Name = types.StringValue(user.Name)
 	state.Description = stringValueOrNull(user.Description)
@@ -207,10 +216,12 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	result, err := r.client.UpdateUser(updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating User",
-			"Could not update user, unexpected error: "+err.Error(),
-		)
+			"Could not update user",
+			"UpdateUser",
+			err,
+		))
 		return
 	}
 
@@ -236,10 +247,12 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	err := r.client.DeleteUser(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting User",
-			"Could not delete user, unexpected error: "+err.Error(),
-		)
+			"Could not delete user",
+			"DeleteUser",
+			err,
+		))
 		return
 	}
 
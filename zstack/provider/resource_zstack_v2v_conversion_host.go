@@ -150,12 +150,18 @@ func (r *v2vConversionHostResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.AddV2VConversionHost(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating V2V Conversion Host",
-			"Could not create v2v conversion host, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryV2VConversionHost), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "V2V Conversion Host", "AddV2VConversionHost", err, &response.Diagnostics)
 		return
 	}
 
@@ -189,12 +195,15 @@ func (r *v2vConversionHostResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading V2V Conversion Host",
-			"Could not read V2V Conversion Host, unexpected error: "+err.Error(),
-		)
+			"Could not read V2V Conversion Host",
+			"QueryV2VConversionHost",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -235,10 +244,12 @@ func (r *v2vConversionHostResource) Update(ctx context.Context, request resource
 
 	item, err := r.client.UpdateV2VConversionHost(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating V2V Conversion Host",
-			"Could not update v2v conversion host, unexpected error: "+err.Error(),
-		)
+			"Could not update v2v conversion host",
+			"UpdateV2VConversionHost",
+			err,
+		))
 		return
 	}
 
@@ -269,10 +280,12 @@ func (r *v2vConversionHostResource) Delete(ctx context.Context, request resource
 
 	err := r.client.DeleteV2VConversionHost(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting V2V Conversion Host",
-			"Could not delete v2v conversion host, unexpected error: "+err.Error(),
-		)
+			"Could not delete v2v conversion host",
+			"DeleteV2VConversionHost",
+			err,
+		))
 		return
 	}
 }
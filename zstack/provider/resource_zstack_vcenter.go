@@ -173,12 +173,18 @@ func (r *vcenterResource) Create(ctx context.Context, request resource.CreateReq
 		p.Params.Port = intPtr(int(plan.Port.ValueInt64()))
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vcenter, err := r.client.AddVCenter(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating vCenter",
-			"Could not create vcenter, unexpected error: "+err.Error(),
-		)
+		vcenter, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVCenter), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "vCenter", "AddVCenter", err, &response.Diagnostics)
 		return
 	}
 
@@ -212,12 +218,15 @@ func (r *vcenterResource) Read(ctx context.Context, request resource.ReadRequest
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading vCenter",
-			"Could not read vCenter, unexpected error: "+err.Error(),
-		)
+			"Could not read vCenter",
+			"QueryVCenter",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vcenter.UUID)
 	state.Name = types.StringValue(vcenter.Name)
@@ -265,10 +274,12 @@ func (r *vcenterResource) Update(ctx context.Context, request resource.UpdateReq
 
 	vcenter, err := r.client.UpdateVCenter(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating vCenter",
-			"Could not update vcenter, unexpected error: "+err.Error(),
-		)
+			"Could not update vcenter",
+			"UpdateVCenter",
+			err,
+		))
 		return
 	}
 
@@ -298,10 +309,12 @@ func (r *vcenterResource) Delete(ctx context.Context, request resource.DeleteReq
 
 	err := r.client.DeleteVCenter(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting vCenter",
-			"Could not delete vcenter, unexpected error: "+err.Error(),
-		)
+			"Could not delete vcenter",
+			"DeleteVCenter",
+			err,
+		))
 		return
 	}
 }
@@ -152,12 +152,18 @@ func (r *vipResource) Create(ctx context.Context, request resource.CreateRequest
 		p.Params.RequiredIp = stringPtr(plan.VIP.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vip, err := r.client.CreateVip(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating VIP",
-			"Could not create vip, unexpected error: "+err.Error(),
-		)
+		vip, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVip), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VIP", "CreateVip", err, &response.Diagnostics)
 		return
 	}
 
@@ -189,12 +195,15 @@ func (r *vipResource) Read(ctx context.Context, request resource.ReadRequest, re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VIP",
-			"Could not read VIP, unexpected error: "+err.Error(),
-		)
+			"Could not read VIP",
+			"QueryVip",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vip.UUID)
 	state.Name = types.StringValue(vip.Name)
@@ -232,10 +241,12 @@ func (r *vipResource) Update(ctx context.Context, request resource.UpdateRequest
 
 	vip, err := r.client.UpdateVip(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VIP",
-			"Could not update VIP, unexpected error: "+err.Error(),
-		)
+			"Could not update VIP",
+			"UpdateVip",
+			err,
+		))
 		return
 	}
 
@@ -260,10 +271,12 @@ func (r *vipResource) Delete(ctx context.Context, request resource.DeleteRequest
 	err := r.client.DeleteVip(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VIP",
-			"Could not delete vip, unexpected error: "+err.Error(),
-		)
+			"Could not delete vip",
+			"DeleteVip",
+			err,
+		))
 		return
 	}
 
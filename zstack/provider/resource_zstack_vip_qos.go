@@ -131,10 +131,12 @@ func (r *vipQosResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	result, err := r.client.SetVipQos(plan.VipUuid.ValueString(), setParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating VIP QoS",
-			"Could not create vip qos, unexpected error: "+err.Error(),
-		)
+			"Could not create vip qos",
+			"SetVipQos",
+			err,
+		))
 		return
 	}
 
@@ -172,10 +174,12 @@ func (r *vipQosResource) Read(ctx context.Context, req resource.ReadRequest, res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VIP QoS",
-			"Could not read vip qos, unexpected error: "+err.Error(),
-		)
+			"Could not read vip qos",
+			"GetVipQos",
+			err,
+		))
 		return
 	}
 
@@ -221,10 +225,12 @@ func (r *vipQosResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	result, err := r.client.SetVipQos(plan.VipUuid.ValueString(), setParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VIP QoS",
-			"Could not update vip qos, unexpected error: "+err.Error(),
-		)
+			"Could not update vip qos",
+			"SetVipQos",
+			err,
+		))
 		return
 	}
 
@@ -257,10 +263,12 @@ func (r *vipQosResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteVipQos(state.VipUuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VIP QoS",
-			"Could not delete vip qos, unexpected error: "+err.Error(),
-		)
+			"Could not delete vip qos",
+			"DeleteVipQos",
+			err,
+		))
 		return
 	}
 
@@ -83,10 +83,12 @@ func (r *virtualRouterImageResource) Create(ctx context.Context, req resource.Cr
 		q := param.NewQueryParam()
 		storage, err := r.client.QueryBackupStorage(&q)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error creating Virtual Router Image",
-				"Could not create virtual router image because reading backup storage failed: "+err.Error(),
-			)
+				"Could not create virtual router image because reading backup storage failed",
+				"QueryBackupStorage",
+				err,
+			))
 			return
 		}
 		backupStorageUuids = []string{storage[0].UUID}
@@ -152,12 +154,18 @@ func (r *virtualRouterImageResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	ctx = tflog.SetField(ctx, "url", plan.Url)
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	imageParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	image, err := r.client.AddImage(imageParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Virtual Router Image",
-			"Could not create virtual router image, unexpected error: "+err.Error(),
-		)
+		image, err = adoptCreatedResource(ctx, r.client.GetImage, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Virtual Router Image", "AddImage", err, &resp.Diagnostics)
 		return
 	}
 
@@ -187,10 +195,12 @@ func (r *virtualRouterImageResource) Delete(ctx context.Context, req resource.De
 	err := r.client.DeleteImage(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Virtual Router Image",
-			"Could not delete virtual router image, unexpected error: "+err.Error(),
-		)
+			"Could not delete virtual router image",
+			"DeleteImage",
+			err,
+		))
 		return
 	}
 }
@@ -214,12 +224,15 @@ func (r *virtualRouterImageResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Virtual Router Image",
-			"Could not read virtual router image UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read virtual router image UUID "+state.Uuid.ValueString(),
+			"GetImage",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Platform = types.StringValue(image.Platform)
 	if image.Platform == "" {
@@ -6,18 +6,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
 )
 
 var (
@@ -26,22 +31,36 @@ var (
 	_ resource.ResourceWithImportState = &virtualRouterInstanceResource{}
 )
 
+const (
+	virtualRouterReconnectActionReconnect = "Reconnect"
+	virtualRouterReconnectActionReboot    = "Reboot"
+
+	virtualRouterHaGroupTagPrefix = "haUuid::"
+	virtualRouterStateTimeout     = 10 * time.Minute
+)
+
 type virtualRouterInstanceResource struct {
 	client *client.ZSClient
 }
 
 type virtualRouterInstanceResourceModel struct {
-	Uuid                      types.String `tfsdk:"uuid"`
-	Name                      types.String `tfsdk:"name"`
-	State                     types.String `tfsdk:"state"`
-	Status                    types.String `tfsdk:"status"`
-	Description               types.String `tfsdk:"description"`
-	VirtualRouterOfferingUuid types.String `tfsdk:"virtual_router_offering_uuid"`
-	//ResourceUuid                    types.String `tfsdk:"resource_uuid" `                       // Resource UUID, if specified, the VM will use this value as its UUID.
+	Uuid                            types.String `tfsdk:"uuid"`
+	Name                            types.String `tfsdk:"name"`
+	State                           types.String `tfsdk:"state"`
+	Status                          types.String `tfsdk:"status"`
+	Description                     types.String `tfsdk:"description"`
+	VirtualRouterOfferingUuid       types.String `tfsdk:"virtual_router_offering_uuid"`
 	ZoneUuid                        types.String `tfsdk:"zone_uuid" `                           // Zone UUID, if specified, the VM will be created in the specified zone.
 	ClusterUUID                     types.String `tfsdk:"cluster_uuid" `                        // Cluster UUID, if specified, the VM will be created in the specified cluster, higher priority than zoneUuid.
 	HostUuid                        types.String `tfsdk:"host_uuid" `                           // Host UUID, if specified, the VM will be created on the specified host, higher priority than zoneUuid and clusterUuid.
 	PrimaryStorageUuidForRootVolume types.String `tfsdk:"primary_storage_uuid_for_rootvolume" ` // Primary storage UUID, if specified, the root volume will be created on the specified primary storage.
+	HaGroupUuid                     types.String `tfsdk:"ha_group_uuid"`
+	ReconnectTrigger                types.String `tfsdk:"reconnect_trigger"`
+	ReconnectAction                 types.String `tfsdk:"reconnect_action"`
+	HaStatus                        types.String `tfsdk:"ha_status"`
+	PublicIp                        types.String `tfsdk:"public_ip"`
+	ManagementIp                    types.String `tfsdk:"management_ip"`
+	GuestIps                        types.List   `tfsdk:"guest_ips"`
 }
 
 // Configure implements resource.ResourceWithConfigure.
@@ -107,25 +126,36 @@ func (r *virtualRouterInstanceResource) Create(ctx context.Context, req resource
 		virtualRouterInstanceParam.Params.PrimaryStorageUuidForRootVolume = plan.PrimaryStorageUuidForRootVolume.ValueStringPointer()
 	}
 
+	if !plan.HaGroupUuid.IsNull() && !plan.HaGroupUuid.IsUnknown() && plan.HaGroupUuid.ValueString() != "" {
+		virtualRouterInstanceParam.BaseParam.SystemTags = []string{virtualRouterHaGroupTag(plan.HaGroupUuid.ValueString())}
+	}
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	virtualRouterInstanceParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vrInstance, err := r.client.CreateVpcVRouter(virtualRouterInstanceParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Virtual Router Instance",
-			"Could not create virtual router instance, unexpected error: "+err.Error(),
-		)
+		vrInstance, err = adoptCreatedResource(ctx, r.client.GetVirtualRouterVm, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Virtual Router Instance", "CreateVpcVRouter", err, &resp.Diagnostics)
 		return
 	}
 
 	plan.Uuid = types.StringValue(vrInstance.UUID)
 	plan.Name = types.StringValue(vrInstance.Name)
 	plan.VirtualRouterOfferingUuid = types.StringValue(vrInstance.InstanceOfferingUuid)
-
-	plan.State = types.StringValue(vrInstance.State)
-	plan.Status = types.StringValue(vrInstance.State)
+	if plan.HaGroupUuid.IsUnknown() {
+		plan.HaGroupUuid = types.StringValue("")
+	}
 
 	if !plan.Description.IsNull() {
 		plan.Description = types.StringValue(vrInstance.Description)
 	}
+	applyVirtualRouterObservedState(&plan, vrInstance)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -146,10 +176,12 @@ func (r *virtualRouterInstanceResource) Delete(ctx context.Context, req resource
 	err := r.client.DestroyVmInstance(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Virtual Router Instance",
-			"Could not delete virtual router instance, unexpected error: "+err.Error(),
-		)
+			"Could not delete virtual router instance",
+			"DestroyVmInstance",
+			err,
+		))
 		return
 	}
 }
@@ -173,17 +205,19 @@ func (r *virtualRouterInstanceResource) Read(ctx context.Context, req resource.R
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Virtual Router Instance",
-			"Could not read virtual router instance UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read virtual router instance UUID "+state.Uuid.ValueString(),
+			"GetVirtualRouterVm",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(vrInstance.UUID)
 	state.Name = types.StringValue(vrInstance.Name)
-	state.State = types.StringValue(vrInstance.State)
-	state.Status = types.StringValue(vrInstance.State)
+	state.VirtualRouterOfferingUuid = types.StringValue(vrInstance.InstanceOfferingUuid)
 
 	if vrInstance.Description != "" {
 		state.Description = types.StringValue(vrInstance.Description)
@@ -191,6 +225,22 @@ func (r *virtualRouterInstanceResource) Read(ctx context.Context, req resource.R
 		state.Description = types.StringNull()
 	}
 	//state.Description = types.StringValue(vrInstance.Description)
+	applyVirtualRouterObservedState(&state, vrInstance)
+	if state.ReconnectAction.IsNull() {
+		state.ReconnectAction = types.StringValue(virtualRouterReconnectActionReconnect)
+	}
+
+	haGroupUuid, err := r.readHaGroupUuid(vrInstance.UUID)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Virtual Router Instance",
+			"Could not read HA group of virtual router instance "+vrInstance.UUID,
+			"QuerySystemTag",
+			err,
+		))
+		return
+	}
+	state.HaGroupUuid = types.StringValue(haGroupUuid)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -219,23 +269,15 @@ func (r *virtualRouterInstanceResource) Schema(_ context.Context, req resource.S
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
 				Description: "An optional description of the virtual router instance. Provides additional context or purpose of this instance.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"virtual_router_offering_uuid": schema.StringAttribute{
-				Required:    true,
-				Description: "The UUID of the virtual router offering associated with this instance. Specifies the configuration and resource settings for the virtual router.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Required: true,
+				Description: "The UUID of the virtual router offering associated with this instance. Specifies the configuration and resource settings for the virtual router. " +
+					"Changing it stops the virtual router, changes its offering and starts it again, which interrupts the traffic it routes.",
 			},
 			"state": schema.StringAttribute{
 				Computed:    true,
@@ -279,17 +321,381 @@ func (r *virtualRouterInstanceResource) Schema(_ context.Context, req resource.S
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"ha_group_uuid": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The UUID of the `zstack_vpc_ha_group` the virtual router belongs to. Changing it joins or leaves the HA group in place and reconnects the virtual router; " +
+					"set it to an empty string to leave the HA group. When unset, the current membership is read from ZStack and kept, and is empty when the router is in no HA group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reconnect_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: "An arbitrary value; changing it reconnects or reboots the virtual router according to `reconnect_action`, " +
+					"for example to re-push its configuration after a network change.",
+			},
+			"reconnect_action": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "What a change of `reconnect_trigger` does: `Reconnect` re-syncs the appliance agent, `Reboot` reboots the appliance. Defaults to `Reconnect`.",
+				Default:     stringdefault.StaticString(virtualRouterReconnectActionReconnect),
+				Validators: []validator.String{
+					stringvalidator.OneOf(virtualRouterReconnectActionReconnect, virtualRouterReconnectActionReboot),
+				},
+			},
+			"ha_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The HA status of the virtual router, e.g. `NoHa`, `Master` or `Backup`.",
+			},
+			"public_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The IP address of the virtual router on its public network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"management_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The IP address of the virtual router on its management network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guest_ips": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IP addresses of the virtual router on its guest (VPC) networks.",
+			},
 		},
 	}
 }
 
 func (r *virtualRouterInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Virtual Router Instance resource does not support updates. Please recreate the resource instead.",
-	)
+	var plan virtualRouterInstanceResourceModel
+	var state virtualRouterInstanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := state.Uuid.ValueString()
+
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
+		updateParam := param.UpdateVmInstanceParam{
+			BaseParam: param.BaseParam{},
+			Params: param.UpdateVmInstanceParamDetail{
+				Name:        plan.Name.ValueString(),
+				Description: stringPtrOrNil(plan.Description.ValueString()),
+			},
+		}
+		if _, err := r.client.UpdateVmInstance(uuid, updateParam); err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error updating Virtual Router Instance",
+				"Could not update name or description of virtual router instance "+uuid,
+				"UpdateVmInstance",
+				err,
+			))
+			return
+		}
+	}
+
+	if plan.VirtualRouterOfferingUuid.ValueString() != state.VirtualRouterOfferingUuid.ValueString() {
+		r.changeOffering(ctx, uuid, plan.VirtualRouterOfferingUuid.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	reconnect := false
+	if plan.HaGroupUuid.IsUnknown() {
+		plan.HaGroupUuid = state.HaGroupUuid
+	}
+	if !plan.HaGroupUuid.IsNull() && plan.HaGroupUuid.ValueString() != state.HaGroupUuid.ValueString() {
+		r.changeHaGroup(ctx, uuid, state.HaGroupUuid.ValueString(), plan.HaGroupUuid.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		reconnect = true
+	}
+
+	action := virtualRouterReconnectActionReconnect
+	if !plan.ReconnectTrigger.IsNull() && !plan.ReconnectTrigger.IsUnknown() && !plan.ReconnectTrigger.Equal(state.ReconnectTrigger) {
+		reconnect = true
+		action = plan.ReconnectAction.ValueString()
+	}
+	if reconnect {
+		r.reconnect(ctx, uuid, action, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	vrInstance, err := findResourceByGet(r.client.GetVirtualRouterVm, uuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading Virtual Router Instance",
+			"Could not read virtual router instance after update",
+			"GetVirtualRouterVm",
+			err,
+		))
+		return
+	}
+
+	plan.Name = types.StringValue(vrInstance.Name)
+	plan.VirtualRouterOfferingUuid = types.StringValue(vrInstance.InstanceOfferingUuid)
+	plan.Description = stringValueOrNull(vrInstance.Description)
+	applyVirtualRouterObservedState(&plan, vrInstance)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *virtualRouterInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
 }
+
+// changeOffering changes the offering of the virtual router. The platform only
+// changes the offering of a stopped appliance, so a running router is stopped
+// first and started again afterwards.
+func (r *virtualRouterInstanceResource) changeOffering(ctx context.Context, uuid, offeringUuid string, diags *diag.Diagnostics) {
+	unlock, err := lockParents(ctx, uuid)
+	if err != nil {
+		diags.AddError("Error updating Virtual Router Instance", "Could not lock virtual router instance "+uuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	vrInstance, err := findResourceByGet(r.client.GetVirtualRouterVm, uuid)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not read virtual router instance "+uuid, "GetVirtualRouterVm", err))
+		return
+	}
+	wasRunning := vrInstance.State == instanceStateRunning
+
+	if wasRunning {
+		tflog.Info(ctx, "Stopping virtual router to change its offering", map[string]any{"uuid": uuid, "offering_uuid": offeringUuid})
+		if _, err := r.client.StopVmInstance(uuid, param.StopVmInstanceParam{
+			Params: param.StopVmInstanceParamDetail{
+				Type: stringPtr(defaultInstanceStateStopType),
+			},
+		}); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not stop virtual router instance "+uuid, "StopVmInstance", err))
+			return
+		}
+		if err := r.waitForState(ctx, uuid, instanceStateStopped); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not wait for virtual router instance "+uuid+" to stop", "GetVirtualRouterVm", err))
+			return
+		}
+	}
+
+	if _, err := r.client.ChangeInstanceOffering(uuid, param.ChangeInstanceOfferingParam{
+		BaseParam: param.BaseParam{},
+		Params: param.ChangeInstanceOfferingParamDetail{
+			InstanceOfferingUuid: offeringUuid,
+		},
+	}); err != nil {
+		diags.Append(zstackErrorDiagnostic(
+			"Error updating Virtual Router Instance",
+			fmt.Sprintf("Could not change offering of virtual router instance %s to %s", uuid, offeringUuid),
+			"ChangeInstanceOffering",
+			err,
+		))
+		// Fall through so a stopped router is started again with its old offering.
+	}
+
+	if wasRunning {
+		if _, err := r.client.StartVmInstance(uuid, param.StartVmInstanceParam{
+			Params: param.StartVmInstanceParamDetail{},
+		}); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not start virtual router instance "+uuid, "StartVmInstance", err))
+			return
+		}
+		if err := r.waitForState(ctx, uuid, instanceStateRunning); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not wait for virtual router instance "+uuid+" to start", "GetVirtualRouterVm", err))
+		}
+	}
+}
+
+// changeHaGroup moves the virtual router between HA groups by replacing its
+// haUuid system tag. An empty UUID means no HA group. The router and both HA
+// groups are locked, and the tags are re-read under the lock, so concurrent
+// changes of the same router or group cannot leave it in two groups.
+func (r *virtualRouterInstanceResource) changeHaGroup(ctx context.Context, uuid, current, desired string, diags *diag.Diagnostics) {
+	unlock, err := lockParents(ctx, uuid, current, desired)
+	if err != nil {
+		diags.AddError("Error updating Virtual Router Instance", "Could not lock virtual router instance "+uuid+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	q := param.NewQueryParam()
+	q.AddQ("resourceUuid=" + uuid)
+	tags, err := r.client.QuerySystemTag(&q)
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not query HA group of virtual router instance "+uuid, "QuerySystemTag", err))
+		return
+	}
+
+	joined := false
+	for _, tag := range tags {
+		haGroupUuid, ok := strings.CutPrefix(tag.Tag, virtualRouterHaGroupTagPrefix)
+		if !ok {
+			continue
+		}
+		if desired != "" && haGroupUuid == desired {
+			joined = true
+			continue
+		}
+		if err := r.client.DeleteTag(tag.UUID, param.DeleteModePermissive); err != nil {
+			diags.Append(zstackErrorDiagnostic(
+				"Error updating Virtual Router Instance",
+				fmt.Sprintf("Could not remove virtual router instance %s from HA group %s", uuid, haGroupUuid),
+				"DeleteTag",
+				err,
+			))
+			return
+		}
+	}
+
+	if desired != "" && !joined {
+		if _, err := r.client.CreateSystemTag(param.CreateSystemTagParam{
+			BaseParam: param.BaseParam{},
+			Params: param.CreateSystemTagParamDetail{
+				ResourceType: "VmInstanceVO",
+				ResourceUuid: uuid,
+				Tag:          virtualRouterHaGroupTag(desired),
+			},
+		}); err != nil {
+			diags.Append(zstackErrorDiagnostic(
+				"Error updating Virtual Router Instance",
+				fmt.Sprintf("Could not add virtual router instance %s to HA group %s", uuid, desired),
+				"CreateSystemTag",
+				err,
+			))
+		}
+	}
+}
+
+func (r *virtualRouterInstanceResource) reconnect(ctx context.Context, uuid, action string, diags *diag.Diagnostics) {
+	tflog.Info(ctx, "Reconnecting virtual router", map[string]any{"uuid": uuid, "action": action})
+
+	if action == virtualRouterReconnectActionReboot {
+		if _, err := r.client.RebootVmInstance(uuid); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not reboot virtual router instance "+uuid, "RebootVmInstance", err))
+			return
+		}
+		if err := r.waitForState(ctx, uuid, instanceStateRunning); err != nil {
+			diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not wait for virtual router instance "+uuid+" to come back after reboot", "GetVirtualRouterVm", err))
+		}
+		return
+	}
+
+	if _, err := r.client.ReconnectVirtualRouter(uuid); err != nil {
+		diags.Append(zstackErrorDiagnostic("Error updating Virtual Router Instance", "Could not reconnect virtual router instance "+uuid, "ReconnectVirtualRouter", err))
+	}
+}
+
+func (r *virtualRouterInstanceResource) waitForState(ctx context.Context, uuid, desiredState string) error {
+	deadline := time.Now().Add(virtualRouterStateTimeout)
+
+	for {
+		vrInstance, err := findResourceByGet(r.client.GetVirtualRouterVm, uuid)
+		if err != nil {
+			return fmt.Errorf("read virtual router instance %s while waiting for state %s: %w", uuid, desiredState, err)
+		}
+		if vrInstance.State == desiredState {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timed out waiting for virtual router instance %s to reach state %s; last state was %s", uuid, desiredState, vrInstance.State)
+		}
+
+		sleepFor := 5 * time.Second
+		if remaining < sleepFor {
+			sleepFor = remaining
+		}
+
+		timer := time.NewTimer(sleepFor)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (r *virtualRouterInstanceResource) readHaGroupUuid(uuid string) (string, error) {
+	q := param.NewQueryParam()
+	q.AddQ("resourceUuid=" + uuid)
+	tags, err := r.client.QuerySystemTag(&q)
+	if err != nil {
+		return "", err
+	}
+
+	tagValues := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagValues = append(tagValues, tag.Tag)
+	}
+	return haGroupUuidFromTags(tagValues), nil
+}
+
+// applyVirtualRouterObservedState sets the attributes that only the platform
+// decides: state, status, HA status and the NIC addresses. status has always
+// mirrored the VM state, so it is kept that way for existing configurations.
+func applyVirtualRouterObservedState(model *virtualRouterInstanceResourceModel, vrInstance *view.VirtualRouterVmInventoryView) {
+	model.State = types.StringValue(vrInstance.State)
+	model.Status = types.StringValue(vrInstance.State)
+	model.HaStatus = stringValueOrNull(vrInstance.HaStatus)
+
+	nics := make([]virtualRouterNic, 0, len(vrInstance.VmNics))
+	for _, nic := range vrInstance.VmNics {
+		nics = append(nics, virtualRouterNic{L3NetworkUuid: nic.L3NetworkUuid, Ip: nic.Ip})
+	}
+	publicIp, managementIp, guestIps := classifyVirtualRouterNics(vrInstance.PublicNetworkUuid, vrInstance.ManagementNetworkUuid, nics)
+	model.PublicIp = stringValueOrNull(publicIp)
+	model.ManagementIp = stringValueOrNull(managementIp)
+	model.GuestIps = stringSliceToList(guestIps)
+}
+
+type virtualRouterNic struct {
+	L3NetworkUuid string
+	Ip            string
+}
+
+// classifyVirtualRouterNics splits the NIC addresses of a virtual router by
+// role. The public and management networks may be the same network, in which
+// case one NIC serves both roles; every other NIC is a guest NIC.
+func classifyVirtualRouterNics(publicL3Uuid, managementL3Uuid string, nics []virtualRouterNic) (publicIp, managementIp string, guestIps []string) {
+	guestIps = []string{}
+	for _, nic := range nics {
+		isPublic := nic.L3NetworkUuid != "" && nic.L3NetworkUuid == publicL3Uuid
+		isManagement := nic.L3NetworkUuid != "" && nic.L3NetworkUuid == managementL3Uuid
+		if isPublic && publicIp == "" {
+			publicIp = nic.Ip
+		}
+		if isManagement && managementIp == "" {
+			managementIp = nic.Ip
+		}
+		if !isPublic && !isManagement && nic.Ip != "" {
+			guestIps = append(guestIps, nic.Ip)
+		}
+	}
+	return publicIp, managementIp, guestIps
+}
+
+func virtualRouterHaGroupTag(haGroupUuid string) string {
+	return virtualRouterHaGroupTagPrefix + haGroupUuid
+}
+
+func haGroupUuidFromTags(tags []string) string {
+	for _, tag := range tags {
+		if haGroupUuid, ok := strings.CutPrefix(tag, virtualRouterHaGroupTagPrefix); ok && haGroupUuid != "" {
+			return haGroupUuid
+		}
+	}
+	return ""
+}
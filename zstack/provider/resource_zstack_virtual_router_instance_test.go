@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -90,6 +91,12 @@ func TestAccZStackVirtualRouterInstance_Create(t *testing.T) {
 		_ = json.NewEncoder(w).Encode(res)
 	})
 
+	// Setup system tag query used to read HA group membership
+	mux.HandleFunc("/zstack/v1/system-tags", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"inventories": []}`))
+	})
+
 	// Setup DELETE Virtual Router Instance — sets deleted state
 	mux.HandleFunc("/zstack/v1/vm-instances/mock-vr-uuid", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodDelete {
@@ -140,6 +147,8 @@ resource "zstack_virtual_router_instance" "foo" {
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("name"), knownvalue.StringExact("test-mock-vr")),
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("uuid"), knownvalue.StringExact("mock-vr-uuid")),
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("state"), knownvalue.StringExact("Running")),
+					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("status"), knownvalue.StringExact("Running")),
+					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("ha_group_uuid"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("description"), knownvalue.StringExact("mock vr created via test")),
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("virtual_router_offering_uuid"), knownvalue.StringExact("mock-offering-uuid")),
 					statecheck.ExpectKnownValue("zstack_virtual_router_instance.foo", tfjsonpath.New("zone_uuid"), knownvalue.StringExact("mock-zone-uuid")),
@@ -167,3 +176,35 @@ resource "zstack_virtual_router_instance" "foo" {
 		}
 	}
 }
+
+func TestClassifyVirtualRouterNics(t *testing.T) {
+	nics := []virtualRouterNic{
+		{L3NetworkUuid: "mgmt-l3", Ip: "10.0.0.10"},
+		{L3NetworkUuid: "public-l3", Ip: "172.20.0.10"},
+		{L3NetworkUuid: "vpc-a", Ip: "192.168.1.1"},
+		{L3NetworkUuid: "vpc-b", Ip: "192.168.2.1"},
+	}
+
+	publicIp, managementIp, guestIps := classifyVirtualRouterNics("public-l3", "mgmt-l3", nics)
+	if publicIp != "172.20.0.10" || managementIp != "10.0.0.10" {
+		t.Fatalf("unexpected public/management IPs: %q %q", publicIp, managementIp)
+	}
+	if want := []string{"192.168.1.1", "192.168.2.1"}; !reflect.DeepEqual(guestIps, want) {
+		t.Fatalf("guestIps = %v, want %v", guestIps, want)
+	}
+
+	// Public and management networks can be the same network.
+	publicIp, managementIp, guestIps = classifyVirtualRouterNics("mgmt-l3", "mgmt-l3", nics[:1])
+	if publicIp != "10.0.0.10" || managementIp != "10.0.0.10" || len(guestIps) != 0 {
+		t.Fatalf("unexpected shared network result: %q %q %v", publicIp, managementIp, guestIps)
+	}
+}
+
+func TestHaGroupUuidFromTags(t *testing.T) {
+	if got := haGroupUuidFromTags([]string{"hostname::vr", virtualRouterHaGroupTag("ha-uuid")}); got != "ha-uuid" {
+		t.Fatalf("haGroupUuidFromTags() = %q, want %q", got, "ha-uuid")
+	}
+	if got := haGroupUuidFromTags([]string{"hostname::vr", "haUuid::"}); got != "" {
+		t.Fatalf("expected no HA group, got %q", got)
+	}
+}
@@ -104,15 +104,21 @@ func (r *virtualRouterOfferingResource) Create(ctx context.Context, req resource
 		offerParam.Params.IsDefault = boolPtr(plan.IsDefault.ValueBool())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	offerParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	virtual_router, err := r.client.CreateVirtualRouterOffering(offerParam)
 	tflog.Debug(ctx, "Received virtual router offering", map[string]interface{}{
 		"virtual_router": virtual_router,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Virtual Router Offering",
-			"Could not create virtual router offering, unexpected error: "+err.Error(),
-		)
+		virtual_router, err = adoptCreatedResource(ctx, r.client.GetVirtualRouterOffering, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Virtual Router Offering", "CreateVirtualRouterOffering", err, &resp.Diagnostics)
 		return
 	}
 
@@ -143,10 +149,12 @@ func (r *virtualRouterOfferingResource) Delete(ctx context.Context, req resource
 	err := r.client.DeleteInstanceOffering(state.Uuid.ValueString(), param.DeleteModeEnforcing)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Virtual Router Offering",
-			"Could not delete virtual router offering, unexpected error: "+err.Error(),
-		)
+			"Could not delete virtual router offering",
+			"DeleteInstanceOffering",
+			err,
+		))
 		return
 	}
 }
@@ -170,12 +178,15 @@ func (r *virtualRouterOfferingResource) Read(ctx context.Context, req resource.R
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Virtual Router Offering",
-			"Could not read virtual router offering UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read virtual router offering UUID "+state.Uuid.ValueString(),
+			"GetVirtualRouterOffering",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Type = types.StringValue(virtual_router.Type)
 	if virtual_router.Type == "" {
@@ -123,9 +123,25 @@ func (r *vmCdRomResource) Create(ctx context.Context, request resource.CreateReq
 		},
 	}
 
+	unlock, err := lockParents(ctx, plan.VmInstanceUuid.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error creating VM CD-ROM", "Could not lock VM instance "+plan.VmInstanceUuid.ValueString()+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	params.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	cdrom, err := r.client.CreateVmCdRom(params)
 	if err != nil {
-		response.Diagnostics.AddError("Error creating VM CD-ROM", err.Error())
+		cdrom, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVmCdRom), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VM CD-ROM", "CreateVmCdRom", err, &response.Diagnostics)
 		return
 	}
 
@@ -157,9 +173,10 @@ func (r *vmCdRomResource) Read(ctx context.Context, request resource.ReadRequest
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError("Error reading VM CD-ROM", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error reading VM CD-ROM", "Could not read VM CD-ROM", "QueryVmCdRom", err))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Name = types.StringValue(cdrom.Name)
 	state.VmInstanceUuid = types.StringValue(cdrom.VmInstanceUuid)
@@ -198,14 +215,14 @@ func (r *vmCdRomResource) Update(ctx context.Context, request resource.UpdateReq
 	}
 
 	if _, err := r.client.UpdateVmCdRom(state.Uuid.ValueString(), params); err != nil {
-		response.Diagnostics.AddError("Error updating VM CD-ROM", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error updating VM CD-ROM", "Could not update VM CD-ROM", "UpdateVmCdRom", err))
 		return
 	}
 
 	// Re-query by UUID after Update to refresh state with the latest server-side values.
 	cdrom, err := findResourceByQuery(r.client.QueryVmCdRom, state.Uuid.ValueString())
 	if err != nil {
-		response.Diagnostics.AddError("Error re-reading VM CD-ROM after update", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error re-reading VM CD-ROM after update", "Could not re-read VM CD-ROM after update", "QueryVmCdRom", err))
 		return
 	}
 
@@ -231,9 +248,16 @@ func (r *vmCdRomResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteVmCdRom(state.Uuid.ValueString(), param.DeleteModePermissive)
+	unlock, err := lockParents(ctx, state.VmInstanceUuid.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error deleting VM CD-ROM", "Could not lock VM instance "+state.VmInstanceUuid.ValueString()+": "+err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.client.DeleteVmCdRom(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError("Error deleting VM CD-ROM", err.Error())
+		response.Diagnostics.Append(zstackErrorDiagnostic("Error deleting VM CD-ROM", "Could not delete VM CD-ROM", "DeleteVmCdRom", err))
 		return
 	}
 }
@@ -136,14 +136,31 @@ func (r *vmNicResource) Create(ctx context.Context, request resource.CreateReque
 		},
 	}
 
-	vmNic, err := r.client.CreateVmNic(p)
+	// NIC creation allocates an IP from the L3 network.
+	unlock, err := lockParents(ctx, plan.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating VM NIC",
-			"Could not create vm nic, unexpected error: "+err.Error(),
+			"Could not lock L3 network "+plan.L3NetworkUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	vmNic, err := r.client.CreateVmNic(p)
+	if err != nil {
+		vmNic, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVmNic), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VM NIC", "CreateVmNic", err, &response.Diagnostics)
+		return
+	}
 
 	plan.Uuid = types.StringValue(vmNic.UUID)
 	plan.L3NetworkUuid = types.StringValue(vmNic.L3NetworkUuid)
@@ -175,12 +192,15 @@ func (r *vmNicResource) Read(ctx context.Context, request resource.ReadRequest,
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VM NIC",
-			"Could not read VM NIC, unexpected error: "+err.Error(),
-		)
+			"Could not read VM NIC",
+			"QueryVmNic",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vmNic.UUID)
 	state.L3NetworkUuid = types.StringValue(vmNic.L3NetworkUuid)
@@ -213,16 +233,26 @@ func (r *vmNicResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
-
-	err := r.client.DeleteVmNic(state.Uuid.ValueString(), param.DeleteModePermissive)
-
+	unlock, err := lockParents(ctx, state.VmInstanceUuid.ValueString(), state.L3NetworkUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error deleting VM NIC",
-			"Could not delete vm nic, unexpected error: "+err.Error(),
+			"Could not lock parent VM instance and L3 network: "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	err = r.client.DeleteVmNic(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting VM NIC",
+			"Could not delete vm nic",
+			"DeleteVmNic",
+			err,
+		))
+		return
+	}
 }
 
 func (r *vmNicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
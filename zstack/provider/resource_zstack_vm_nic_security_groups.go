@@ -0,0 +1,278 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &vmNicSecurityGroupsResource{}
+	_ resource.ResourceWithConfigure   = &vmNicSecurityGroupsResource{}
+	_ resource.ResourceWithImportState = &vmNicSecurityGroupsResource{}
+)
+
+type vmNicSecurityGroupsResource struct {
+	client *client.ZSClient
+}
+
+type vmNicSecurityGroupsModel struct {
+	NicUuid            types.String `tfsdk:"nic_uuid"`
+	SecurityGroupUuids types.List   `tfsdk:"security_group_uuids"`
+}
+
+func VmNicSecurityGroupsResource() resource.Resource {
+	return &vmNicSecurityGroupsResource{}
+}
+
+func (r *vmNicSecurityGroupsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *vmNicSecurityGroupsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_nic_security_groups"
+}
+
+func (r *vmNicSecurityGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets the complete, ordered list of security groups of a VM NIC. " +
+			"Security groups that are not listed are detached from the NIC. " +
+			"Do not combine with `zstack_networking_secgroup_attachment` resources for the same NIC. " +
+			"Destroying this resource detaches all security groups from the NIC.",
+		Attributes: map[string]schema.Attribute{
+			"nic_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the VM NIC.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_uuids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The UUIDs of the security groups of the NIC, in evaluation order. " +
+					"The first security group gets priority 1 and is evaluated first. An empty list detaches all security groups.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vmNicSecurityGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vmNicSecurityGroupsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	var securityGroupUuids []string
+	resp.Diagnostics.Append(plan.SecurityGroupUuids.ElementsAs(ctx, &securityGroupUuids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setSecurityGroups(ctx, plan.NicUuid.ValueString(), securityGroupUuids, nil, "Error creating VM NIC Security Groups", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vmNicSecurityGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vmNicSecurityGroupsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nicUuid := state.NicUuid.ValueString()
+	if _, err := findResourceByQuery(r.client.QueryVmNic, nicUuid); err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading VM NIC Security Groups", "Could not read VM NIC "+nicUuid, "QueryVmNic", err))
+		return
+	}
+
+	securityGroupUuids, err := r.querySecurityGroups(nicUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading VM NIC Security Groups", "Could not query security groups of VM NIC "+nicUuid, "QueryVmNicInSecurityGroup", err))
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, securityGroupUuids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SecurityGroupUuids = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vmNicSecurityGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vmNicSecurityGroupsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var securityGroupUuids, previousUuids []string
+	resp.Diagnostics.Append(plan.SecurityGroupUuids.ElementsAs(ctx, &securityGroupUuids, false)...)
+	resp.Diagnostics.Append(state.SecurityGroupUuids.ElementsAs(ctx, &previousUuids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nicUuid := plan.NicUuid.ValueString()
+	r.setSecurityGroups(ctx, nicUuid, securityGroupUuids, previousUuids, "Error updating VM NIC Security Groups", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actual, err := r.querySecurityGroups(nicUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic("Error reading VM NIC Security Groups", "Could not query security groups of VM NIC "+nicUuid+" after update", "QueryVmNicInSecurityGroup", err))
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, actual)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SecurityGroupUuids = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vmNicSecurityGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vmNicSecurityGroupsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	var previousUuids []string
+	resp.Diagnostics.Append(state.SecurityGroupUuids.ElementsAs(ctx, &previousUuids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nicUuid := state.NicUuid.ValueString()
+	if _, err := findResourceByQuery(r.client.QueryVmNic, nicUuid); errors.Is(err, ErrResourceNotFound) {
+		// Deleting the NIC already dropped its security groups.
+		return
+	}
+
+	r.setSecurityGroups(ctx, nicUuid, []string{}, previousUuids, "Error deleting VM NIC Security Groups", &resp.Diagnostics)
+}
+
+func (r *vmNicSecurityGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("nic_uuid"), req, resp)
+}
+
+// setSecurityGroups replaces the security groups of the NIC with
+// securityGroupUuids, in priority order. It holds the locks of the NIC's VM
+// and of every security group gaining or losing the NIC.
+func (r *vmNicSecurityGroupsResource) setSecurityGroups(ctx context.Context, nicUuid string, securityGroupUuids, previousUuids []string, summary string, diags *diag.Diagnostics) {
+	vmUuid := ""
+	if nic, err := findResourceByQuery(r.client.QueryVmNic, nicUuid); err == nil {
+		vmUuid = nic.VmInstanceUuid
+	}
+	lockUuids := append([]string{vmUuid}, securityGroupUuids...)
+	lockUuids = append(lockUuids, previousUuids...)
+	unlock, err := lockParents(ctx, lockUuids...)
+	if err != nil {
+		diags.AddError(summary, "Could not lock the NIC's VM instance and security groups: "+err.Error())
+		return
+	}
+	defer unlock()
+
+	refs := make([]param.SetVmNicSecurityGroup_VmNicSecurityGroupRefAOParam, 0, len(securityGroupUuids))
+	for i, securityGroupUuid := range securityGroupUuids {
+		refs = append(refs, param.SetVmNicSecurityGroup_VmNicSecurityGroupRefAOParam{
+			SecurityGroupUuid: securityGroupUuid,
+			Priority:          i + 1,
+		})
+	}
+
+	tflog.Debug(ctx, "Setting VM NIC security groups", map[string]any{
+		"nic_uuid":             nicUuid,
+		"security_group_uuids": securityGroupUuids,
+	})
+
+	_, err = r.client.SetVmNicSecurityGroup(nicUuid, param.SetVmNicSecurityGroupParam{
+		BaseParam: param.BaseParam{},
+		Params: param.SetVmNicSecurityGroupParamDetail{
+			Refs: refs,
+		},
+	})
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(summary, "Could not set security groups of VM NIC "+nicUuid, "SetVmNicSecurityGroup", err))
+	}
+}
+
+// querySecurityGroups returns the security groups of the NIC ordered by
+// priority.
+func (r *vmNicSecurityGroupsResource) querySecurityGroups(nicUuid string) ([]string, error) {
+	q := param.NewQueryParam()
+	q.AddQ("vmNicUuid=" + nicUuid)
+	refs, err := r.client.QueryVmNicInSecurityGroup(&q)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].Priority < refs[j].Priority })
+	securityGroupUuids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		securityGroupUuids = append(securityGroupUuids, ref.SecurityGroupUuid)
+	}
+	return securityGroupUuids, nil
+}
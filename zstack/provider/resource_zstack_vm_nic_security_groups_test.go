@@ -0,0 +1,99 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVmNicSecurityGroupsResource_Schema(t *testing.T) {
+	var r vmNicSecurityGroupsResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"nic_uuid", "security_group_uuids"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+}
+
+func TestVmNicSecurityGroupsResource_Metadata(t *testing.T) {
+	var r vmNicSecurityGroupsResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vm_nic_security_groups" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccVmNicSecurityGroupsResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	nicUuid := createSecurityGroupTestNic(t)
+	name := testAccName("nic-sgs")
+
+	config := func(order string) string {
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_networking_secgroup" "first" {
+  name       = "%[1]s-first"
+  ip_version = 4
+}
+
+resource "zstack_networking_secgroup" "second" {
+  name       = "%[1]s-second"
+  ip_version = 4
+}
+
+resource "zstack_vm_nic_security_groups" "test" {
+  nic_uuid             = %[2]q
+  security_group_uuids = %[3]s
+}
+`, name, nicUuid, order)
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy,
+		Steps: []tfresource.TestStep{
+			// Step 1: Create
+			{
+				Config: config("[zstack_networking_secgroup.first.uuid, zstack_networking_secgroup.second.uuid]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_vm_nic_security_groups.test", tfjsonpath.New("security_group_uuids"), knownvalue.ListSizeExact(2)),
+				},
+			},
+			// Step 2: Update — reverse the evaluation order
+			{
+				Config: config("[zstack_networking_secgroup.second.uuid, zstack_networking_secgroup.first.uuid]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_vm_nic_security_groups.test", tfjsonpath.New("security_group_uuids"), knownvalue.ListSizeExact(2)),
+				},
+			},
+			// Step 3: Import
+			{
+				ResourceName:                         "zstack_vm_nic_security_groups.test",
+				ImportState:                          true,
+				ImportStateId:                        nicUuid,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "nic_uuid",
+			},
+		},
+	})
+}
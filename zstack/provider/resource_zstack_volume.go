@@ -237,16 +237,23 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !plan.PrimaryStorageUuid.IsNull() && plan.PrimaryStorageUuid.ValueString() != "" {
 		createParam.Params.PrimaryStorageUuid = stringPtr(plan.PrimaryStorageUuid.ValueString())
 	}
-	if !plan.ResourceUuid.IsNull() && plan.ResourceUuid.ValueString() != "" {
-		createParam.Params.ResourceUuid = stringPtr(plan.ResourceUuid.ValueString())
+	// A resource_uuid from the configuration takes precedence over the
+	// client-generated one; both make the create idempotent.
+	resourceUuid := plan.ResourceUuid.ValueString()
+	if plan.ResourceUuid.IsNull() || plan.ResourceUuid.IsUnknown() || resourceUuid == "" {
+		resourceUuid = recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
 
 	volume, err := r.client.CreateDataVolume(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Volume",
-			"Could not create volume, unexpected error: "+err.Error(),
-		)
+		volume, err = adoptCreatedResource(ctx, r.client.GetVolume, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Volume", "CreateDataVolume", err, &resp.Diagnostics)
 		return
 	}
 
@@ -259,23 +266,37 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if !plan.VmInstanceUuid.IsNull() && plan.VmInstanceUuid.ValueString() != "" {
-		if _, err := r.client.AttachDataVolumeToVm(volume.UUID, plan.VmInstanceUuid.ValueString(), param.AttachDataVolumeToVmParam{
-			BaseParam: param.BaseParam{},
-		}); err != nil {
+		unlock, err := lockParents(ctx, plan.VmInstanceUuid.ValueString())
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error attaching Volume",
-				"Could not attach volume UUID "+volume.UUID+" to VM instance UUID "+plan.VmInstanceUuid.ValueString()+": "+err.Error(),
+				"Could not lock VM instance UUID "+plan.VmInstanceUuid.ValueString()+": "+err.Error(),
 			)
 			return
 		}
+		_, err = r.client.AttachDataVolumeToVm(volume.UUID, plan.VmInstanceUuid.ValueString(), param.AttachDataVolumeToVmParam{
+			BaseParam: param.BaseParam{},
+		})
+		unlock()
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error attaching Volume",
+				"Could not attach volume UUID "+volume.UUID+" to VM instance UUID "+plan.VmInstanceUuid.ValueString(),
+				"AttachDataVolumeToVm",
+				err,
+			))
+			return
+		}
 	}
 
 	state, err := r.readVolume(volume.UUID, plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume",
-			"Could not read volume UUID "+volume.UUID+" after create: "+err.Error(),
-		)
+			"Could not read volume UUID "+volume.UUID+" after create",
+			"GetVolume",
+			err,
+		))
 		return
 	}
 
@@ -298,12 +319,15 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume",
-			"Could not read volume UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read volume UUID "+state.Uuid.ValueString(),
+			"GetVolume",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := volumeModelFromView(volume, state)
 
@@ -333,10 +357,12 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		if _, err := r.client.UpdateVolume(state.Uuid.ValueString(), updateParam); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating Volume",
-				"Could not update volume metadata, unexpected error: "+err.Error(),
-			)
+				"Could not update volume metadata",
+				"UpdateVolume",
+				err,
+			))
 			return
 		}
 	}
@@ -355,28 +381,34 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 				Size: plan.DiskSize.ValueInt64(),
 			},
 		}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error resizing Volume",
-				"Could not resize volume, unexpected error: "+err.Error(),
-			)
+				"Could not resize volume",
+				"ResizeDataVolume",
+				err,
+			))
 			return
 		}
 	}
 
-	if err := r.reconcileAttachment(state, plan); err != nil {
-		resp.Diagnostics.AddError(
+	if err := r.reconcileAttachment(ctx, state, plan); err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Volume attachment",
-			"Could not update volume attachment, unexpected error: "+err.Error(),
-		)
+			"Could not update volume attachment",
+			"",
+			err,
+		))
 		return
 	}
 
 	refreshedState, err := r.readVolume(state.Uuid.ValueString(), plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume",
-			"Could not read volume UUID "+state.Uuid.ValueString()+" after update: "+err.Error(),
-		)
+			"Could not read volume UUID "+state.Uuid.ValueString()+" after update",
+			"GetVolume",
+			err,
+		))
 		return
 	}
 
@@ -398,13 +430,26 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if err := r.client.DeleteDataVolume(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
+	// Deleting an attached volume detaches it from its VM first.
+	unlock, err := lockParents(ctx, state.VmInstanceUuid.ValueString())
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Volume",
-			"Could not delete volume, unexpected error: "+err.Error(),
+			"Could not lock VM instance UUID "+state.VmInstanceUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	if err := r.client.DeleteDataVolume(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting Volume",
+			"Could not delete volume",
+			"DeleteDataVolume",
+			err,
+		))
+		return
+	}
 }
 
 // ImportState implements resource.ResourceWithImportState.
@@ -421,7 +466,7 @@ func (r *volumeResource) readVolume(uuid string, prior volumeResourceModel) (vol
 	return volumeModelFromView(volume, prior), nil
 }
 
-func (r *volumeResource) reconcileAttachment(state, plan volumeResourceModel) error {
+func (r *volumeResource) reconcileAttachment(ctx context.Context, state, plan volumeResourceModel) error {
 	currentVm := state.VmInstanceUuid.ValueString()
 	desiredVm := plan.VmInstanceUuid.ValueString()
 
@@ -429,6 +474,12 @@ func (r *volumeResource) reconcileAttachment(state, plan volumeResourceModel) er
 		return nil
 	}
 
+	unlock, err := lockParents(ctx, currentVm, desiredVm)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if currentVm != "" {
 		if err := r.client.DeleteWithSpec("v1/volumes", state.Uuid.ValueString(), "vm-instances", fmt.Sprintf("deleteMode=%s", param.DeleteModePermissive), nil); err != nil {
 			return err
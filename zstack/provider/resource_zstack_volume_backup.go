@@ -157,12 +157,18 @@ func (r *volumeBackupResource) Create(ctx context.Context, request resource.Crea
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateVolumeBackup(plan.VolumeUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating Volume Backup",
-			"Could not create volume backup, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVolumeBackup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "Volume Backup", "CreateVolumeBackup", err, &response.Diagnostics)
 		return
 	}
 
@@ -199,12 +205,15 @@ func (r *volumeBackupResource) Read(ctx context.Context, request resource.ReadRe
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume Backup",
-			"Could not read volume backup UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read volume backup UUID "+state.Uuid.ValueString(),
+			"QueryVolumeBackup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(volumeBackup.UUID)
 	state.Name = types.StringValue(volumeBackup.Name)
@@ -243,10 +252,12 @@ func (r *volumeBackupResource) Delete(ctx context.Context, request resource.Dele
 	err := r.client.DeleteVolumeBackup(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Volume Backup",
-			"Could not delete volume backup, unexpected error: "+err.Error(),
-		)
+			"Could not delete volume backup",
+			"DeleteVolumeBackup",
+			err,
+		))
 		return
 	}
 }
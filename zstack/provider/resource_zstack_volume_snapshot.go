@@ -210,17 +210,23 @@ func (r *volumeSnapshotResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	snapshot, err := r.client.CreateVolumeSnapshot(plan.VolumeUuid.ValueString(), param.CreateVolumeSnapshotParam{
 		Params: param.CreateVolumeSnapshotParamDetail{
-			Name:        plan.Name.ValueString(),
-			Description: stringPtr(plan.Description.ValueString()),
+			Name:         plan.Name.ValueString(),
+			Description:  stringPtr(plan.Description.ValueString()),
+			ResourceUuid: stringPtr(resourceUuid),
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Volume Snapshot",
-			"Could not create volume snapshot, unexpected error: "+err.Error(),
-		)
+		snapshot, err = adoptCreatedResource(ctx, r.client.GetVolumeSnapshot, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Volume Snapshot", "CreateVolumeSnapshot", err, &resp.Diagnostics)
 		return
 	}
 
@@ -248,12 +254,15 @@ func (r *volumeSnapshotResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume Snapshot",
-			"Could not read volume snapshot UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read volume snapshot UUID "+state.Uuid.ValueString(),
+			"GetVolumeSnapshot",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state = volumeSnapshotModelFromView(snapshot)
 	state.Revert = priorRevert
@@ -282,10 +291,12 @@ func (r *volumeSnapshotResource) Update(ctx context.Context, req resource.Update
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error updating Volume Snapshot",
-				"Could not update volume snapshot, unexpected error: "+err.Error(),
-			)
+				"Could not update volume snapshot",
+				"UpdateVolumeSnapshot",
+				err,
+			))
 			return
 		}
 	}
@@ -294,20 +305,24 @@ func (r *volumeSnapshotResource) Update(ctx context.Context, req resource.Update
 	if plan.Revert.ValueBool() && !state.Revert.ValueBool() {
 		tflog.Info(ctx, "Reverting volume from snapshot", map[string]any{"snapshot_uuid": state.Uuid.ValueString()})
 		if _, err := r.client.RevertVolumeFromSnapshot(state.Uuid.ValueString(), param.RevertVolumeFromSnapshotParam{}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error reverting Volume Snapshot",
-				"Could not revert volume from volume snapshot UUID "+state.Uuid.ValueString()+": "+err.Error(),
-			)
+				"Could not revert volume from volume snapshot UUID "+state.Uuid.ValueString(),
+				"RevertVolumeFromSnapshot",
+				err,
+			))
 			return
 		}
 	}
 
 	snapshot, err := r.client.GetVolumeSnapshot(state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Volume Snapshot",
-			"Could not read volume snapshot after update: "+err.Error(),
-		)
+			"Could not read volume snapshot after update",
+			"GetVolumeSnapshot",
+			err,
+		))
 		return
 	}
 
@@ -332,10 +347,12 @@ func (r *volumeSnapshotResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	if err := r.client.DeleteVolumeSnapshot(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Volume Snapshot",
-			"Could not delete volume snapshot, unexpected error: "+err.Error(),
-		)
+			"Could not delete volume snapshot",
+			"DeleteVolumeSnapshot",
+			err,
+		))
 		return
 	}
 }
@@ -240,12 +240,27 @@ func (r *vpcResource) Create(ctx context.Context, request resource.CreateRequest
 		},
 	}
 
+	// Read does not refresh the IP range, DNS and virtual router set up after
+	// the L3 network is created, so an unconfirmed create is not saved as
+	// pending: those steps would look done. A VPC ZStack did create is still
+	// adopted.
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	pvc, err := r.client.CreateL3Network(p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		pvc, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryL3Network), resourceUuid, err)
+	}
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error creating VPC",
-			"Could not create vpc, unexpected error: "+err.Error(),
-		)
+			"Could not create vpc",
+			"CreateL3Network",
+			err,
+		))
 		return
 	}
 
@@ -263,37 +278,45 @@ func (r *vpcResource) Create(ctx context.Context, request resource.CreateRequest
 	}
 
 	if _, err := r.client.AttachNetworkServiceToL3Network(pvc.UUID, netSvcParam); err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error attaching network services to VPC",
-			"Could not attach network services to vpc UUID "+pvc.UUID+": "+err.Error(),
-		)
+			"Could not attach network services to vpc UUID "+pvc.UUID,
+			"AttachNetworkServiceToL3Network",
+			err,
+		))
 		return
 	}
 
 	if _, err := r.client.AddIpRangeByNetworkCidr(pvc.UUID, cidrParam); err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error adding IP range to VPC",
-			"Could not add IP range to vpc UUID "+pvc.UUID+": "+err.Error(),
-		)
+			"Could not add IP range to vpc UUID "+pvc.UUID,
+			"AddIpRangeByNetworkCidr",
+			err,
+		))
 		return
 	}
 
 	if !plan.Dns.IsNull() && plan.Dns.ValueString() != "" {
 		if _, err := r.client.AddDnsToL3Network(pvc.UUID, dnsParam); err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error adding DNS to VPC",
-				"Could not add DNS to vpc UUID "+pvc.UUID+": "+err.Error(),
-			)
+				"Could not add DNS to vpc UUID "+pvc.UUID,
+				"AddDnsToL3Network",
+				err,
+			))
 			return
 		}
 	}
 
 	if !plan.VirtualRouterUuid.IsNull() && plan.VirtualRouterUuid.ValueString() != "" {
 		if _, err := r.client.AttachL3NetworkToVm(plan.VirtualRouterUuid.ValueString(), pvc.UUID, attachVRtoVPC); err != nil {
-			response.Diagnostics.AddError(
+			response.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error attaching VPC to Virtual Router",
-				"Could not attach vpc UUID "+pvc.UUID+" to virtual router UUID "+plan.VirtualRouterUuid.ValueString()+": "+err.Error(),
-			)
+				"Could not attach vpc UUID "+pvc.UUID+" to virtual router UUID "+plan.VirtualRouterUuid.ValueString(),
+				"AttachL3NetworkToVm",
+				err,
+			))
 			return
 		}
 	}
@@ -319,10 +342,12 @@ func (r *vpcResource) Read(ctx context.Context, request resource.ReadRequest, re
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VPC",
-			"Could not read VPC, unexpected error: "+err.Error(),
-		)
+			"Could not read VPC",
+			"QueryL3Network",
+			err,
+		))
 		return
 	}
 
@@ -358,10 +383,12 @@ func (r *vpcResource) Delete(ctx context.Context, request resource.DeleteRequest
 	err := r.client.DeleteL3Network(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VPC",
-			"Could not delete vpc, unexpected error: "+err.Error(),
-		)
+			"Could not delete vpc",
+			"DeleteL3Network",
+			err,
+		))
 		return
 	}
 
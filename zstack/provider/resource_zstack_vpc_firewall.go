@@ -122,10 +122,12 @@ func (r *vpcFirewallResource) Create(ctx context.Context, request resource.Creat
 			)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error validating VPC Firewall vpc_uuid",
-			"Could not validate virtual router instance UUID "+vpcUuid+": "+err.Error(),
-		)
+			"Could not validate virtual router instance UUID "+vpcUuid,
+			"GetVirtualRouterVm",
+			err,
+		))
 		return
 	}
 	if virtualRouter.UUID == "" {
@@ -146,14 +148,30 @@ func (r *vpcFirewallResource) Create(ctx context.Context, request resource.Creat
 		},
 	}
 
-	vpcFirewall, err := r.client.CreateVpcFirewall(p)
+	unlock, err := lockParents(ctx, plan.VpcUuid.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Error creating VPC Firewall",
-			"Could not create vpc firewall, unexpected error: "+err.Error(),
+			"Could not lock VPC router "+plan.VpcUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	vpcFirewall, err := r.client.CreateVpcFirewall(p)
+	if err != nil {
+		vpcFirewall, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVpcFirewall), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VPC Firewall", "CreateVpcFirewall", err, &response.Diagnostics)
+		return
+	}
 
 	plan.Uuid = types.StringValue(vpcFirewall.UUID)
 	plan.Name = types.StringValue(vpcFirewall.Name)
@@ -180,12 +198,15 @@ func (r *vpcFirewallResource) Read(ctx context.Context, request resource.ReadReq
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VPC Firewall",
-			"Could not read VPC firewall UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read VPC firewall UUID "+state.Uuid.ValueString(),
+			"QueryVpcFirewall",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vpcFirewall.UUID)
 	state.Name = types.StringValue(vpcFirewall.Name)
@@ -225,10 +246,12 @@ func (r *vpcFirewallResource) Update(ctx context.Context, request resource.Updat
 
 	vpcFirewall, err := r.client.UpdateVpcFirewall(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VPC Firewall",
-			"Could not update vpc firewall, unexpected error: "+err.Error(),
-		)
+			"Could not update vpc firewall",
+			"UpdateVpcFirewall",
+			err,
+		))
 		return
 	}
 
@@ -0,0 +1,421 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/view"
+)
+
+var (
+	_ resource.Resource                   = &vpcFirewallRuleResource{}
+	_ resource.ResourceWithConfigure      = &vpcFirewallRuleResource{}
+	_ resource.ResourceWithImportState    = &vpcFirewallRuleResource{}
+	_ resource.ResourceWithValidateConfig = &vpcFirewallRuleResource{}
+)
+
+type vpcFirewallRuleResource struct {
+	client *client.ZSClient
+}
+
+type vpcFirewallRuleModel struct {
+	Uuid            types.String `tfsdk:"uuid"`
+	RuleSetUuid     types.String `tfsdk:"rule_set_uuid"`
+	RuleNumber      types.Int64  `tfsdk:"rule_number"`
+	Action          types.String `tfsdk:"action"`
+	Protocol        types.String `tfsdk:"protocol"`
+	SourceIp        types.String `tfsdk:"source_ip"`
+	DestinationIp   types.String `tfsdk:"destination_ip"`
+	SourcePort      types.String `tfsdk:"source_port"`
+	DestinationPort types.String `tfsdk:"destination_port"`
+	State           types.String `tfsdk:"state"`
+	Description     types.String `tfsdk:"description"`
+}
+
+func VpcFirewallRuleResource() resource.Resource {
+	return &vpcFirewallRuleResource{}
+}
+
+func (r *vpcFirewallRuleResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+func (r *vpcFirewallRuleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_vpc_firewall_rule"
+}
+
+func (r *vpcFirewallRuleResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "This resource allows you to manage rules of a VPC firewall rule set in ZStack. " +
+			"Rules are evaluated in ascending `rule_number` order; the first matching rule decides the action.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_set_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the rule set the rule belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the rule, unique within the rule set. Lower numbers are evaluated first.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 9999),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The action for matching packets: `accept`, `drop` or `reject`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "drop", "reject"),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Required:    true,
+				Description: "The protocol to match: `TCP`, `UDP`, `ICMP` or `ALL`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("TCP", "UDP", "ICMP", "ALL"),
+				},
+			},
+			"source_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The source IP address, CIDR or range to match. Matches any source when unset.",
+			},
+			"destination_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The destination IP address, CIDR or range to match. Matches any destination when unset.",
+			},
+			"source_port": schema.StringAttribute{
+				Optional:    true,
+				Description: "The source port or port range to match, e.g. `1024-65535`. Only valid for TCP and UDP.",
+			},
+			"destination_port": schema.StringAttribute{
+				Optional:    true,
+				Description: "The destination port or port range to match, e.g. `443` or `8000-8080`. Only valid for TCP and UDP.",
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("enable"),
+				Description: "Whether the rule is in effect: `enable` or `disable`. Defaults to `enable`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("enable", "disable"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description for the rule.",
+			},
+		},
+	}
+}
+
+func (r *vpcFirewallRuleResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config vpcFirewallRuleModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Protocol.IsNull() || config.Protocol.IsUnknown() {
+		return
+	}
+	protocol := config.Protocol.ValueString()
+	if protocol == "TCP" || protocol == "UDP" {
+		return
+	}
+
+	for _, port := range []struct {
+		name  string
+		value types.String
+	}{{"source_port", config.SourcePort}, {"destination_port", config.DestinationPort}} {
+		if !port.value.IsNull() && !port.value.IsUnknown() {
+			response.Diagnostics.AddAttributeError(
+				path.Root(port.name),
+				"Invalid VPC Firewall Rule Port",
+				fmt.Sprintf("%q can only be set when protocol is TCP or UDP, got %s.", port.name, protocol),
+			)
+		}
+	}
+}
+
+func (r *vpcFirewallRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan vpcFirewallRuleModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		response.Diagnostics.AddWarning("Client Not Configured", "The client was not properly configured.")
+		return
+	}
+
+	ruleSetUuid := plan.RuleSetUuid.ValueString()
+	p := param.CreateFirewallRuleParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreateFirewallRuleParamDetail{
+			RuleSetUuid: ruleSetUuid,
+			RuleNumber:  int(plan.RuleNumber.ValueInt64()),
+			Action:      plan.Action.ValueString(),
+			Protocol:    stringPtr(plan.Protocol.ValueString()),
+			SourceIp:    stringPtrOrNil(plan.SourceIp.ValueString()),
+			DestIp:      stringPtrOrNil(plan.DestinationIp.ValueString()),
+			SourcePort:  stringPtrOrNil(plan.SourcePort.ValueString()),
+			DestPort:    stringPtrOrNil(plan.DestinationPort.ValueString()),
+			State:       stringPtr(plan.State.ValueString()),
+			Description: stringPtrOrNil(plan.Description.ValueString()),
+		},
+	}
+
+	unlock, err := lockParents(ctx, ruleSetUuid)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error creating VPC Firewall Rule",
+			"Could not lock rule set "+ruleSetUuid+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	rule, err := r.client.CreateFirewallRule(p)
+	if err != nil {
+		rule, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryFirewallRule), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VPC Firewall Rule", "CreateFirewallRule", err, &response.Diagnostics)
+		return
+	}
+
+	setVpcFirewallRuleModel(&plan, rule)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state vpcFirewallRuleModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := findResourceByQuery(r.client.QueryFirewallRule, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule",
+			"Could not read VPC firewall rule UUID "+state.Uuid.ValueString(),
+			"QueryFirewallRule",
+			err,
+		))
+		return
+	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	setVpcFirewallRuleModel(&state, rule)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan vpcFirewallRuleModel
+	var state vpcFirewallRuleModel
+
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	uuid := state.Uuid.ValueString()
+	ruleSetUuid := state.RuleSetUuid.ValueString()
+
+	unlock, err := lockParents(ctx, ruleSetUuid)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error updating VPC Firewall Rule",
+			"Could not lock rule set "+ruleSetUuid+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	if p, changed := vpcFirewallRuleUpdateParam(plan, state); changed {
+		if _, err := r.client.UpdateFirewallRule(uuid, p); err != nil {
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error updating VPC Firewall Rule",
+				"Could not update VPC firewall rule UUID "+uuid,
+				"UpdateFirewallRule",
+				err,
+			))
+			return
+		}
+	}
+
+	if !plan.State.Equal(state.State) {
+		_, err := r.client.ChangeFirewallRuleState(uuid, param.ChangeFirewallRuleStateParam{
+			BaseParam: param.BaseParam{},
+			Params: param.ChangeFirewallRuleStateParamDetail{
+				State: plan.State.ValueString(),
+			},
+		})
+		if err != nil {
+			response.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error updating VPC Firewall Rule",
+				"Could not change state of VPC firewall rule UUID "+uuid,
+				"ChangeFirewallRuleState",
+				err,
+			))
+			return
+		}
+	}
+
+	rule, err := findResourceByQuery(r.client.QueryFirewallRule, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule",
+			"Could not read VPC firewall rule UUID "+uuid+" after update",
+			"QueryFirewallRule",
+			err,
+		))
+		return
+	}
+
+	setVpcFirewallRuleModel(&plan, rule)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state vpcFirewallRuleModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ruleSetUuid := state.RuleSetUuid.ValueString()
+	unlock, err := lockParents(ctx, ruleSetUuid)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error deleting VPC Firewall Rule",
+			"Could not lock rule set "+ruleSetUuid+": "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DeleteFirewallRule(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		if isZStackNotFoundError(err) {
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting VPC Firewall Rule",
+			"Could not delete VPC firewall rule UUID "+state.Uuid.ValueString(),
+			"DeleteFirewallRule",
+			err,
+		))
+	}
+}
+
+func (r *vpcFirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
+
+// vpcFirewallRuleUpdateParam returns the UpdateFirewallRule parameters for
+// the attributes that changed, and whether there are any. The rule state is
+// changed by its own API.
+func vpcFirewallRuleUpdateParam(plan, state vpcFirewallRuleModel) (param.UpdateFirewallRuleParam, bool) {
+	var detail param.UpdateFirewallRuleParamDetail
+	changed := false
+
+	if !plan.RuleNumber.Equal(state.RuleNumber) {
+		detail.RuleNumber = intPtr(int(plan.RuleNumber.ValueInt64()))
+		changed = true
+	}
+	for _, f := range []struct {
+		plan, state types.String
+		dst         **string
+	}{
+		{plan.Action, state.Action, &detail.Action},
+		{plan.Protocol, state.Protocol, &detail.Protocol},
+		{plan.SourceIp, state.SourceIp, &detail.SourceIp},
+		{plan.DestinationIp, state.DestinationIp, &detail.DestIp},
+		{plan.SourcePort, state.SourcePort, &detail.SourcePort},
+		{plan.DestinationPort, state.DestinationPort, &detail.DestPort},
+		{plan.Description, state.Description, &detail.Description},
+	} {
+		if !f.plan.Equal(f.state) {
+			*f.dst = stringPtr(f.plan.ValueString())
+			changed = true
+		}
+	}
+
+	return param.UpdateFirewallRuleParam{BaseParam: param.BaseParam{}, Params: detail}, changed
+}
+
+func setVpcFirewallRuleModel(model *vpcFirewallRuleModel, rule *view.VpcFirewallRuleInventoryView) {
+	model.Uuid = types.StringValue(rule.UUID)
+	model.RuleSetUuid = types.StringValue(rule.RuleSetUuid)
+	model.RuleNumber = types.Int64Value(int64(rule.RuleNumber))
+	model.Action = types.StringValue(rule.Action)
+	model.Protocol = types.StringValue(rule.Protocol)
+	model.SourceIp = stringValueOrNull(rule.SourceIp)
+	model.DestinationIp = stringValueOrNull(rule.DestIp)
+	model.SourcePort = stringValueOrNull(rule.SourcePort)
+	model.DestinationPort = stringValueOrNull(rule.DestPort)
+	model.State = types.StringValue(rule.State)
+	model.Description = stringValueOrNull(rule.Description)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &vpcFirewallRuleSetResource{}
+	_ resource.ResourceWithConfigure   = &vpcFirewallRuleSetResource{}
+	_ resource.ResourceWithImportState = &vpcFirewallRuleSetResource{}
+)
+
+type vpcFirewallRuleSetResource struct {
+	client *client.ZSClient
+}
+
+type vpcFirewallRuleSetModel struct {
+	Uuid          types.String `tfsdk:"uuid"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	DefaultAction types.String `tfsdk:"default_action"`
+}
+
+func VpcFirewallRuleSetResource() resource.Resource {
+	return &vpcFirewallRuleSetResource{}
+}
+
+func (r *vpcFirewallRuleSetResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*client.ZSClient)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", request.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+func (r *vpcFirewallRuleSetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_vpc_firewall_rule_set"
+}
+
+func (r *vpcFirewallRuleSetResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "This resource allows you to manage VPC firewall rule sets in ZStack. " +
+			"A rule set holds an ordered list of `zstack_vpc_firewall_rule` rules and is bound to VPC router interfaces with `zstack_vpc_firewall_rule_set_attachment`.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The UUID of the rule set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the rule set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description for the rule set.",
+			},
+			"default_action": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The action for packets that match no rule: `accept`, `drop` or `reject`. Defaults to the ZStack default.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "drop", "reject"),
+				},
+			},
+		},
+	}
+}
+
+func (r *vpcFirewallRuleSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan vpcFirewallRuleSetModel
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		response.Diagnostics.AddWarning("Client Not Configured", "The client was not properly configured.")
+		return
+	}
+
+	p := param.CreateFirewallRuleSetParam{
+		BaseParam: param.BaseParam{},
+		Params: param.CreateFirewallRuleSetParamDetail{
+			Name:        plan.Name.ValueString(),
+			Description: stringPtrOrNil(plan.Description.ValueString()),
+			ActionType:  stringPtrOrNil(plan.DefaultAction.ValueString()),
+		},
+	}
+
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	ruleSet, err := r.client.CreateFirewallRuleSet(p)
+	if err != nil {
+		ruleSet, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryFirewallRuleSet), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VPC Firewall Rule Set", "CreateFirewallRuleSet", err, &response.Diagnostics)
+		return
+	}
+
+	plan.Uuid = types.StringValue(ruleSet.UUID)
+	plan.Name = types.StringValue(ruleSet.Name)
+	plan.Description = stringValueOrNull(ruleSet.Description)
+	plan.DefaultAction = types.StringValue(ruleSet.ActionType)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state vpcFirewallRuleSetModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ruleSet, err := findResourceByQuery(r.client.QueryFirewallRuleSet, state.Uuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule Set",
+			"Could not read VPC firewall rule set UUID "+state.Uuid.ValueString(),
+			"QueryFirewallRuleSet",
+			err,
+		))
+		return
+	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
+
+	state.Uuid = types.StringValue(ruleSet.UUID)
+	state.Name = types.StringValue(ruleSet.Name)
+	state.Description = stringValueOrNull(ruleSet.Description)
+	state.DefaultAction = types.StringValue(ruleSet.ActionType)
+
+	diags = response.State.Set(ctx, &state)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan vpcFirewallRuleSetModel
+	var state vpcFirewallRuleSetModel
+
+	diags := request.Plan.Get(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	diags = request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	p := param.UpdateFirewallRuleSetParam{
+		BaseParam: param.BaseParam{},
+		Params: param.UpdateFirewallRuleSetParamDetail{
+			Name: stringPtr(plan.Name.ValueString()),
+		},
+	}
+	if !plan.Description.Equal(state.Description) {
+		p.Params.Description = stringPtr(plan.Description.ValueString())
+	}
+	if !plan.DefaultAction.IsUnknown() && !plan.DefaultAction.Equal(state.DefaultAction) {
+		p.Params.ActionType = stringPtr(plan.DefaultAction.ValueString())
+	}
+
+	uuid := state.Uuid.ValueString()
+	if _, err := r.client.UpdateFirewallRuleSet(uuid, p); err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error updating VPC Firewall Rule Set",
+			"Could not update VPC firewall rule set UUID "+uuid,
+			"UpdateFirewallRuleSet",
+			err,
+		))
+		return
+	}
+
+	ruleSet, err := findResourceByQuery(r.client.QueryFirewallRuleSet, uuid)
+	if err != nil {
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule Set",
+			"Could not read VPC firewall rule set UUID "+uuid+" after update",
+			"QueryFirewallRuleSet",
+			err,
+		))
+		return
+	}
+
+	plan.Uuid = types.StringValue(ruleSet.UUID)
+	plan.Name = types.StringValue(ruleSet.Name)
+	plan.Description = stringValueOrNull(ruleSet.Description)
+	plan.DefaultAction = types.StringValue(ruleSet.ActionType)
+
+	diags = response.State.Set(ctx, plan)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *vpcFirewallRuleSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state vpcFirewallRuleSetModel
+	diags := request.State.Get(ctx, &state)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallRuleSet(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		if isZStackNotFoundError(err) {
+			return
+		}
+		response.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting VPC Firewall Rule Set",
+			"Could not delete VPC firewall rule set UUID "+state.Uuid.ValueString(),
+			"DeleteFirewallRuleSet",
+			err,
+		))
+	}
+}
+
+func (r *vpcFirewallRuleSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+}
@@ -0,0 +1,378 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &vpcFirewallRuleSetAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &vpcFirewallRuleSetAttachmentResource{}
+	_ resource.ResourceWithImportState = &vpcFirewallRuleSetAttachmentResource{}
+)
+
+type vpcFirewallRuleSetAttachmentResource struct {
+	client *client.ZSClient
+}
+
+type vpcFirewallRuleSetAttachmentModel struct {
+	ID            types.String `tfsdk:"id"`
+	RuleSetUuid   types.String `tfsdk:"rule_set_uuid"`
+	L3NetworkUuid types.String `tfsdk:"l3_network_uuid"`
+	Direction     types.String `tfsdk:"direction"`
+}
+
+func VpcFirewallRuleSetAttachmentResource() resource.Resource {
+	return &vpcFirewallRuleSetAttachmentResource{}
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_firewall_rule_set_attachment"
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Bind a VPC firewall rule set to a VPC router interface, identified by the L3 network the interface is on. " +
+			"The direction can be changed in place. Destroying this resource unbinds the rule set without deleting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `rule_set_uuid:l3_network_uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_set_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the rule set to bind.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"l3_network_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the L3 network of the VPC router interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Required:    true,
+				Description: "The traffic the rule set filters: `in` for packets entering the router through the interface, `out` for packets leaving it.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("in", "out"),
+				},
+			},
+		},
+	}
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcFirewallRuleSetAttachmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	ruleSetUuid := plan.RuleSetUuid.ValueString()
+	l3NetworkUuid := plan.L3NetworkUuid.ValueString()
+	direction := plan.Direction.ValueString()
+	plan.ID = types.StringValue(vpcFirewallRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid))
+
+	unlock, err := lockParents(ctx, ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VPC Firewall Rule Set Attachment",
+			"Could not lock the rule set and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	current, err := r.attachedDirection(ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating VPC Firewall Rule Set Attachment",
+			"Could not query rule set L3 network bindings",
+			"QueryFirewallRuleSetL3Ref",
+			err,
+		))
+		return
+	}
+
+	if current != direction {
+		if current != "" {
+			r.detach(ctx, ruleSetUuid, l3NetworkUuid, current, "Error creating VPC Firewall Rule Set Attachment", &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		r.attach(ctx, ruleSetUuid, l3NetworkUuid, direction, "Error creating VPC Firewall Rule Set Attachment", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcFirewallRuleSetAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	direction, err := r.attachedDirection(state.RuleSetUuid.ValueString(), state.L3NetworkUuid.ValueString())
+	if err != nil {
+		if isZStackNotFoundError(err) || errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule Set Attachment",
+			"Could not query rule set L3 network bindings",
+			"QueryFirewallRuleSetL3Ref",
+			err,
+		))
+		return
+	}
+
+	if direction == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(vpcFirewallRuleSetAttachmentID(state.RuleSetUuid.ValueString(), state.L3NetworkUuid.ValueString()))
+	state.Direction = types.StringValue(direction)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the binding to the new direction: ZStack has no API to change
+// the direction of a binding, so it is detached and attached again.
+func (r *vpcFirewallRuleSetAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcFirewallRuleSetAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleSetUuid := state.RuleSetUuid.ValueString()
+	l3NetworkUuid := state.L3NetworkUuid.ValueString()
+
+	unlock, err := lockParents(ctx, ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating VPC Firewall Rule Set Attachment",
+			"Could not lock the rule set and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	r.detach(ctx, ruleSetUuid, l3NetworkUuid, state.Direction.ValueString(), "Error updating VPC Firewall Rule Set Attachment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.attach(ctx, ruleSetUuid, l3NetworkUuid, plan.Direction.ValueString(), "Error updating VPC Firewall Rule Set Attachment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	direction, err := r.attachedDirection(ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VPC Firewall Rule Set Attachment",
+			"Could not query rule set L3 network bindings after update",
+			"QueryFirewallRuleSetL3Ref",
+			err,
+		))
+		return
+	}
+	plan.Direction = types.StringValue(direction)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcFirewallRuleSetAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	ruleSetUuid := state.RuleSetUuid.ValueString()
+	l3NetworkUuid := state.L3NetworkUuid.ValueString()
+
+	unlock, err := lockParents(ctx, ruleSetUuid, l3NetworkUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting VPC Firewall Rule Set Attachment",
+			"Could not lock the rule set and L3 network: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	r.detach(ctx, ruleSetUuid, l3NetworkUuid, state.Direction.ValueString(), "Error deleting VPC Firewall Rule Set Attachment", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ruleSetUuid, l3NetworkUuid, err := parseVpcFirewallRuleSetAttachmentID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: rule_set_uuid:l3_network_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vpcFirewallRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_set_uuid"), ruleSetUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("l3_network_uuid"), l3NetworkUuid)...)
+}
+
+func (r *vpcFirewallRuleSetAttachmentResource) attach(ctx context.Context, ruleSetUuid, l3NetworkUuid, direction, summary string, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "Binding VPC firewall rule set", map[string]any{
+		"rule_set_uuid":   ruleSetUuid,
+		"l3_network_uuid": l3NetworkUuid,
+		"direction":       direction,
+	})
+
+	_, err := r.client.AttachFirewallRuleSetToL3(ruleSetUuid, l3NetworkUuid, param.AttachFirewallRuleSetToL3Param{
+		BaseParam: param.BaseParam{},
+		Params: param.AttachFirewallRuleSetToL3ParamDetail{
+			Forward: direction,
+		},
+	})
+	if err != nil {
+		diags.Append(zstackErrorDiagnostic(
+			summary,
+			fmt.Sprintf("Could not bind rule set %s to L3 network %s in direction %q", ruleSetUuid, l3NetworkUuid, direction),
+			"AttachFirewallRuleSetToL3",
+			err,
+		))
+	}
+}
+
+// detach unbinds the rule set. A failed call is ignored when the binding
+// turns out to be gone already.
+func (r *vpcFirewallRuleSetAttachmentResource) detach(ctx context.Context, ruleSetUuid, l3NetworkUuid, direction, summary string, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, "Unbinding VPC firewall rule set", map[string]any{
+		"rule_set_uuid":   ruleSetUuid,
+		"l3_network_uuid": l3NetworkUuid,
+		"direction":       direction,
+	})
+
+	_, err := r.client.DetachRuleSetFromL3(l3NetworkUuid, param.DetachRuleSetFromL3Param{
+		BaseParam: param.BaseParam{},
+		Params: param.DetachRuleSetFromL3ParamDetail{
+			Forward: direction,
+		},
+	})
+	if err == nil {
+		return
+	}
+
+	current, queryErr := r.attachedDirection(ruleSetUuid, l3NetworkUuid)
+	if queryErr != nil {
+		if isZStackNotFoundError(queryErr) || errors.Is(queryErr, ErrResourceNotFound) {
+			return
+		}
+		diags.Append(zstackErrorDiagnostic(
+			summary,
+			fmt.Sprintf("Unbind failed (%s) and could not verify binding status", err.Error()),
+			"QueryFirewallRuleSetL3Ref",
+			queryErr,
+		))
+		return
+	}
+	if current == direction {
+		diags.Append(zstackErrorDiagnostic(
+			summary,
+			fmt.Sprintf("Could not unbind rule set %s from L3 network %s", ruleSetUuid, l3NetworkUuid),
+			"DetachRuleSetFromL3",
+			err,
+		))
+	}
+}
+
+// attachedDirection returns the direction the rule set is bound to the L3
+// network in, or "" when it is not bound.
+func (r *vpcFirewallRuleSetAttachmentResource) attachedDirection(ruleSetUuid, l3NetworkUuid string) (string, error) {
+	q := param.NewQueryParam()
+	q.AddQ("ruleSetUuid=" + ruleSetUuid)
+	q.AddQ("l3NetworkUuid=" + l3NetworkUuid)
+	refs, err := r.client.QueryFirewallRuleSetL3Ref(&q)
+	if err != nil {
+		return "", err
+	}
+	if len(refs) == 0 {
+		return "", nil
+	}
+	return refs[0].PacketsForwardType, nil
+}
+
+func vpcFirewallRuleSetAttachmentID(ruleSetUuid, l3NetworkUuid string) string {
+	return fmt.Sprintf("%s:%s", ruleSetUuid, l3NetworkUuid)
+}
+
+func parseVpcFirewallRuleSetAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected rule_set_uuid:l3_network_uuid")
+	}
+	return parts[0], parts[1], nil
+}
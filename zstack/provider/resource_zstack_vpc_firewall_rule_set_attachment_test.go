@@ -0,0 +1,61 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestVpcFirewallRuleSetAttachmentResource_Schema(t *testing.T) {
+	var r vpcFirewallRuleSetAttachmentResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"rule_set_uuid", "l3_network_uuid", "direction"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	id, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Fatal("schema missing computed attribute \"id\"")
+	}
+	if !id.IsComputed() {
+		t.Error("attribute \"id\" should be computed")
+	}
+}
+
+func TestVpcFirewallRuleSetAttachmentResource_Metadata(t *testing.T) {
+	var r vpcFirewallRuleSetAttachmentResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vpc_firewall_rule_set_attachment" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestParseVpcFirewallRuleSetAttachmentID(t *testing.T) {
+	ruleSetUuid, l3NetworkUuid, err := parseVpcFirewallRuleSetAttachmentID("rs-uuid:l3-uuid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ruleSetUuid != "rs-uuid" || l3NetworkUuid != "l3-uuid" {
+		t.Fatalf("unexpected parsed id: %q %q", ruleSetUuid, l3NetworkUuid)
+	}
+
+	invalidIDs := []string{"", "rs-uuid", ":l3-uuid", "rs-uuid:"}
+	for _, id := range invalidIDs {
+		if _, _, err := parseVpcFirewallRuleSetAttachmentID(id); err == nil {
+			t.Fatalf("expected error for invalid id %q", id)
+		}
+	}
+}
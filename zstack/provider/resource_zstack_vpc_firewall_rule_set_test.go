@@ -0,0 +1,106 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVpcFirewallRuleSetResource_Schema(t *testing.T) {
+	var r vpcFirewallRuleSetResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	name, ok := resp.Schema.Attributes["name"]
+	if !ok {
+		t.Fatal("schema missing required attribute \"name\"")
+	}
+	if !name.IsRequired() {
+		t.Error("attribute \"name\" should be required")
+	}
+
+	computed := []string{"uuid", "default_action"}
+	for _, attr := range computed {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing computed attribute %q", attr)
+		}
+		if !a.IsComputed() {
+			t.Errorf("attribute %q should be computed", attr)
+		}
+	}
+}
+
+func TestVpcFirewallRuleSetResource_Metadata(t *testing.T) {
+	var r vpcFirewallRuleSetResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vpc_firewall_rule_set" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestAccVpcFirewallRuleSetResource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("acceptance test skipped unless TF_ACC is set")
+	}
+
+	name := testAccName("fw-rule-set")
+	config := func(action, state string) string {
+		return providerConfig() + fmt.Sprintf(`
+resource "zstack_vpc_firewall_rule_set" "test" {
+  name           = %q
+  default_action = "drop"
+}
+
+resource "zstack_vpc_firewall_rule" "https" {
+  rule_set_uuid    = zstack_vpc_firewall_rule_set.test.uuid
+  rule_number      = 100
+  action           = %q
+  protocol         = "TCP"
+  destination_port = "443"
+  state            = %q
+}
+
+data "zstack_vpc_firewall_rules" "test" {
+  rule_set_uuid = zstack_vpc_firewall_rule.https.rule_set_uuid
+}
+`, name, action, state)
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config("accept", "enable"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_vpc_firewall_rule_set.test", tfjsonpath.New("default_action"), knownvalue.StringExact("drop")),
+					statecheck.ExpectKnownValue("zstack_vpc_firewall_rule.https", tfjsonpath.New("state"), knownvalue.StringExact("enable")),
+					statecheck.ExpectKnownValue("data.zstack_vpc_firewall_rules.test", tfjsonpath.New("rules"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			{
+				Config: config("reject", "disable"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_vpc_firewall_rule.https", tfjsonpath.New("action"), knownvalue.StringExact("reject")),
+					statecheck.ExpectKnownValue("zstack_vpc_firewall_rule.https", tfjsonpath.New("state"), knownvalue.StringExact("disable")),
+				},
+			},
+			{
+				ResourceName:                         "zstack_vpc_firewall_rule_set.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+		},
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVpcFirewallRuleResource_Schema(t *testing.T) {
+	var r vpcFirewallRuleResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"rule_set_uuid", "rule_number", "action", "protocol"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	optional := []string{"source_ip", "destination_ip", "source_port", "destination_port", "state", "description"}
+	for _, attr := range optional {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing optional attribute %q", attr)
+		}
+		if !a.IsOptional() {
+			t.Errorf("attribute %q should be optional", attr)
+		}
+	}
+}
+
+func TestVpcFirewallRuleResource_Metadata(t *testing.T) {
+	var r vpcFirewallRuleResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vpc_firewall_rule" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestVpcFirewallRuleUpdateParam(t *testing.T) {
+	state := vpcFirewallRuleModel{
+		RuleNumber:      types.Int64Value(100),
+		Action:          types.StringValue("accept"),
+		Protocol:        types.StringValue("TCP"),
+		SourceIp:        types.StringNull(),
+		DestinationIp:   types.StringValue("10.0.0.0/24"),
+		SourcePort:      types.StringNull(),
+		DestinationPort: types.StringValue("443"),
+		State:           types.StringValue("enable"),
+		Description:     types.StringNull(),
+	}
+
+	plan := state
+	plan.State = types.StringValue("disable")
+	if _, changed := vpcFirewallRuleUpdateParam(plan, state); changed {
+		t.Fatal("a state change alone should not need UpdateFirewallRule")
+	}
+
+	plan = state
+	plan.RuleNumber = types.Int64Value(200)
+	plan.DestinationPort = types.StringValue("8443")
+	p, changed := vpcFirewallRuleUpdateParam(plan, state)
+	if !changed {
+		t.Fatal("expected changes")
+	}
+	if p.Params.RuleNumber == nil || *p.Params.RuleNumber != 200 {
+		t.Errorf("unexpected rule number: %v", p.Params.RuleNumber)
+	}
+	if p.Params.DestPort == nil || *p.Params.DestPort != "8443" {
+		t.Errorf("unexpected destination port: %v", p.Params.DestPort)
+	}
+	if p.Params.Action != nil || p.Params.Protocol != nil || p.Params.DestIp != nil {
+		t.Error("unchanged attributes should not be sent")
+	}
+}
@@ -125,12 +125,18 @@ func (r *vpcHaGroupResource) Create(ctx context.Context, request resource.Create
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vpcHaGroup, err := r.client.CreateVpcHaGroup(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating VPC HA Group",
-			"Could not create vpc ha group, unexpected error: "+err.Error(),
-		)
+		vpcHaGroup, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVpcHaGroup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VPC HA Group", "CreateVpcHaGroup", err, &response.Diagnostics)
 		return
 	}
 
@@ -164,12 +170,15 @@ func (r *vpcHaGroupResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VPC HA Group",
-			"Could not read VPC HA Group, unexpected error: "+err.Error(),
-		)
+			"Could not read VPC HA Group",
+			"QueryVpcHaGroup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vpcHaGroup.UUID)
 	state.Name = types.StringValue(vpcHaGroup.Name)
@@ -208,10 +217,12 @@ func (r *vpcHaGroupResource) Update(ctx context.Context, request resource.Update
 
 	vpcHaGroup, err := r.client.UpdateVpcHaGroup(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VPC HA Group",
-			"Could not update vpc ha group, unexpected error: "+err.Error(),
-		)
+			"Could not update vpc ha group",
+			"UpdateVpcHaGroup",
+			err,
+		))
 		return
 	}
 
@@ -243,10 +254,12 @@ func (r *vpcHaGroupResource) Delete(ctx context.Context, request resource.Delete
 	err := r.client.DeleteVpcHaGroup(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VPC HA Group",
-			"Could not delete vpc ha group, unexpected error: "+err.Error(),
-		)
+			"Could not delete vpc ha group",
+			"DeleteVpcHaGroup",
+			err,
+		))
 		return
 	}
 }
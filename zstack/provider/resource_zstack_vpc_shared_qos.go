@@ -143,14 +143,30 @@ func (r *vpcSharedQosResource) Create(ctx context.Context, req resource.CreateRe
 		createParam.Params.Bandwidth = &bandwidth
 	}
 
-	result, err := r.client.CreateVpcSharedQos(createParam)
+	unlock, err := lockParents(ctx, plan.VpcUuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating VPC Shared QoS",
-			"Could not create vpc shared qos, unexpected error: "+err.Error(),
+			"Could not lock VPC router "+plan.VpcUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
+	result, err := r.client.CreateVpcSharedQos(createParam)
+	if err != nil {
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVpcSharedQos), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "VPC Shared QoS", "CreateVpcSharedQos", err, &resp.Diagnostics)
+		return
+	}
 
 	plan.Uuid = types.StringValue(result.UUID)
 	plan.Name = types.StringValue(result.Name)
@@ -185,12 +201,15 @@ func (r *vpcSharedQosResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VPC Shared QoS",
-			"Could not read VPC shared QoS, unexpected error: "+err.Error(),
-		)
+			"Could not read VPC shared QoS",
+			"QueryVpcSharedQos",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(result.UUID)
 	state.Name = types.StringValue(result.Name)
@@ -227,10 +246,12 @@ func (r *vpcSharedQosResource) Update(ctx context.Context, req resource.UpdateRe
 
 	result, err := r.client.UpdateVpcSharedQos(plan.Uuid.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VPC Shared QoS",
-			"Could not update vpc shared qos, unexpected error: "+err.Error(),
-		)
+			"Could not update vpc shared qos",
+			"UpdateVpcSharedQos",
+			err,
+		))
 		return
 	}
 
@@ -242,10 +263,12 @@ func (r *vpcSharedQosResource) Update(ctx context.Context, req resource.UpdateRe
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing VPC Shared QoS bandwidth",
-				"Could not change vpc shared qos bandwidth, unexpected error: "+err.Error(),
-			)
+				"Could not change vpc shared qos bandwidth",
+				"ChangeVpcSharedQosBandwidth",
+				err,
+			))
 			return
 		}
 	}
@@ -277,14 +300,26 @@ func (r *vpcSharedQosResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.client.DeleteVpcSharedQos(state.Uuid.ValueString(), param.DeleteModePermissive)
+	unlock, err := lockParents(ctx, state.VpcUuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting VPC Shared QoS",
-			"Could not delete vpc shared qos, unexpected error: "+err.Error(),
+			"Could not lock VPC router "+state.VpcUuid.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	defer unlock()
+
+	err = r.client.DeleteVpcSharedQos(state.Uuid.ValueString(), param.DeleteModePermissive)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error deleting VPC Shared QoS",
+			"Could not delete vpc shared qos",
+			"DeleteVpcSharedQos",
+			err,
+		))
+		return
+	}
 
 	tflog.Info(ctx, "VPC Shared QoS deleted", map[string]interface{}{
 		"uuid": state.Uuid.ValueString(),
@@ -6,22 +6,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
 	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
 )
 
 var (
-	_ resource.Resource                = &vrouterRouteEntryResource{}
-	_ resource.ResourceWithConfigure   = &vrouterRouteEntryResource{}
-	_ resource.ResourceWithImportState = &vrouterRouteEntryResource{}
+	_ resource.Resource                   = &vrouterRouteEntryResource{}
+	_ resource.ResourceWithConfigure      = &vrouterRouteEntryResource{}
+	_ resource.ResourceWithImportState    = &vrouterRouteEntryResource{}
+	_ resource.ResourceWithValidateConfig = &vrouterRouteEntryResource{}
+)
+
+const (
+	vrouterRouteEntryTypeStatic    = "UserStatic"
+	vrouterRouteEntryTypeBlackHole = "UserBlackHole"
 )
 
 type vrouterRouteEntryResource struct {
@@ -91,12 +100,16 @@ func (r *vrouterRouteEntryResource) Schema(_ context.Context, request resource.S
 				},
 			},
 			"type": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "The type of the route entry.",
+				Optional: true,
+				Computed: true,
+				Description: "The type of the route entry: `UserStatic` routes to the `target` next hop, " +
+					"`UserBlackHole` silently drops traffic to the destination. Defaults to `UserStatic`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vrouterRouteEntryTypeStatic, vrouterRouteEntryTypeBlackHole),
+				},
 			},
 			"destination": schema.StringAttribute{
 				Required:    true,
@@ -106,9 +119,10 @@ func (r *vrouterRouteEntryResource) Schema(_ context.Context, request resource.S
 				},
 			},
 			"target": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "The target of the route entry.",
+				Optional: true,
+				Computed: true,
+				Description: "The next hop IP address of the route entry, in the same IP version as `destination`. " +
+					"Required for `UserStatic` entries and must be unset for `UserBlackHole` entries.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -125,6 +139,52 @@ func (r *vrouterRouteEntryResource) Schema(_ context.Context, request resource.S
 	}
 }
 
+func (r *vrouterRouteEntryResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config vrouterRouteEntryResourceModel
+	diags := request.Config.Get(ctx, &config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Destination.IsUnknown() || config.Target.IsUnknown() {
+		return
+	}
+
+	_, destination, err := net.ParseCIDR(config.Destination.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("destination"),
+			"Invalid Route Destination",
+			fmt.Sprintf("%q is not a valid CIDR block.", config.Destination.ValueString()),
+		)
+		return
+	}
+
+	if config.Type.ValueString() == vrouterRouteEntryTypeBlackHole {
+		if !config.Target.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("target"),
+				"Unexpected Route Target",
+				"Blackhole route entries drop matching traffic and cannot have a next hop; remove \"target\".",
+			)
+		}
+		return
+	}
+
+	if config.Target.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("target"),
+			"Missing Route Target",
+			fmt.Sprintf("\"target\" is required for %s route entries. Use type %q to drop traffic instead.", vrouterRouteEntryTypeStatic, vrouterRouteEntryTypeBlackHole),
+		)
+		return
+	}
+	if err := validateRouteNextHop(destination, config.Target.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("target"), "Invalid Route Target", err.Error())
+	}
+}
+
 func (r *vrouterRouteEntryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var plan vrouterRouteEntryResourceModel
 	diags := request.Plan.Get(ctx, &plan)
@@ -149,12 +209,18 @@ func (r *vrouterRouteEntryResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vrouterRouteEntry, err := r.client.AddVRouterRouteEntry(plan.RouteTableUuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating VRouter Route Entry",
-			"Could not create vrouter route entry, unexpected error: "+err.Error(),
-		)
+		vrouterRouteEntry, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVRouterRouteEntry), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VRouter Route Entry", "AddVRouterRouteEntry", err, &response.Diagnostics)
 		return
 	}
 
@@ -187,12 +253,15 @@ func (r *vrouterRouteEntryResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VRouter Route Entrie",
-			"Could not read VRouter Route Entrie, unexpected error: "+err.Error(),
-		)
+			"Could not read VRouter Route Entrie",
+			"QueryVRouterRouteEntry",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vrouterRouteEntry.UUID)
 	state.RouteTableUuid = types.StringValue(vrouterRouteEntry.RouteTableUuid)
@@ -224,14 +293,15 @@ func (r *vrouterRouteEntryResource) Delete(ctx context.Context, request resource
 		return
 	}
 
-
 	err := r.client.DeleteVRouterRouteEntry(state.RouteTableUuid.ValueString(), state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VRouter Route Entry",
-			"Could not delete vrouter route entry, unexpected error: "+err.Error(),
-		)
+			"Could not delete vrouter route entry",
+			"DeleteVRouterRouteEntry",
+			err,
+		))
 		return
 	}
 }
@@ -247,3 +317,19 @@ func intPtrFromInt64OrNil(v types.Int64) *int {
 	val := int(v.ValueInt64())
 	return &val
 }
+
+// validateRouteNextHop checks that nextHop is a unicast IP address of the same
+// IP version as destination.
+func validateRouteNextHop(destination *net.IPNet, nextHop string) error {
+	ip := net.ParseIP(nextHop)
+	if ip == nil {
+		return fmt.Errorf("%q is not a valid IP address", nextHop)
+	}
+	if (ip.To4() != nil) != (destination.IP.To4() != nil) {
+		return fmt.Errorf("next hop %s and destination %s are of different IP versions", nextHop, destination)
+	}
+	if ip.IsUnspecified() || ip.IsMulticast() || ip.IsLoopback() {
+		return fmt.Errorf("next hop %s is not a unicast address", nextHop)
+	}
+	return nil
+}
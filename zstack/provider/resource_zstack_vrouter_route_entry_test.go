@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -48,3 +49,29 @@ func TestVrouterRouteEntryResource_Metadata(t *testing.T) {
 		t.Errorf("unexpected type name: %s", resp.TypeName)
 	}
 }
+
+func TestValidateRouteNextHop(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.10.0.0/16")
+	_, v6, _ := net.ParseCIDR("fd00:10::/64")
+
+	tests := []struct {
+		destination *net.IPNet
+		nextHop     string
+		wantErr     bool
+	}{
+		{v4, "192.168.1.1", false},
+		{v6, "fd00::1", false},
+		{v4, "fd00::1", true},
+		{v6, "192.168.1.1", true},
+		{v4, "not-an-ip", true},
+		{v4, "0.0.0.0", true},
+		{v4, "224.0.0.5", true},
+		{v4, "127.0.0.1", true},
+	}
+	for _, tt := range tests {
+		err := validateRouteNextHop(tt.destination, tt.nextHop)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateRouteNextHop(%s, %q) error = %v, wantErr %v", tt.destination, tt.nextHop, err, tt.wantErr)
+		}
+	}
+}
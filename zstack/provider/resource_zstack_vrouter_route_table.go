@@ -109,12 +109,18 @@ func (r *vrouterRouteTableResource) Create(ctx context.Context, request resource
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	vrouterRouteTable, err := r.client.CreateVRouterRouteTable(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating VRouter Route Table",
-			"Could not create vrouter route table, unexpected error: "+err.Error(),
-		)
+		vrouterRouteTable, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryVRouterRouteTable), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "VRouter Route Table", "CreateVRouterRouteTable", err, &response.Diagnostics)
 		return
 	}
 
@@ -143,12 +149,15 @@ func (r *vrouterRouteTableResource) Read(ctx context.Context, request resource.R
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading VRouter Route Table",
-			"Could not read VRouter Route Table, unexpected error: "+err.Error(),
-		)
+			"Could not read VRouter Route Table",
+			"QueryVRouterRouteTable",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(vrouterRouteTable.UUID)
 	state.Name = types.StringValue(vrouterRouteTable.Name)
@@ -188,10 +197,12 @@ func (r *vrouterRouteTableResource) Update(ctx context.Context, request resource
 
 	vrouterRouteTable, err := r.client.UpdateVRouterRouteTable(state.Uuid.ValueString(), p)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating VRouter Route Table",
-			"Could not update vrouter route table, unexpected error: "+err.Error(),
-		)
+			"Could not update vrouter route table",
+			"UpdateVRouterRouteTable",
+			err,
+		))
 		return
 	}
 
@@ -218,10 +229,12 @@ func (r *vrouterRouteTableResource) Delete(ctx context.Context, request resource
 	err := r.client.DeleteVRouterRouteTable(state.Uuid.ValueString(), param.DeleteModePermissive)
 
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting VRouter Route Table",
-			"Could not delete vrouter route table, unexpected error: "+err.Error(),
-		)
+			"Could not delete vrouter route table",
+			"DeleteVRouterRouteTable",
+			err,
+		))
 		return
 	}
 }
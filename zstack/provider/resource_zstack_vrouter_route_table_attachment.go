@@ -0,0 +1,288 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/client"
+	"github.com/zstackio/zstack-sdk-go-v2/pkg/param"
+)
+
+var (
+	_ resource.Resource                = &vrouterRouteTableAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &vrouterRouteTableAttachmentResource{}
+	_ resource.ResourceWithImportState = &vrouterRouteTableAttachmentResource{}
+)
+
+type vrouterRouteTableAttachmentResource struct {
+	client *client.ZSClient
+}
+
+type vrouterRouteTableAttachmentModel struct {
+	ID                types.String `tfsdk:"id"`
+	RouteTableUuid    types.String `tfsdk:"route_table_uuid"`
+	VirtualRouterUuid types.String `tfsdk:"virtual_router_uuid"`
+}
+
+func VRouterRouteTableAttachmentResource() resource.Resource {
+	return &vrouterRouteTableAttachmentResource{}
+}
+
+func (r *vrouterRouteTableAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ZSClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ZSClient, got: %T. Please report this issue to the Provider developer. ", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *vrouterRouteTableAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vrouter_route_table_attachment"
+}
+
+func (r *vrouterRouteTableAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attach a VRouter route table to a virtual router or VPC router so that its route entries take effect on the router. " +
+			"A router uses at most one route table. Destroying this resource detaches the route table without deleting it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform resource ID in the format `route_table_uuid:virtual_router_uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"route_table_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the route table to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_router_uuid": schema.StringAttribute{
+				Required:    true,
+				Description: "The UUID of the virtual router VM, e.g. `zstack_virtual_router_instance.<name>.uuid`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *vrouterRouteTableAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vrouterRouteTableAttachmentModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	routeTableUuid := plan.RouteTableUuid.ValueString()
+	virtualRouterUuid := plan.VirtualRouterUuid.ValueString()
+	plan.ID = types.StringValue(vrouterRouteTableAttachmentID(routeTableUuid, virtualRouterUuid))
+
+	unlock, err := lockParents(ctx, routeTableUuid, virtualRouterUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating VRouter Route Table Attachment",
+			"Could not lock the route table and virtual router: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	attached, err := r.isRouteTableAttached(routeTableUuid, virtualRouterUuid)
+	if err != nil {
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error creating VRouter Route Table Attachment",
+			"Could not query route table attachments",
+			"QueryVRouterRouteTable",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		_, err = r.client.AttachVRouterRouteTableToVRouter(routeTableUuid, virtualRouterUuid, param.AttachVRouterRouteTableToVRouterParam{
+			BaseParam: param.BaseParam{},
+			Params:    param.AttachVRouterRouteTableToVRouterParamDetail{},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error creating VRouter Route Table Attachment",
+				fmt.Sprintf("Could not attach route table %s to virtual router %s", routeTableUuid, virtualRouterUuid),
+				"AttachVRouterRouteTableToVRouter",
+				err,
+			))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "VRouter route table attachment created", map[string]any{
+		"id":                  plan.ID.ValueString(),
+		"route_table_uuid":    routeTableUuid,
+		"virtual_router_uuid": virtualRouterUuid,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vrouterRouteTableAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vrouterRouteTableAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attached, err := r.isRouteTableAttached(state.RouteTableUuid.ValueString(), state.VirtualRouterUuid.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrResourceNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
+			"Error reading VRouter Route Table Attachment",
+			"Could not query route table attachments",
+			"QueryVRouterRouteTable",
+			err,
+		))
+		return
+	}
+
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(vrouterRouteTableAttachmentID(state.RouteTableUuid.ValueString(), state.VirtualRouterUuid.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vrouterRouteTableAttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"This resource does not support updates. Any changes require replacement.",
+	)
+}
+
+func (r *vrouterRouteTableAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vrouterRouteTableAttachmentModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Not Configured", "The ZStack client was not properly configured.")
+		return
+	}
+
+	routeTableUuid := state.RouteTableUuid.ValueString()
+	virtualRouterUuid := state.VirtualRouterUuid.ValueString()
+
+	unlock, err := lockParents(ctx, routeTableUuid, virtualRouterUuid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting VRouter Route Table Attachment",
+			"Could not lock the route table and virtual router: "+err.Error(),
+		)
+		return
+	}
+	defer unlock()
+
+	err = r.client.DetachVRouterRouteTableFromVRouter(routeTableUuid, virtualRouterUuid, param.DeleteModePermissive)
+	if err != nil {
+		attached, queryErr := r.isRouteTableAttached(routeTableUuid, virtualRouterUuid)
+		if queryErr != nil {
+			if errors.Is(queryErr, ErrResourceNotFound) {
+				return
+			}
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting VRouter Route Table Attachment",
+				fmt.Sprintf("Detach failed (%s) and could not verify attachment status", err.Error()),
+				"QueryVRouterRouteTable",
+				queryErr,
+			))
+			return
+		}
+		if attached {
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
+				"Error deleting VRouter Route Table Attachment",
+				fmt.Sprintf("Could not detach route table %s from virtual router %s", routeTableUuid, virtualRouterUuid),
+				"DetachVRouterRouteTableFromVRouter",
+				err,
+			))
+		}
+	}
+}
+
+func (r *vrouterRouteTableAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	routeTableUuid, virtualRouterUuid, err := parseVRouterRouteTableAttachmentID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: route_table_uuid:virtual_router_uuid (e.g. abc123:def456).",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vrouterRouteTableAttachmentID(routeTableUuid, virtualRouterUuid))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_table_uuid"), routeTableUuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("virtual_router_uuid"), virtualRouterUuid)...)
+}
+
+// isRouteTableAttached reports whether the route table is attached to the
+// virtual router. It returns ErrResourceNotFound when the route table does
+// not exist.
+func (r *vrouterRouteTableAttachmentResource) isRouteTableAttached(routeTableUuid, virtualRouterUuid string) (bool, error) {
+	routeTable, err := findResourceByQuery(r.client.QueryVRouterRouteTable, routeTableUuid)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ref := range routeTable.AttachedRouterRefs {
+		if ref.VirtualRouterVmUuid == virtualRouterUuid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func vrouterRouteTableAttachmentID(routeTableUuid, virtualRouterUuid string) string {
+	return fmt.Sprintf("%s:%s", routeTableUuid, virtualRouterUuid)
+}
+
+func parseVRouterRouteTableAttachmentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected route_table_uuid:virtual_router_uuid")
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestVRouterRouteTableAttachmentResource_Schema(t *testing.T) {
+	var r vrouterRouteTableAttachmentResource
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	required := []string{"route_table_uuid", "virtual_router_uuid"}
+	for _, attr := range required {
+		a, ok := resp.Schema.Attributes[attr]
+		if !ok {
+			t.Fatalf("schema missing required attribute %q", attr)
+		}
+		if !a.IsRequired() {
+			t.Errorf("attribute %q should be required", attr)
+		}
+	}
+
+	id, ok := resp.Schema.Attributes["id"]
+	if !ok {
+		t.Fatal("schema missing computed attribute \"id\"")
+	}
+	if !id.IsComputed() {
+		t.Error("attribute \"id\" should be computed")
+	}
+}
+
+func TestVRouterRouteTableAttachmentResource_Metadata(t *testing.T) {
+	var r vrouterRouteTableAttachmentResource
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "zstack"}, resp)
+	if resp.TypeName != "zstack_vrouter_route_table_attachment" {
+		t.Errorf("unexpected type name: %s", resp.TypeName)
+	}
+}
+
+func TestParseVRouterRouteTableAttachmentID(t *testing.T) {
+	routeTableUuid, virtualRouterUuid, err := parseVRouterRouteTableAttachmentID("rt-uuid:vr-uuid")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if routeTableUuid != "rt-uuid" || virtualRouterUuid != "vr-uuid" {
+		t.Fatalf("unexpected parsed id: %q %q", routeTableUuid, virtualRouterUuid)
+	}
+
+	invalidIDs := []string{"", "rt-uuid", ":vr-uuid", "rt-uuid:"}
+	for _, id := range invalidIDs {
+		if _, _, err := parseVRouterRouteTableAttachmentID(id); err == nil {
+			t.Fatalf("expected error for invalid id %q", id)
+		}
+	}
+}
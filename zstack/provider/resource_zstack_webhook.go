@@ -133,12 +133,18 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 		},
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	item, err := r.client.CreateWebhook(p)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Webhook",
-			"Could not create webhook, unexpected error: "+err.Error(),
-		)
+		item, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryWebhook), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Webhook", "CreateWebhook", err, &resp.Diagnostics)
 		return
 	}
 
@@ -167,12 +173,15 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Webhook",
-			"Could not read Webhook, unexpected error: "+err.Error(),
-		)
+			"Could not read Webhook",
+			"QueryWebhook",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	state.Uuid = types.StringValue(item.UUID)
 	state.Name = types.StringValue(item.Name)
@@ -211,19 +220,23 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	if err := r.client.PutWithSpec("v1/web-hooks", state.Uuid.ValueString(), "", "", map[string]interface{}{
 		"updateWebhook": p.Params,
 	}, nil); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Webhook",
-			"Could not update webhook, unexpected error: "+err.Error(),
-		)
+			"Could not update webhook",
+			"PutWithSpec",
+			err,
+		))
 		return
 	}
 
 	item, err := findResourceByQuery(r.client.QueryWebhook, state.Uuid.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Webhook",
-			"Could not read webhook after update: "+err.Error(),
-		)
+			"Could not read webhook after update",
+			"QueryWebhook",
+			err,
+		))
 		return
 	}
 
@@ -247,10 +260,12 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	if err := r.client.DeleteWebhook(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Webhook",
-			"Could not delete webhook, unexpected error: "+err.Error(),
-		)
+			"Could not delete webhook",
+			"DeleteWebhook",
+			err,
+		))
 		return
 	}
 }
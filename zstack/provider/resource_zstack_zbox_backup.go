@@ -156,12 +156,18 @@ func (r *zboxBackupResource) Create(ctx context.Context, request resource.Create
 		p.Params.DryRun = boolPtr(plan.DryRun.ValueBool())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, response.Private, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	p.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	result, err := r.client.CreateZBoxBackup(p)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Error creating ZBox Backup",
-			"Could not create zbox backup, unexpected error: "+err.Error(),
-		)
+		result, err = adoptCreatedResource(ctx, queryLookup(r.client.QueryZBoxBackup), resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, request.Plan, &response.State, response.Private, path.Root("uuid"), resourceUuid, "ZBox Backup", "CreateZBoxBackup", err, &response.Diagnostics)
 		return
 	}
 
@@ -192,12 +198,15 @@ func (r *zboxBackupResource) Read(ctx context.Context, request resource.ReadRequ
 			response.State.RemoveResource(ctx)
 			return
 		}
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Zbox Backup",
-			"Could not read Zbox Backup, unexpected error: "+err.Error(),
-		)
+			"Could not read Zbox Backup",
+			"QueryZBoxBackup",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, response.Private, &response.Diagnostics)
 
 	state.Uuid = types.StringValue(zboxBackup.UUID)
 	state.Name = stringValueOrNull(zboxBackup.Name)
@@ -231,10 +240,12 @@ func (r *zboxBackupResource) Delete(ctx context.Context, request resource.Delete
 
 	err := r.client.DeleteExternalBackup(state.Uuid.ValueString(), param.DeleteModePermissive)
 	if err != nil {
-		response.Diagnostics.AddError(
+		response.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting ZBox Backup",
-			"Could not delete zbox backup, unexpected error: "+err.Error(),
-		)
+			"Could not delete zbox backup",
+			"DeleteExternalBackup",
+			err,
+		))
 		return
 	}
 }
@@ -139,12 +139,18 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		createParam.Params.Description = stringPtr(plan.Description.ValueString())
 	}
 
+	resourceUuid := recordResourceUuid(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createParam.Params.ResourceUuid = stringPtr(resourceUuid)
+
 	zone, err := r.client.CreateZone(createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating Zone",
-			"Could not create zone, unexpected error: "+err.Error(),
-		)
+		zone, err = adoptCreatedResource(ctx, r.client.GetZone, resourceUuid, err)
+	}
+	if err != nil {
+		savePendingCreate(ctx, req.Plan, &resp.State, resp.Private, path.Root("uuid"), resourceUuid, "Zone", "CreateZone", err, &resp.Diagnostics)
 		return
 	}
 
@@ -157,20 +163,24 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing Zone state",
-				"Could not change zone state, unexpected error: "+err.Error(),
-			)
+				"Could not change zone state",
+				"ChangeZoneState",
+				err,
+			))
 			return
 		}
 
 		// Re-read the zone to get the updated state
 		zone, err = r.client.GetZone(zone.UUID)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error reading Zone",
-				"Could not read zone after state change: "+err.Error(),
-			)
+				"Could not read zone after state change",
+				"GetZone",
+				err,
+			))
 			return
 		}
 	}
@@ -196,12 +206,15 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Zone",
-			"Could not read zone UUID "+state.Uuid.ValueString()+": "+err.Error(),
-		)
+			"Could not read zone UUID "+state.Uuid.ValueString(),
+			"GetZone",
+			err,
+		))
 		return
 	}
+	clearPendingCreate(ctx, resp.Private, &resp.Diagnostics)
 
 	refreshedState := zoneModelFromView(zone)
 
@@ -233,10 +246,12 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if _, err := r.client.UpdateZone(uuid, updateParam); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error updating Zone",
-			"Could not update zone, unexpected error: "+err.Error(),
-		)
+			"Could not update zone",
+			"UpdateZone",
+			err,
+		))
 		return
 	}
 
@@ -248,10 +263,12 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 				StateEvent: stateEvent,
 			},
 		}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(zstackErrorDiagnostic(
 				"Error changing Zone state",
-				"Could not change zone state, unexpected error: "+err.Error(),
-			)
+				"Could not change zone state",
+				"ChangeZoneState",
+				err,
+			))
 			return
 		}
 	}
@@ -259,10 +276,12 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Read back the updated resource
 	zone, err := r.client.GetZone(uuid)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error reading Zone",
-			"Could not read zone UUID "+uuid+" after update: "+err.Error(),
-		)
+			"Could not read zone UUID "+uuid+" after update",
+			"GetZone",
+			err,
+		))
 		return
 	}
 
@@ -287,10 +306,12 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	if err := r.client.DeleteZone(state.Uuid.ValueString(), param.DeleteModePermissive); err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(zstackErrorDiagnostic(
 			"Error deleting Zone",
-			"Could not delete zone, unexpected error: "+err.Error(),
-		)
+			"Could not delete zone",
+			"DeleteZone",
+			err,
+		))
 		return
 	}
 }
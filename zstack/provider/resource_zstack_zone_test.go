@@ -4,7 +4,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -126,3 +131,105 @@ resource "zstack_zone" "test" {
 		},
 	})
 }
+
+// TestAccZoneResource_UnconfirmedCreateIsNotDuplicated loses the response of
+// the create call. The refresh that follows must keep the zone in state rather
+// than plan a second create, and adopt it once ZStack reports it.
+func TestAccZoneResource_UnconfirmedCreateIsNotDuplicated(t *testing.T) {
+	var mu sync.Mutex
+	var createdUuids []string
+	created, deleted := false, false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zstack/v1/accounts/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"inventory": map[string]string{"uuid": "mock-session-uuid"}})
+	})
+	mux.HandleFunc("/zstack/v1/zones", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Params map[string]any `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		uuid, _ := payload.Params["resourceUuid"].(string)
+		mu.Lock()
+		if len(createdUuids) == 0 || createdUuids[len(createdUuids)-1] != uuid {
+			createdUuids = append(createdUuids, uuid)
+		}
+		mu.Unlock()
+		// The create is accepted, but the response never reaches the provider.
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc("/zstack/v1/zones/", func(w http.ResponseWriter, r *http.Request) {
+		uuid := strings.TrimPrefix(r.URL.Path, "/zstack/v1/zones/")
+		w.Header().Set("Content-Type", "application/json")
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodDelete {
+			deleted = true
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		inventories := []any{}
+		if created && !deleted && len(createdUuids) > 0 && uuid == createdUuids[0] {
+			inventories = append(inventories, map[string]any{
+				"uuid":        uuid,
+				"name":        "mock-zone",
+				"description": "unconfirmed create",
+				"state":       "Enabled",
+				"type":        "zstack",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"inventories": inventories})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	hostParts := strings.Split(server.Listener.Addr().String(), ":")
+	t.Setenv("ZSTACK_HOST", hostParts[0])
+	t.Setenv("ZSTACK_PORT", hostParts[1])
+	t.Setenv("ZSTACK_ACCOUNT_NAME", "admin")
+	t.Setenv("ZSTACK_ACCOUNT_PASSWORD", "password")
+
+	config := `
+resource "zstack_zone" "foo" {
+  name        = "mock-zone"
+  description = "unconfirmed create"
+}
+`
+	tfresource.UnitTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				// The refresh after apply finds no zone yet; an empty plan
+				// shows it was kept in state instead of being created again.
+				Config: config,
+			},
+			{
+				PreConfig: func() {
+					mu.Lock()
+					created = true
+					mu.Unlock()
+				},
+				Config: config,
+				Check: tfresource.TestCheckResourceAttrWith("zstack_zone.foo", "uuid", func(value string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					if len(createdUuids) != 1 || value != createdUuids[0] {
+						return fmt.Errorf("expected the zone of the single create call %v to be adopted, got %q", createdUuids, value)
+					}
+					return nil
+				}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("zstack_zone.foo", tfjsonpath.New("state"), knownvalue.StringExact("Enabled")),
+				},
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(createdUuids) != 1 {
+		t.Errorf("expected exactly one create call, got %d: %v", len(createdUuids), createdUuids)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+// secGroupRuleSpec is the configurable part of a security group rule.
+type secGroupRuleSpec struct {
+	Action                  string
+	State                   string
+	Protocol                string
+	IpVersion               int
+	IpRanges                string
+	DstPortRanges           string
+	RemoteSecurityGroupUuid string
+	Description             string
+}
+
+// secGroupLiveRule is a rule as it exists in ZStack.
+type secGroupLiveRule struct {
+	Uuid     string
+	Priority int
+	Spec     secGroupRuleSpec
+}
+
+type secGroupRuleOpKind int
+
+const (
+	secGroupRuleDelete secGroupRuleOpKind = iota
+	secGroupRuleAdd
+	secGroupRuleChange
+)
+
+// secGroupRuleOp is one API call of a rule list reconciliation. Priority is
+// the new priority of an added or moved rule, or 0 when a changed rule keeps
+// its place; Current is the live spec of a changed rule.
+type secGroupRuleOp struct {
+	Kind     secGroupRuleOpKind
+	Uuid     string
+	Priority int
+	Spec     secGroupRuleSpec
+	Current  secGroupRuleSpec
+}
+
+// matchKey identifies a rule across changes of its action, state and
+// description, which ZStack can change in place without reordering.
+func (s secGroupRuleSpec) matchKey() secGroupRuleSpec {
+	s.Action, s.State, s.Description = "", "", ""
+	return s
+}
+
+// planSecGroupRuleChanges returns the calls that turn the live rules of one
+// direction into desired, where desired[i] must end up with priority i+1.
+//
+// Live rules are reused where possible: first those equal to a desired rule,
+// then those matching it except for action, state and description. The rest
+// are deleted. The remaining rules are then walked in desired order, moving
+// or inserting each at its priority. ZStack shifts the other rules when a
+// rule is inserted or moved, so the walk simulates the live order to skip
+// rules that are already in place.
+func planSecGroupRuleChanges(live []secGroupLiveRule, desired []secGroupRuleSpec) []secGroupRuleOp {
+	byUuid := make(map[string]secGroupLiveRule, len(live))
+	for _, rule := range live {
+		byUuid[rule.Uuid] = rule
+	}
+
+	matched := make([]string, len(desired))
+	used := make(map[string]bool, len(live))
+	match := func(equal func(a, b secGroupRuleSpec) bool) {
+		for i, spec := range desired {
+			if matched[i] != "" {
+				continue
+			}
+			for _, rule := range live {
+				if !used[rule.Uuid] && equal(rule.Spec, spec) {
+					matched[i] = rule.Uuid
+					used[rule.Uuid] = true
+					break
+				}
+			}
+		}
+	}
+	match(func(a, b secGroupRuleSpec) bool { return a == b })
+	match(func(a, b secGroupRuleSpec) bool { return a.matchKey() == b.matchKey() })
+
+	var ops []secGroupRuleOp
+	var order []string
+	for _, rule := range live {
+		if !used[rule.Uuid] {
+			ops = append(ops, secGroupRuleOp{Kind: secGroupRuleDelete, Uuid: rule.Uuid})
+			continue
+		}
+		order = append(order, rule.Uuid)
+	}
+
+	for i, spec := range desired {
+		uuid := matched[i]
+		if uuid == "" {
+			ops = append(ops, secGroupRuleOp{Kind: secGroupRuleAdd, Priority: i + 1, Spec: spec})
+			order = insertString(order, i, "")
+			continue
+		}
+
+		pos := indexOfString(order, uuid)
+		current := byUuid[uuid].Spec
+		if pos == i && current == spec {
+			continue
+		}
+
+		op := secGroupRuleOp{Kind: secGroupRuleChange, Uuid: uuid, Spec: spec, Current: current}
+		if pos != i {
+			op.Priority = i + 1
+			order = insertString(append(order[:pos:pos], order[pos+1:]...), i, uuid)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops
+}
+
+func insertString(s []string, i int, v string) []string {
+	s = append(s, "")
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+func indexOfString(s []string, v string) int {
+	for i := range s {
+		if s[i] == v {
+			return i
+		}
+	}
+	return -1
+}
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"errors"
+	"net"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -57,6 +58,14 @@ func stringValueOrNull(value string) types.String {
 	return types.StringValue(value)
 }
 
+func int64ValueOrNull(value int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
 func terraformStringsToSlice(values []types.String) []string {
 	if len(values) == 0 {
 		return nil
@@ -142,3 +151,33 @@ func isZStackNotFoundError(err error) bool {
 
 	return strings.Contains(err.Error(), "status code 404")
 }
+
+// stringSetDifference returns the elements of a that are not in b.
+func stringSetDifference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, s := range b {
+		in[s] = true
+	}
+	var diff []string
+	for _, s := range a {
+		if !in[s] {
+			diff = append(diff, s)
+		}
+	}
+	return diff
+}
+
+// ipStringValue returns the IP address reported by the API, or prior when it
+// is the same address in another notation, such as an uncompressed IPv6
+// address. An empty observed value yields null.
+func ipStringValue(observed string, prior types.String) types.String {
+	if observed == "" {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if ip := net.ParseIP(prior.ValueString()); ip != nil && ip.Equal(net.ParseIP(observed)) {
+			return prior
+		}
+	}
+	return types.StringValue(observed)
+}
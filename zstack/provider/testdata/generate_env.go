@@ -455,10 +455,11 @@ func main() {
 			var nics []map[string]interface{}
 			for _, nic := range vr.VmNics {
 				nics = append(nics, map[string]interface{}{
-					"ip":      nic.Ip,
-					"mac":     nic.Mac,
-					"netmask": nic.Netmask,
-					"gateway": nic.Gateway,
+					"ip":              nic.Ip,
+					"mac":             nic.Mac,
+					"netmask":         nic.Netmask,
+					"gateway":         nic.Gateway,
+					"l3_network_uuid": nic.L3NetworkUuid,
 				})
 			}
 			vrMap["vm_nics"] = nics
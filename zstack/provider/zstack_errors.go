@@ -0,0 +1,210 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// zstackError is the error payload the ZStack management node returns for a
+// failed API call, as found in the text of an SDK error.
+type zstackError struct {
+	Code        string       `json:"code"`
+	Description string       `json:"description"`
+	Details     string       `json:"details"`
+	Elaboration string       `json:"elaboration"`
+	Cause       *zstackError `json:"cause"`
+}
+
+// zstackErrorInfo is everything that could be extracted from an SDK error.
+type zstackErrorInfo struct {
+	// Payload is nil when the error text carries no ZStack error payload,
+	// e.g. for transport errors.
+	Payload    *zstackError
+	StatusCode int
+	RequestId  string
+	Raw        string
+}
+
+var (
+	statusCodePattern = regexp.MustCompile(`status code (\d{3})`)
+	requestIdPattern  = regexp.MustCompile(`(?:"(?:requestId|apiId|jobUuid)"\s*:\s*"|/api-jobs/)([0-9a-fA-F-]{32,36})`)
+)
+
+// parseZStackError extracts the ZStack error payload, HTTP status and request
+// ID from err. The SDK embeds the response body in its error text, so the
+// first JSON object holding an error code, either bare or under an "error"
+// key, is taken as the payload.
+func parseZStackError(err error) zstackErrorInfo {
+	raw := err.Error()
+	info := zstackErrorInfo{Raw: raw}
+
+	if m := statusCodePattern.FindStringSubmatch(raw); m != nil {
+		info.StatusCode, _ = strconv.Atoi(m[1])
+	}
+	if m := requestIdPattern.FindStringSubmatch(raw); m != nil {
+		info.RequestId = m[1]
+	}
+
+	for i := strings.IndexByte(raw, '{'); i >= 0; {
+		var body struct {
+			zstackError
+			Error *zstackError `json:"error"`
+		}
+		if json.NewDecoder(bytes.NewReader([]byte(raw[i:]))).Decode(&body) == nil {
+			if body.Error != nil && body.Error.Code != "" {
+				info.Payload = body.Error
+				break
+			}
+			if body.Code != "" {
+				payload := body.zstackError
+				info.Payload = &payload
+				break
+			}
+		}
+		next := strings.IndexByte(raw[i+1:], '{')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+
+	return info
+}
+
+// zstackErrorHint is the remediation shown for a class of known failures.
+type zstackErrorHint struct {
+	codePrefixes []string
+	pattern      *regexp.Regexp
+	hint         string
+}
+
+// zstackErrorHints are checked in order against the code and description of
+// every error in the cause chain; the first match wins.
+var zstackErrorHints = []zstackErrorHint{
+	{
+		codePrefixes: []string{"LICENSE."},
+		pattern:      regexp.MustCompile(`(?i)licen[cs]e`),
+		hint: "The ZStack license does not allow this operation: the licensed quota (for example hosts, CPU sockets or VM instances) is used up, " +
+			"the license has expired, or the feature is not licensed. Check the license on the management node (Settings > License) and apply again after it is updated.",
+	},
+	{
+		codePrefixes: []string{"ID.1003", "ID.1004"},
+		pattern:      regexp.MustCompile(`(?i)quota`),
+		hint:         "An account or project quota is exhausted. Delete unused resources or ask an administrator to raise the quota of the account or IAM2 project, then apply again.",
+	},
+	{
+		codePrefixes: []string{"HOST_ALLOCATION.", "PRIMARY_STORAGE_ALLOCATION."},
+		pattern:      regexp.MustCompile(`(?i)insufficient|not enough|no (?:available|candidate|qualified) (?:host|primary storage)|capacity`),
+		hint: "ZStack could not find enough free capacity for the request. Check CPU and memory of the hosts, free space on the primary storage and free IPs of the L3 network, " +
+			"or choose a smaller offering or another zone, cluster or host.",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)\bin use\b|being used|still (?:attached|used|has)|has been attached|referenced by|attached to`),
+		hint: "The resource is still used by another resource. Detach or delete the dependent resources first. " +
+			"When both are managed by Terraform, reference this resource's attributes from the dependent one so Terraform orders the changes.",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)(?:invalid|wrong|incorrect|unexpected|current) state|not in (?:the )?(?:correct |right )?state|in state \[|state is not|is not (?:running|stopped|ready|enabled|connected)`),
+		hint: "The resource is not in a state that allows this operation, for example a VM instance that is still starting or a host in maintenance. " +
+			"Wait for it to settle or change its state, then apply again.",
+	},
+}
+
+// hint returns the remediation for the first known failure in the cause
+// chain, or "" if there is none.
+func (info zstackErrorInfo) hint() string {
+	for _, h := range zstackErrorHints {
+		for e := info.Payload; e != nil; e = e.Cause {
+			for _, prefix := range h.codePrefixes {
+				if strings.HasPrefix(e.Code, prefix) {
+					return h.hint
+				}
+			}
+			if h.pattern.MatchString(e.Description) || h.pattern.MatchString(e.Details) {
+				return h.hint
+			}
+		}
+		if info.Payload == nil && h.pattern.MatchString(info.Raw) {
+			return h.hint
+		}
+	}
+	return ""
+}
+
+// zstackErrorDiagnostic converts an SDK error into an error diagnostic.
+// message says what failed, e.g. "Could not create VM instance"; apiName is
+// the SDK call that returned err. The detail carries the API name, request ID,
+// ZStack error code, description, details and cause chain when the error
+// holds a ZStack payload, the raw error text otherwise, and a remediation
+// hint for known error codes.
+func zstackErrorDiagnostic(summary, message, apiName string, err error) diag.Diagnostic {
+	return zstackErrorDiagnosticWithHint(summary, message, apiName, "", err)
+}
+
+// zstackErrorDiagnosticWithHint is zstackErrorDiagnostic with resource-specific
+// guidance appended after the hint for known error codes.
+func zstackErrorDiagnosticWithHint(summary, message, apiName, hint string, err error) diag.Diagnostic {
+	info := parseZStackError(err)
+
+	var b strings.Builder
+	if info.Payload == nil {
+		b.WriteString(message + ", unexpected error: " + info.Raw + "\n")
+	} else {
+		b.WriteString(message + ".\n")
+	}
+
+	b.WriteString("\n")
+	if apiName != "" {
+		b.WriteString("ZStack API: " + apiName + "\n")
+	}
+	if info.RequestId != "" {
+		b.WriteString("Request ID: " + info.RequestId + "\n")
+	}
+	if info.StatusCode != 0 {
+		b.WriteString("HTTP status: " + strconv.Itoa(info.StatusCode) + "\n")
+	}
+
+	if e := info.Payload; e != nil {
+		b.WriteString("Error code: " + e.Code + "\n")
+		if e.Description != "" {
+			b.WriteString("Description: " + e.Description + "\n")
+		}
+		if e.Details != "" {
+			b.WriteString("Details: " + e.Details + "\n")
+		}
+		if e.Elaboration != "" {
+			b.WriteString("Elaboration: " + e.Elaboration + "\n")
+		}
+		for cause := e.Cause; cause != nil; cause = cause.Cause {
+			b.WriteString("Caused by: " + cause.String() + "\n")
+		}
+	}
+
+	if known := info.hint(); known != "" {
+		b.WriteString("\n" + known + "\n")
+	}
+	if hint != "" {
+		b.WriteString("\n" + hint + "\n")
+	}
+
+	return diag.NewErrorDiagnostic(summary, strings.TrimRight(b.String(), "\n"))
+}
+
+func (e *zstackError) String() string {
+	s := e.Code
+	if e.Description != "" {
+		s = fmt.Sprintf("[%s] %s", e.Code, e.Description)
+	}
+	if e.Details != "" {
+		s += ": " + e.Details
+	}
+	return s
+}
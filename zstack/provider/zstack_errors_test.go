@@ -0,0 +1,107 @@
+// Copyright (c) ZStack.io, Inc.
+
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseZStackError_Payload(t *testing.T) {
+	err := errors.New(`status code 503, POST http://172.30.3.2:8080/zstack/v1/api-jobs/8d1a0a2b5b2a4e07a2f5e1e2f3c4d5e6: ` +
+		`{"error":{"code":"SYS.1001","description":"An operation failed","details":"failed to create vm",` +
+		`"cause":{"code":"HOST_ALLOCATION.1001","description":"no available host for vm","details":"no host has 64G free memory"}}}`)
+
+	info := parseZStackError(err)
+	if info.Payload == nil {
+		t.Fatal("expected the ZStack error payload to be parsed")
+	}
+	if info.Payload.Code != "SYS.1001" || info.Payload.Details != "failed to create vm" {
+		t.Errorf("unexpected payload %+v", info.Payload)
+	}
+	if info.Payload.Cause == nil || info.Payload.Cause.Code != "HOST_ALLOCATION.1001" {
+		t.Errorf("expected the cause chain to be parsed, got %+v", info.Payload.Cause)
+	}
+	if info.StatusCode != 503 {
+		t.Errorf("expected status 503, got %d", info.StatusCode)
+	}
+	if info.RequestId != "8d1a0a2b5b2a4e07a2f5e1e2f3c4d5e6" {
+		t.Errorf("expected the job UUID as request ID, got %q", info.RequestId)
+	}
+}
+
+func TestParseZStackError_BarePayload(t *testing.T) {
+	info := parseZStackError(errors.New(`request failed {not json} {"code":"ID.1003","description":"quota exceeding"}`))
+	if info.Payload == nil || info.Payload.Code != "ID.1003" {
+		t.Fatalf("expected a bare payload to be parsed, got %+v", info.Payload)
+	}
+}
+
+func TestParseZStackError_NoPayload(t *testing.T) {
+	info := parseZStackError(errors.New("dial tcp 172.30.3.2:8080: connection refused"))
+	if info.Payload != nil || info.StatusCode != 0 || info.RequestId != "" {
+		t.Errorf("expected nothing to be extracted, got %+v", info)
+	}
+}
+
+func TestZStackErrorInfo_Hint(t *testing.T) {
+	cases := map[string]struct {
+		err  string
+		want string
+	}{
+		"quota by code":        {`{"error":{"code":"ID.1003","description":"x"}}`, "quota"},
+		"license":              {`{"error":{"code":"SYS.1001","description":"the license allows only 10 hosts"}}`, "license"},
+		"capacity in cause":    {`{"error":{"code":"SYS.1001","cause":{"code":"HOST_ALLOCATION.1001","description":"x"}}}`, "capacity"},
+		"resource in use":      {`{"error":{"code":"SYS.1001","description":"the volume is still attached to vm"}}`, "still used"},
+		"invalid state":        {`{"error":{"code":"SYS.1001","description":"vm is not in state [Running]","details":"current state is not Running"}}`, "not in a state"},
+		"unknown code":         {`{"error":{"code":"SYS.1002","description":"invalid argument"}}`, ""},
+		"raw text, no payload": {"image is in use by 3 vm instances", "still used"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := parseZStackError(errors.New(tc.err)).hint()
+			if tc.want == "" && got != "" {
+				t.Fatalf("expected no hint, got %q", got)
+			}
+			if !strings.Contains(got, tc.want) {
+				t.Fatalf("expected a hint about %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestZStackErrorDiagnostic(t *testing.T) {
+	err := errors.New(`{"error":{"code":"ID.1003","description":"Quota exceeding","details":"vm number exceeds the quota 10",` +
+		`"cause":{"code":"SYS.1000","description":"internal error"}}}`)
+
+	d := zstackErrorDiagnostic("Error creating VM Instance", "Could not create VM instance", "CreateVmInstance", err)
+	detail := d.Detail()
+
+	if d.Summary() != "Error creating VM Instance" {
+		t.Errorf("unexpected summary %q", d.Summary())
+	}
+	for _, want := range []string{
+		"Could not create VM instance.",
+		"ZStack API: CreateVmInstance",
+		"Error code: ID.1003",
+		"Description: Quota exceeding",
+		"Details: vm number exceeds the quota 10",
+		"Caused by: [SYS.1000] internal error",
+		"raise the quota",
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail is missing %q:\n%s", want, detail)
+		}
+	}
+	if strings.Contains(detail, "unexpected error") {
+		t.Errorf("structured errors should not repeat the raw error text:\n%s", detail)
+	}
+}
+
+func TestZStackErrorDiagnostic_Unstructured(t *testing.T) {
+	d := zstackErrorDiagnostic("Error reading Zone", "Could not read zone", "QueryZone", errors.New("connection reset by peer"))
+	if !strings.Contains(d.Detail(), "Could not read zone, unexpected error: connection reset by peer") {
+		t.Errorf("expected the raw error text, got:\n%s", d.Detail())
+	}
+}